@@ -0,0 +1,299 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package analysis provides goderive as an analyzer,
+// which can be run by gopls, go vet -vettool or multichecker, alongside other linters.
+//
+// The analyzer reports calls to derived functions, which are missing from or out of date in derived.gen.go,
+// or for the test variants of a package, in derived.gen_test.go and derived.gen_x_test.go.
+// Each diagnostic suggests a fix, which replaces the derived file with the regenerated code,
+// or creates the derived file, if it does not exist yet.
+// Packages, which are configured to be generated for multiple builds, are not analyzed.
+package analysis
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/awalterschulze/goderive/derive"
+	"github.com/awalterschulze/goderive/plugin"
+)
+
+const doc = `report derived functions that are missing or out of date
+
+The goderive analyzer generates the derived functions of a package in memory
+and compares them to derived.gen.go, or for test variants to derived.gen_test.go and derived.gen_x_test.go.
+Calls to functions that are missing or out of date are reported,
+with a suggested fix that replaces the derived file with the regenerated code,
+or creates the derived file, if it does not exist yet.`
+
+// Analyzer reports derived functions that are missing or out of date,
+// for all the plugins that are included in goderive, with their default prefixes.
+var Analyzer = New(plugin.Default())
+
+// New returns an analyzer, which reports derived functions that are missing or out of date, for the given plugins.
+// The goderive.yaml or goderive.toml config file of each package is respected, see derive.FindConfig.
+func New(plugins []derive.Plugin) *analysis.Analyzer {
+	r := &runner{
+		plugins: derive.NewPlugins(plugins, false, false),
+	}
+	return &analysis.Analyzer{
+		Name: "goderive",
+		Doc:  doc,
+		URL:  "https://github.com/awalterschulze/goderive",
+		Run:  r.run,
+		// Calls to derived functions, which do not exist yet, are type errors.
+		RunDespiteErrors: true,
+	}
+}
+
+type runner struct {
+	plugins derive.Plugins
+}
+
+func (r *runner) run(pass *analysis.Pass) (interface{}, error) {
+	if len(pass.Files) == 0 {
+		return nil, nil
+	}
+	dir := filepath.Dir(pass.Fset.File(pass.Files[0].Pos()).Name())
+	config, err := derive.FindConfig(dir)
+	if err != nil {
+		return nil, err
+	}
+	// A pass only sees the package as it is loaded for one build.
+	if len(config.Builds) > 0 {
+		return nil, nil
+	}
+	filenames := make([]string, len(pass.Files))
+	isTest := false
+	for i, f := range pass.Files {
+		filenames[i] = pass.Fset.File(f.Pos()).Name()
+		if strings.HasSuffix(filenames[i], "_test.go") {
+			isTest = true
+		}
+	}
+	// Each variant of a package is generated into its own derived file.
+	// The in-package test variant only generates the functions, which are called from its test files.
+	forTest := ""
+	derivedFilename := config.OutputFilename()
+	switch {
+	case strings.HasSuffix(pass.Pkg.Name(), "_test"):
+		forTest = strings.TrimSuffix(pass.Pkg.Path(), "_test")
+		derivedFilename = config.ExternalTestOutputFilename()
+	case isTest:
+		forTest = pass.Pkg.Path()
+		derivedFilename = config.TestOutputFilename()
+	}
+	var derivedFile *ast.File
+	for i, f := range pass.Files {
+		if filepath.Base(filenames[i]) == derivedFilename {
+			derivedFile = f
+		}
+	}
+
+	generated, err := r.plugins.GeneratePackage(&packages.Package{
+		ID:              pass.Pkg.Path(),
+		Name:            pass.Pkg.Name(),
+		PkgPath:         pass.Pkg.Path(),
+		ForTest:         forTest,
+		GoFiles:         filenames,
+		CompiledGoFiles: filenames,
+		Fset:            pass.Fset,
+		Syntax:          pass.Files,
+		Types:           pass.Pkg,
+		TypesInfo:       pass.TypesInfo,
+		TypesSizes:      pass.TypesSizes,
+	})
+	if err != nil {
+		for _, d := range derive.AsDiagnostics(err, derive.CodeInternal) {
+			msg := d.Message
+			if len(d.Plugin) > 0 {
+				msg = d.Plugin + ": " + msg
+			}
+			pass.Report(analysis.Diagnostic{
+				Pos:      position(pass, d.Pos),
+				Category: string(d.Code),
+				Message:  msg,
+			})
+		}
+		return nil, nil
+	}
+
+	derivedPath := filepath.Join(dir, derivedFilename)
+	newContent, ok := generated[derivedPath]
+	if !ok {
+		return nil, nil
+	}
+	// The derived file is read as the pass sees it, which might be an unsaved buffer of an editor.
+	var oldContent []byte
+	if derivedFile != nil {
+		oldContent, err = pass.ReadFile(pass.Fset.File(derivedFile.Pos()).Name())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if bytes.Equal(oldContent, newContent) {
+		return nil, nil
+	}
+	oldFuncs := funcSources(derivedFilename, oldContent)
+	newFuncs := funcSources(derivedFilename, newContent)
+
+	var fixes []analysis.SuggestedFix
+	switch {
+	case newContent == nil:
+		// The derived file is no longer needed and is removed by goderive.
+	case derivedFile != nil:
+		tokFile := pass.Fset.File(derivedFile.Pos())
+		fixes = []analysis.SuggestedFix{{
+			Message: "Regenerate " + derivedFilename,
+			TextEdits: []analysis.TextEdit{{
+				Pos:     token.Pos(tokFile.Base()),
+				End:     token.Pos(tokFile.Base() + tokFile.Size()),
+				NewText: newContent,
+			}},
+		}}
+	case !ignored(pass, derivedPath):
+		// A derived file, which does not exist yet, is created by inserting the code into an empty file.
+		tokFile := pass.Fset.AddFile(derivedPath, -1, 0)
+		fixes = []analysis.SuggestedFix{{
+			Message: "Create " + derivedFilename,
+			TextEdits: []analysis.TextEdit{{
+				Pos:     token.Pos(tokFile.Base()),
+				End:     token.Pos(tokFile.Base()),
+				NewText: newContent,
+			}},
+		}}
+	}
+	report := func(pos token.Pos, format string, args ...interface{}) {
+		pass.Report(analysis.Diagnostic{
+			Pos:            pos,
+			Message:        fmt.Sprintf(format, args...),
+			SuggestedFixes: fixes,
+		})
+	}
+
+	reported := make(map[string]bool)
+	for i, f := range pass.Files {
+		if f == derivedFile || (isTest && !strings.HasSuffix(filenames[i], "_test.go")) {
+			continue
+		}
+		ast.Inspect(f, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			fn, ok := call.Fun.(*ast.Ident)
+			if !ok {
+				return true
+			}
+			newSrc, ok := newFuncs[fn.Name]
+			if !ok {
+				return true
+			}
+			oldSrc, ok := oldFuncs[fn.Name]
+			switch {
+			case !ok:
+				report(call.Pos(), "derived function %s is missing, run goderive", fn.Name)
+			case oldSrc != newSrc:
+				report(call.Pos(), "derived function %s is out of date, run goderive", fn.Name)
+			default:
+				return true
+			}
+			reported[fn.Name] = true
+			return true
+		})
+	}
+
+	if derivedFile == nil {
+		return nil, nil
+	}
+	// Functions, which are only called from other derived functions, are reported where they are declared.
+	for _, decl := range derivedFile.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || reported[fn.Name.Name] {
+			continue
+		}
+		newSrc, ok := newFuncs[fn.Name.Name]
+		switch {
+		case !ok:
+			report(fn.Name.Pos(), "derived function %s is no longer used, run goderive", fn.Name.Name)
+		case oldFuncs[fn.Name.Name] != newSrc:
+			report(fn.Name.Pos(), "derived function %s is out of date, run goderive", fn.Name.Name)
+		default:
+			continue
+		}
+		reported[fn.Name.Name] = true
+	}
+	if len(reported) == 0 {
+		report(derivedFile.Name.Pos(), "%s is out of date, run goderive", derivedFilename)
+	}
+	return nil, nil
+}
+
+// ignored returns whether the file exists, but is not part of the package, for example because of its build constraints.
+func ignored(pass *analysis.Pass, filename string) bool {
+	for _, f := range pass.IgnoredFiles {
+		if f == filename {
+			return true
+		}
+	}
+	return false
+}
+
+// funcSources returns the source code, including the doc comment, of each function declared in the file.
+func funcSources(filename string, src []byte) map[string]string {
+	funcs := make(map[string]string)
+	if len(src) == 0 {
+		return funcs
+	}
+	fset := token.NewFileSet()
+	// A partially parsed file still contains the functions before the syntax error.
+	f, _ := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if f == nil {
+		return funcs
+	}
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil {
+			continue
+		}
+		start := fn.Pos()
+		if fn.Doc != nil {
+			start = fn.Doc.Pos()
+		}
+		funcs[fn.Name.Name] = string(src[fset.Position(start).Offset:fset.Position(fn.End()).Offset])
+	}
+	return funcs
+}
+
+// position returns the position in the analysis pass of a diagnostic reported by goderive.
+// Diagnostics without a position are reported at the package clause of the first file.
+func position(pass *analysis.Pass, pos token.Position) token.Pos {
+	for _, f := range pass.Files {
+		tokFile := pass.Fset.File(f.Pos())
+		if !pos.IsValid() || tokFile.Name() != pos.Filename || pos.Line > tokFile.LineCount() {
+			continue
+		}
+		return tokFile.LineStart(pos.Line) + token.Pos(pos.Column-1)
+	}
+	return pass.Files[0].Package
+}
@@ -0,0 +1,542 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build/constraint"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Build is a platform and a set of build tags, under which a package is loaded and generated.
+// Empty fields are not constrained, for example a Build with only Tags is loaded for the host platform,
+// but its generated file is used on any platform.
+type Build struct {
+	GOOS   string   `yaml:"goos" toml:"goos"`
+	GOARCH string   `yaml:"goarch" toml:"goarch"`
+	Tags   []string `yaml:"tags" toml:"tags"`
+}
+
+// ParseBuilds parses a comma separated list of builds, each of the form [GOOS[/GOARCH]][+tag]...,
+// for example: linux/amd64,windows,linux+integration
+func ParseBuilds(s string) ([]Build, error) {
+	var builds []Build
+	for _, field := range strings.Split(s, ",") {
+		parts := strings.Split(field, "+")
+		var b Build
+		platform := strings.SplitN(parts[0], "/", 2)
+		b.GOOS = platform[0]
+		if len(platform) == 2 {
+			b.GOARCH = platform[1]
+		}
+		b.Tags = parts[1:]
+		if err := b.validate(); err != nil {
+			return nil, fmt.Errorf("invalid build %q: %v", field, err)
+		}
+		builds = append(builds, b)
+	}
+	return builds, nil
+}
+
+// terms returns the build tags that are satisfied by the build, starting with GOOS and GOARCH.
+func (b Build) terms() []string {
+	var terms []string
+	if len(b.GOOS) > 0 {
+		terms = append(terms, b.GOOS)
+	}
+	if len(b.GOARCH) > 0 {
+		terms = append(terms, b.GOARCH)
+	}
+	return append(terms, b.Tags...)
+}
+
+// name returns the name of the build, which is used in the names of its generated files, for example linux_amd64.
+func (b Build) name() string {
+	return strings.Join(b.terms(), "_")
+}
+
+// filename returns the name of the generated file for this build,
+// by inserting the name of the build before the first dot in the output filename,
+// for example derived_linux.gen.go for derived.gen.go.
+func (b Build) filename(output string) string {
+	i := strings.Index(output, ".")
+	return output[:i] + "_" + b.name() + output[i:]
+}
+
+func (b Build) validate() error {
+	terms := b.terms()
+	if len(terms) == 0 {
+		return fmt.Errorf("a build needs a goos, goarch or tags")
+	}
+	for _, term := range terms {
+		if !isIdentifier(term) {
+			return fmt.Errorf("%q is not a valid build tag", term)
+		}
+	}
+	return nil
+}
+
+func isIdentifier(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+	for _, r := range s {
+		if !(r == '_' || r == '.' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9')) {
+			return false
+		}
+	}
+	return true
+}
+
+// expr returns the build constraint, which is satisfied by the build.
+func (b Build) expr() constraint.Expr {
+	var x constraint.Expr
+	for _, term := range b.terms() {
+		var t constraint.Expr = &constraint.TagExpr{Tag: term}
+		if x == nil {
+			x = t
+		} else {
+			x = &constraint.AndExpr{X: x, Y: t}
+		}
+	}
+	return x
+}
+
+// disjoint returns whether no build can satisfy both builds, because they are for different platforms.
+func (b Build) disjoint(that Build) bool {
+	return (len(b.GOOS) > 0 && len(that.GOOS) > 0 && b.GOOS != that.GOOS) ||
+		(len(b.GOARCH) > 0 && len(that.GOARCH) > 0 && b.GOARCH != that.GOARCH)
+}
+
+// env returns the environment in which go list is run, to load packages for this build.
+func (b Build) env() []string {
+	env := os.Environ()
+	if len(b.GOOS) > 0 {
+		env = append(env, "GOOS="+b.GOOS)
+	}
+	if len(b.GOARCH) > 0 {
+		env = append(env, "GOARCH="+b.GOARCH)
+	}
+	return env
+}
+
+// buildFlags returns the build flags, with the tags of the build added to the -tags flag.
+func (b Build) buildFlags(buildFlags []string) []string {
+	if len(b.Tags) == 0 {
+		return buildFlags
+	}
+	flags := make([]string, 0, len(buildFlags)+1)
+	tags := b.Tags
+	for _, flag := range buildFlags {
+		if value, ok := cutTagsFlag(flag); ok {
+			tags = append(strings.Split(value, ","), tags...)
+			continue
+		}
+		flags = append(flags, flag)
+	}
+	return append(flags, "-tags="+strings.Join(tags, ","))
+}
+
+func cutTagsFlag(flag string) (string, bool) {
+	for _, prefix := range []string{"-tags=", "--tags="} {
+		if strings.HasPrefix(flag, prefix) {
+			return flag[len(prefix):], true
+		}
+	}
+	return "", false
+}
+
+// sortBuilds sorts the builds from most to least specific,
+// since the generated file of a build is only used if no more specific build is satisfied.
+func sortBuilds(builds []Build) []Build {
+	sorted := append([]Build(nil), builds...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return len(sorted[i].terms()) > len(sorted[j].terms())
+	})
+	return sorted
+}
+
+// buildConstraints returns the //go:build line of the generated file of each of the sorted builds.
+// The builds are made mutually exclusive, by excluding the more specific builds that come before it,
+// for example linux && integration, followed by linux && !(linux && integration).
+func buildConstraints(builds []Build) []string {
+	lines := make([]string, len(builds))
+	for i, b := range builds {
+		x := b.expr()
+		for _, before := range builds[:i] {
+			if !b.disjoint(before) {
+				x = &constraint.AndExpr{X: x, Y: &constraint.NotExpr{X: before.expr()}}
+			}
+		}
+		lines[i] = "//go:build " + x.String()
+	}
+	return lines
+}
+
+// buildUnit is a package that was loaded for one of its configured builds.
+type buildUnit struct {
+	build Build
+	// unit is nil, if the build constraints exclude all the files of the package.
+	unit  *unit
+	cache *typesCache
+}
+
+// path returns the import path of the package.
+func (u *unit) path() string {
+	pkg := u.first()
+	if len(pkg.ForTest) > 0 {
+		return pkg.ForTest
+	}
+	return pkg.PkgPath
+}
+
+// loadBuilds loads the packages, which are configured with builds, once for each build.
+// Packages that share a build, are loaded together.
+func loadBuilds(fset *token.FileSet, buildFlags []string, units []*unit, configs []packageConfig) ([][]buildUnit, error) {
+	buildUnits := make([][]buildUnit, len(units))
+	type buildPaths struct {
+		build Build
+		paths []string
+	}
+	var byBuild []*buildPaths
+	index := make(map[string]*buildPaths)
+	for i, config := range configs {
+		buildUnits[i] = make([]buildUnit, len(config.builds))
+		for _, b := range config.builds {
+			bp, ok := index[b.name()]
+			if !ok {
+				bp = &buildPaths{build: b}
+				index[b.name()] = bp
+				byBuild = append(byBuild, bp)
+			}
+			bp.paths = append(bp.paths, units[i].path())
+		}
+	}
+	for _, bp := range byBuild {
+		flags := bp.build.buildFlags(buildFlags)
+		env := bp.build.env()
+		loaded, err := load(fset, env, flags, bp.paths...)
+		if err != nil {
+			return nil, fmt.Errorf("build %s: %v", bp.build.name(), err)
+		}
+		cache := newTypesCache(fset, env, flags, loaded)
+		loadedUnits := make(map[string]*unit)
+		for _, u := range newUnits(loaded) {
+			loadedUnits[u.path()] = u
+		}
+		for i, config := range configs {
+			for j, b := range config.builds {
+				if b.name() == bp.build.name() {
+					buildUnits[i][j] = buildUnit{b, loadedUnits[units[i].path()], cache}
+				}
+			}
+		}
+	}
+	return buildUnits, nil
+}
+
+// generateBuilds generates the package for each of its builds in memory and then writes the generated files.
+// Declarations, which are generated exactly the same for all the builds, are written to the usual output files,
+// while all the other declarations are written to the output files of each build, with their build constraints.
+func (pg *program) generateBuilds(buildUnits []buildUnit, config packageConfig, files *overlay, report Reporter) error {
+	dir := ""
+	for _, bu := range buildUnits {
+		if bu.unit != nil {
+			dir = packageDir(bu.unit.first())
+			break
+		}
+	}
+	if len(dir) == 0 {
+		return nil
+	}
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	outputs := []string{config.output, config.testOutput, config.xtestOutput}
+	var buildOutputs []string
+	for _, b := range config.builds {
+		for _, output := range outputs {
+			buildOutputs = append(buildOutputs, b.filename(output))
+		}
+	}
+
+	generated := make([]*overlay, len(buildUnits))
+	for i, bu := range buildUnits {
+		// Each build starts from the functions, which are shared by all builds,
+		// so that the functions in the files of the builds are generated again.
+		bfiles := newOverlay(true)
+		for _, name := range buildOutputs {
+			if err := bfiles.Remove(filepath.Join(dir, name)); err != nil {
+				return err
+			}
+		}
+		generated[i] = bfiles
+		if bu.unit == nil {
+			continue
+		}
+		for _, t := range bu.unit.targets(config) {
+			t.generated = append(t.generated, buildOutputs...)
+			t.pkg, err = bu.cache.reload(t.pkg, bfiles, t.generated)
+			if err != nil {
+				return err
+			}
+			if err := pg.generatePackage(bu.cache, t, config, bfiles, report); err != nil {
+				return fmt.Errorf("build %s: %v", bu.build.name(), err)
+			}
+		}
+	}
+
+	// Source files, which were renamed by autoname or dedup, are written as they were renamed by the first build.
+	isOutput := make(map[string]bool)
+	for _, name := range append(outputs, buildOutputs...) {
+		isOutput[filepath.Join(dir, name)] = true
+	}
+	written := make(map[string]bool)
+	for _, bfiles := range generated {
+		for _, filename := range bfiles.order {
+			if isOutput[filename] || written[filename] {
+				continue
+			}
+			written[filename] = true
+			if err := files.WriteFile(filename, bfiles.files[filename]); err != nil {
+				return err
+			}
+		}
+	}
+
+	constraints := buildConstraints(config.builds)
+	for _, output := range outputs {
+		filename := filepath.Join(dir, output)
+		contents := make([][]byte, len(generated))
+		for i, bfiles := range generated {
+			contents[i] = bfiles.files[filename]
+		}
+		shared, perBuild, err := splitBuilds(filename, contents, constraints)
+		if err != nil {
+			return err
+		}
+		if err := writeOrRemove(files, filename, shared); err != nil {
+			return err
+		}
+		for i, b := range config.builds {
+			if err := writeOrRemove(files, filepath.Join(dir, b.filename(output)), perBuild[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func writeOrRemove(files *overlay, filename string, content []byte) error {
+	if content == nil {
+		return files.Remove(filename)
+	}
+	return files.WriteFile(filename, content)
+}
+
+// generatedFile is a generated file, parsed into its declarations.
+type generatedFile struct {
+	pkgName string
+	// imports are the import lines, indexed by the name under which the package is imported.
+	imports map[string]string
+	names   []string
+	decls   map[string]string
+	// uses are the names of the imports, which are used by each declaration.
+	uses map[string][]string
+}
+
+func parseGenerated(filename string, src []byte) (*generatedFile, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	g := &generatedFile{
+		pkgName: f.Name.Name,
+		imports: make(map[string]string),
+		decls:   make(map[string]string),
+		uses:    make(map[string][]string),
+	}
+	offset := func(pos token.Pos) int {
+		return fset.Position(pos).Offset
+	}
+	for _, imp := range f.Imports {
+		path := strings.Trim(imp.Path.Value, `"`)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		g.imports[name] = string(src[offset(imp.Pos()):offset(imp.End())])
+	}
+	for _, decl := range f.Decls {
+		var name string
+		var doc *ast.CommentGroup
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			name, doc = d.Name.Name, d.Doc
+			if d.Recv != nil && len(d.Recv.List) > 0 {
+				// methods with the same name on different receivers are different declarations
+				name = recvTypeName(d.Recv.List[0].Type) + "." + name
+			}
+		case *ast.GenDecl:
+			if d.Tok == token.IMPORT {
+				continue
+			}
+			doc = d.Doc
+			switch s := d.Specs[0].(type) {
+			case *ast.TypeSpec:
+				name = s.Name.Name
+			case *ast.ValueSpec:
+				name = s.Names[0].Name
+			}
+		}
+		start := decl.Pos()
+		if doc != nil {
+			start = doc.Pos()
+		}
+		g.names = append(g.names, name)
+		g.decls[name] = string(src[offset(start):offset(decl.End())])
+		ast.Inspect(decl, func(node ast.Node) bool {
+			if sel, ok := node.(*ast.SelectorExpr); ok {
+				if x, ok := sel.X.(*ast.Ident); ok {
+					if _, ok := g.imports[x.Name]; ok {
+						g.uses[name] = append(g.uses[name], x.Name)
+					}
+				}
+			}
+			return true
+		})
+	}
+	return g, nil
+}
+
+// recvTypeName returns the name of the receiver's type, without the pointer and type parameters.
+func recvTypeName(typ ast.Expr) string {
+	switch t := typ.(type) {
+	case *ast.StarExpr:
+		return recvTypeName(t.X)
+	case *ast.ParenExpr:
+		return recvTypeName(t.X)
+	case *ast.IndexExpr:
+		return recvTypeName(t.X)
+	case *ast.IndexListExpr:
+		return recvTypeName(t.X)
+	case *ast.Ident:
+		return t.Name
+	}
+	return ""
+}
+
+// print returns the source of a generated file, with the named declarations and the imports that they use.
+func (g *generatedFile) print(buildLine string, names []string) ([]byte, error) {
+	body := bytes.NewBuffer(nil)
+	used := make(map[string]bool)
+	var imports []string
+	for _, name := range names {
+		body.WriteString("\n" + g.decls[name] + "\n")
+		for _, imp := range g.uses[name] {
+			if !used[imp] {
+				used[imp] = true
+				imports = append(imports, g.imports[imp])
+			}
+		}
+	}
+	buf := bytes.NewBuffer(nil)
+	buf.WriteString("// Code generated by goderive DO NOT EDIT.\n\n")
+	if len(buildLine) > 0 {
+		buf.WriteString(buildLine + "\n\n")
+	}
+	buf.WriteString("package " + g.pkgName + "\n")
+	if len(imports) > 0 {
+		buf.WriteString("\nimport (\n\t" + strings.Join(imports, "\n\t") + "\n)\n")
+	}
+	body.WriteTo(buf)
+	return format.Source(buf.Bytes())
+}
+
+// splitBuilds splits the generated file of each build into a file with the declarations, which are the same for all builds,
+// and a file for each build, with the remaining declarations.
+// Files without any declarations are nil.
+func splitBuilds(filename string, contents [][]byte, constraints []string) ([]byte, [][]byte, error) {
+	files := make([]*generatedFile, len(contents))
+	for i, content := range contents {
+		if content == nil {
+			continue
+		}
+		var err error
+		files[i], err = parseGenerated(filename, content)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	perBuild := make([][]byte, len(contents))
+	var sharedNames []string
+	var first *generatedFile
+	if len(files) > 0 && files[0] != nil {
+		first = files[0]
+		for _, name := range first.names {
+			same := true
+			for _, f := range files[1:] {
+				if f == nil || f.decls[name] != first.decls[name] {
+					same = false
+					break
+				}
+			}
+			if same {
+				sharedNames = append(sharedNames, name)
+			}
+		}
+	}
+	isShared := make(map[string]bool, len(sharedNames))
+	for _, name := range sharedNames {
+		isShared[name] = true
+	}
+	for i, f := range files {
+		if f == nil {
+			continue
+		}
+		var names []string
+		for _, name := range f.names {
+			if !isShared[name] {
+				names = append(names, name)
+			}
+		}
+		if len(names) == 0 {
+			continue
+		}
+		var err error
+		perBuild[i], err = f.print(constraints[i], names)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %v", filename, err)
+		}
+	}
+	if len(sharedNames) == 0 {
+		return nil, perBuild, nil
+	}
+	shared, err := first.print("", sharedNames)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	return shared, perBuild, nil
+}
@@ -0,0 +1,360 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	yamlConfigFilename = "goderive.yaml"
+	tomlConfigFilename = "goderive.toml"
+)
+
+// Config configures goderive for a package.
+// It is read from a goderive.yaml or goderive.toml file in the package directory or the nearest ancestor directory,
+// for example:
+//
+//	prefix: generate
+//	pluginprefix:
+//	  equal: eq
+//	disable:
+//	  - hash
+//	autoname: true
+//	output: zz_derived.gen.go
+//	builds:
+//	  - goos: linux
+//	  - goos: windows
+//	plugins:
+//	  - ./bin/goderive-plugin-same
+//
+// Fields that are not set, keep their default value.
+type Config struct {
+	// Prefix replaces derive in the prefixes of all the plugins, for example generateEqual instead of deriveEqual.
+	Prefix string `yaml:"prefix" toml:"prefix"`
+	// PluginPrefix overrides the prefixes of specific plugins, indexed by plugin name.
+	PluginPrefix map[string]string `yaml:"pluginprefix" toml:"pluginprefix"`
+	// Disable lists the names of the plugins, of which calls are not generated.
+	// Disabled plugins are still used to generate the dependencies of other plugins.
+	Disable []string `yaml:"disable" toml:"disable"`
+	// Autoname renames functions that are conflicting with other functions.
+	Autoname *bool `yaml:"autoname" toml:"autoname"`
+	// Dedup renames functions to functions that are duplicates.
+	Dedup *bool `yaml:"dedup" toml:"dedup"`
+	// Output is the name of the generated file, by default derived.gen.go.
+	// The functions, which are only called from test files, are generated into test files named after it,
+	// see TestOutputFilename and ExternalTestOutputFilename.
+	Output string `yaml:"output" toml:"output"`
+	// Builds are the platforms and build tags, under which the package is loaded and generated.
+	// Functions, which are not generated the same for all builds, are generated into a file for each build,
+	// with a //go:build line, for example derived_linux.gen.go.
+	// By default the package is only loaded for the host platform and the -tags flag.
+	Builds []Build `yaml:"builds" toml:"builds"`
+	// Plugins are the executables of external plugins, in addition to the goderive-plugin- executables on the PATH.
+	// A name is looked up on the PATH, while a path is relative to the directory of the config file.
+	Plugins []string `yaml:"plugins" toml:"plugins"`
+}
+
+// OutputFilename returns the name of the generated file.
+func (c Config) OutputFilename() string {
+	if len(c.Output) == 0 {
+		return derivedFilename
+	}
+	return c.Output
+}
+
+// TestOutputFilename returns the name of the generated test file,
+// which contains the functions that are only called from the in-package test files, by default derived.gen_test.go.
+func (c Config) TestOutputFilename() string {
+	return strings.TrimSuffix(c.OutputFilename(), ".go") + "_test.go"
+}
+
+// ExternalTestOutputFilename returns the name of the generated test file,
+// which contains the functions that are called from the external test package, with the _test suffix,
+// by default derived.gen_x_test.go.
+func (c Config) ExternalTestOutputFilename() string {
+	return strings.TrimSuffix(c.OutputFilename(), ".go") + "_x_test.go"
+}
+
+// merge returns the config, with the fields that are set in that config overridden.
+func (c Config) merge(that Config) Config {
+	if len(that.Prefix) > 0 {
+		c.Prefix = that.Prefix
+	}
+	if len(that.PluginPrefix) > 0 {
+		prefixes := make(map[string]string, len(c.PluginPrefix)+len(that.PluginPrefix))
+		for name, prefix := range c.PluginPrefix {
+			prefixes[name] = prefix
+		}
+		for name, prefix := range that.PluginPrefix {
+			prefixes[name] = prefix
+		}
+		c.PluginPrefix = prefixes
+	}
+	if that.Disable != nil {
+		c.Disable = append(append([]string(nil), c.Disable...), that.Disable...)
+	}
+	if that.Autoname != nil {
+		c.Autoname = that.Autoname
+	}
+	if that.Dedup != nil {
+		c.Dedup = that.Dedup
+	}
+	if len(that.Output) > 0 {
+		c.Output = that.Output
+	}
+	if that.Builds != nil {
+		c.Builds = that.Builds
+	}
+	if that.Plugins != nil {
+		c.Plugins = append(append([]string(nil), c.Plugins...), that.Plugins...)
+	}
+	return c
+}
+
+func (c Config) validate(plugins []Plugin) error {
+	names := make(map[string]bool, len(plugins))
+	for _, p := range plugins {
+		if names[p.Name()] {
+			return fmt.Errorf("plugins: there are two plugins named %q", p.Name())
+		}
+		names[p.Name()] = true
+	}
+	for name := range c.PluginPrefix {
+		if !names[name] {
+			return fmt.Errorf("pluginprefix: unknown plugin %q", name)
+		}
+	}
+	for _, name := range c.Disable {
+		if !names[name] {
+			return fmt.Errorf("disable: unknown plugin %q", name)
+		}
+	}
+	if strings.ContainsRune(c.Output, filepath.Separator) || strings.Contains(c.Output, "/") {
+		return fmt.Errorf("output: %q must be a filename, not a path", c.Output)
+	}
+	if len(c.Output) > 0 && !strings.HasSuffix(c.Output, ".go") {
+		return fmt.Errorf("output: %q must end in .go", c.Output)
+	}
+	if strings.HasSuffix(c.Output, "_test.go") {
+		return fmt.Errorf("output: %q cannot be a test file", c.Output)
+	}
+	names = make(map[string]bool, len(c.Builds))
+	for _, b := range c.Builds {
+		if err := b.validate(); err != nil {
+			return fmt.Errorf("builds: %v", err)
+		}
+		if names[b.name()] {
+			return fmt.Errorf("builds: duplicate build %s", b.name())
+		}
+		names[b.name()] = true
+	}
+	return nil
+}
+
+// packageConfig is the resolved configuration of a package.
+type packageConfig struct {
+	// plugins are configured with their prefix for this package and sorted by prefix.
+	plugins     []Plugin
+	disabled    map[string]bool
+	autoname    bool
+	dedup       bool
+	output      string
+	testOutput  string
+	xtestOutput string
+	// builds are sorted from most to least specific.
+	builds []Build
+	// directives are the directives of the plugins, which support them, indexed by plugin name.
+	directives map[string]Directive
+}
+
+// hasPrefixOf returns whether the function name starts with the prefix of any of the plugins, even if it is disabled.
+func (pc packageConfig) hasPrefixOf(funcName string) bool {
+	for _, p := range pc.plugins {
+		if strings.HasPrefix(funcName, p.GetPrefix()) {
+			return true
+		}
+	}
+	return false
+}
+
+// configuredPlugin is a plugin with the prefix that is configured for a specific package.
+type configuredPlugin struct {
+	Plugin
+	prefix string
+}
+
+func (p *configuredPlugin) GetPrefix() string {
+	return p.prefix
+}
+
+func (p *configuredPlugin) SetPrefix(prefix string) {
+	p.prefix = prefix
+}
+
+func (c Config) resolve(plugins []Plugin) packageConfig {
+	pc := packageConfig{
+		plugins:     make([]Plugin, len(plugins)),
+		disabled:    make(map[string]bool, len(c.Disable)),
+		autoname:    c.Autoname != nil && *c.Autoname,
+		dedup:       c.Dedup != nil && *c.Dedup,
+		output:      c.OutputFilename(),
+		testOutput:  c.TestOutputFilename(),
+		xtestOutput: c.ExternalTestOutputFilename(),
+		builds:      sortBuilds(c.Builds),
+		directives:  make(map[string]Directive),
+	}
+	for i, p := range plugins {
+		if dp, ok := p.(DirectivePlugin); ok {
+			pc.directives[p.Name()] = dp.Directive()
+		}
+		prefix := p.GetPrefix()
+		if len(c.Prefix) > 0 {
+			prefix = strings.Replace(prefix, "derive", c.Prefix, 1)
+		}
+		if override, ok := c.PluginPrefix[p.Name()]; ok {
+			prefix = override
+		}
+		pc.plugins[i] = &configuredPlugin{p, prefix}
+	}
+	sortPlugins(pc.plugins)
+	for _, name := range c.Disable {
+		pc.disabled[name] = true
+	}
+	return pc
+}
+
+// FindConfig returns the configuration from the goderive.yaml or goderive.toml file
+// in the directory or its nearest ancestor directory, that contains one.
+// The zero Config is returned, if there is no such file.
+func FindConfig(dir string) (Config, error) {
+	return newConfigs().find(dir)
+}
+
+// configs caches the config files by directory, since many packages share the same config.
+type configs struct {
+	mu    sync.Mutex
+	byDir map[string]*configResult
+}
+
+type configResult struct {
+	config Config
+	err    error
+}
+
+func newConfigs() *configs {
+	return &configs{byDir: make(map[string]*configResult)}
+}
+
+func (cs *configs) find(dir string) (Config, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return Config{}, err
+	}
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	r := cs.findLocked(abs)
+	return r.config, r.err
+}
+
+func (cs *configs) findLocked(dir string) *configResult {
+	if r, ok := cs.byDir[dir]; ok {
+		return r
+	}
+	r, found := readConfigDir(dir)
+	if !found {
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			r = &configResult{}
+		} else {
+			r = cs.findLocked(parent)
+		}
+	}
+	cs.byDir[dir] = r
+	return r
+}
+
+// readConfigDir reads the config file in the directory, if there is one.
+func readConfigDir(dir string) (*configResult, bool) {
+	yamlFilename := filepath.Join(dir, yamlConfigFilename)
+	tomlFilename := filepath.Join(dir, tomlConfigFilename)
+	yamlData, yamlErr := os.ReadFile(yamlFilename)
+	tomlData, tomlErr := os.ReadFile(tomlFilename)
+	switch {
+	case yamlErr == nil && tomlErr == nil:
+		return &configResult{err: fmt.Errorf("%s: cannot have both %s and %s", dir, yamlConfigFilename, tomlConfigFilename)}, true
+	case yamlErr == nil:
+		c, err := parseYAMLConfig(yamlData)
+		if err != nil {
+			err = fmt.Errorf("%s: %v", yamlFilename, err)
+		}
+		return &configResult{c.relativeTo(dir), err}, true
+	case tomlErr == nil:
+		c, err := parseTOMLConfig(tomlData)
+		if err != nil {
+			err = fmt.Errorf("%s: %v", tomlFilename, err)
+		}
+		return &configResult{c.relativeTo(dir), err}, true
+	}
+	return nil, false
+}
+
+// relativeTo returns the config with the paths of plugins made relative to the directory of the config file.
+func (c Config) relativeTo(dir string) Config {
+	if c.Plugins == nil {
+		return c
+	}
+	plugins := make([]string, len(c.Plugins))
+	for i, p := range c.Plugins {
+		if (strings.ContainsRune(p, filepath.Separator) || strings.Contains(p, "/")) && !filepath.IsAbs(p) {
+			p = filepath.Join(dir, p)
+		}
+		plugins[i] = p
+	}
+	c.Plugins = plugins
+	return c
+}
+
+func parseYAMLConfig(data []byte) (Config, error) {
+	var c Config
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	// An empty file is a valid config.
+	if err := dec.Decode(&c); err != nil && err != io.EOF {
+		return Config{}, err
+	}
+	return c, nil
+}
+
+func parseTOMLConfig(data []byte) (Config, error) {
+	var c Config
+	meta, err := toml.Decode(string(data), &c)
+	if err != nil {
+		return Config{}, err
+	}
+	if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+		return Config{}, fmt.Errorf("unknown field %s", undecoded[0])
+	}
+	return c, nil
+}
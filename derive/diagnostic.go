@@ -0,0 +1,148 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"encoding/json"
+	"errors"
+	"go/token"
+	"log"
+	"strings"
+)
+
+// Code is a stable identifier for a kind of diagnostic, which tools can match on.
+type Code string
+
+const (
+	// CodeLoad is reported when the packages could not be loaded.
+	CodeLoad Code = "load"
+	// CodeInvalidCall is reported when a plugin does not accept the arguments of a call to a derived function.
+	CodeInvalidCall Code = "invalid-call"
+	// CodeNameConflict is reported when two calls with different types use the same function name,
+	// or when two names are used for the same types, see the autoname and dedup flags.
+	CodeNameConflict Code = "name-conflict"
+	// CodeInvalidDirective is reported when a //goderive: directive on a type declaration cannot be derived,
+	// for example because it names an unknown plugin or an unknown option.
+	CodeInvalidDirective Code = "invalid-directive"
+	// CodeInvalidTag is reported when a derive struct tag names an unknown plugin or an unknown option.
+	CodeInvalidTag Code = "invalid-tag"
+	// CodeGenerate is reported when a plugin fails to generate a function.
+	CodeGenerate Code = "generate"
+	// CodeUndefined is reported when the types of the arguments of a call are not known yet,
+	// because they depend on other functions that still need to be generated.
+	CodeUndefined Code = "undefined"
+	// CodeCannotGenerate is reported when the types of the arguments of a call never became known.
+	CodeCannotGenerate Code = "cannot-generate"
+	// CodeRenamed is reported when a call is renamed, because of the autoname or dedup flags.
+	CodeRenamed Code = "renamed"
+	// CodeInternal is reported for any other error, for example when a file could not be written.
+	CodeInternal Code = "internal"
+)
+
+// Severity is the severity of a diagnostic.
+type Severity string
+
+const (
+	// SeverityError is a diagnostic that stops goderive.
+	SeverityError Severity = "error"
+	// SeverityWarning is a diagnostic that might become an error, if it persists.
+	SeverityWarning Severity = "warning"
+	// SeverityInfo is a diagnostic that is only informational.
+	SeverityInfo Severity = "info"
+)
+
+// Diagnostic is a message about a call to a derived function.
+// Pos is the position of the call, which is not valid for diagnostics that are not about a specific call.
+type Diagnostic struct {
+	Pos      token.Position
+	Plugin   string
+	Code     Code
+	Severity Severity
+	Message  string
+}
+
+// String returns the diagnostic in the file:line:column: message format of the go tool.
+func (d Diagnostic) String() string {
+	ss := make([]string, 0, 3)
+	if d.Pos.IsValid() {
+		ss = append(ss, d.Pos.String())
+	}
+	if len(d.Plugin) > 0 {
+		ss = append(ss, d.Plugin)
+	}
+	ss = append(ss, d.Message+" ("+string(d.Code)+")")
+	return strings.Join(ss, ": ")
+}
+
+type jsonDiagnostic struct {
+	File     string   `json:"file,omitempty"`
+	Line     int      `json:"line,omitempty"`
+	Column   int      `json:"column,omitempty"`
+	Plugin   string   `json:"plugin,omitempty"`
+	Code     Code     `json:"code"`
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+}
+
+// MarshalJSON returns the diagnostic as a flat JSON object, with the position split into file, line and column.
+func (d Diagnostic) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonDiagnostic{
+		File:     d.Pos.Filename,
+		Line:     d.Pos.Line,
+		Column:   d.Pos.Column,
+		Plugin:   d.Plugin,
+		Code:     d.Code,
+		Severity: d.Severity,
+		Message:  d.Message,
+	})
+}
+
+// Diagnostics is an error consisting of one or more diagnostics.
+type Diagnostics []Diagnostic
+
+func (ds Diagnostics) Error() string {
+	ss := make([]string, len(ds))
+	for i, d := range ds {
+		ss[i] = d.String()
+	}
+	return strings.Join(ss, "\n")
+}
+
+// AsDiagnostics returns the diagnostics that the error consists of.
+// Errors, which are not diagnostics, are returned as a single diagnostic with the given code.
+func AsDiagnostics(err error, code Code) Diagnostics {
+	var ds Diagnostics
+	if errors.As(err, &ds) {
+		return ds
+	}
+	return Diagnostics{{Code: code, Severity: SeverityError, Message: err.Error()}}
+}
+
+// Reporter receives the diagnostics, which do not stop goderive, like warnings.
+type Reporter func(d Diagnostic)
+
+// logReporter logs diagnostics, like goderive always has.
+func logReporter(d Diagnostic) {
+	log.Print(d.String())
+}
+
+// nameError is returned by the TypesMap, when function names are conflicting.
+type nameError struct {
+	msg string
+}
+
+func (e *nameError) Error() string {
+	return e.msg
+}
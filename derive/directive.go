@@ -0,0 +1,228 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// directivePrefix starts a comment on a type declaration, which lists the plugins that derive functions for the type.
+const directivePrefix = "//goderive:"
+
+// directive is a //goderive: comment on a type declaration.
+type directive struct {
+	comment *ast.Comment
+	// typ is nil, if the type declaration could not be type checked.
+	typ *types.TypeName
+}
+
+// findDirectives returns the directives in the doc comments of the type declarations in the file.
+func findDirectives(pkg *packages.Package, astFile *ast.File) []*directive {
+	var ds []*directive
+	for _, decl := range astFile.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			s := spec.(*ast.TypeSpec)
+			doc := s.Doc
+			if doc == nil && !gen.Lparen.IsValid() {
+				doc = gen.Doc
+			}
+			if doc == nil {
+				continue
+			}
+			typ, _ := pkg.TypesInfo.Defs[s.Name].(*types.TypeName)
+			for _, c := range doc.List {
+				if strings.HasPrefix(c.Text, directivePrefix) {
+					ds = append(ds, &directive{c, typ})
+				}
+			}
+		}
+	}
+	return ds
+}
+
+// directiveEntry is a plugin, which is listed in a directive, with its options.
+type directiveEntry struct {
+	plugin string
+	// method is whether the method is generated and methodName optionally overrides its name.
+	method     bool
+	methodName string
+}
+
+// parseDirective parses the plugins, which are listed in a directive, with their options, for example:
+//
+//	//goderive:equal(method),hash(method=Hash64),deepcopy
+func parseDirective(text string) ([]directiveEntry, error) {
+	list := strings.TrimSpace(strings.TrimPrefix(text, directivePrefix))
+	if len(list) == 0 {
+		return nil, fmt.Errorf("no plugins are listed")
+	}
+	var entries []directiveEntry
+	for len(list) > 0 {
+		end := strings.IndexAny(list, ",(")
+		if end < 0 {
+			end = len(list)
+		}
+		e := directiveEntry{plugin: strings.TrimSpace(list[:end])}
+		if len(e.plugin) == 0 {
+			return nil, fmt.Errorf("missing plugin name in %q", text)
+		}
+		list = list[end:]
+		if strings.HasPrefix(list, "(") {
+			end = strings.Index(list, ")")
+			if end < 0 {
+				return nil, fmt.Errorf("missing ) after the options of %s", e.plugin)
+			}
+			for _, opt := range strings.Split(list[1:end], ",") {
+				key, value, hasValue := strings.Cut(strings.TrimSpace(opt), "=")
+				switch key {
+				case "method":
+					e.method = true
+					if hasValue {
+						e.methodName = strings.TrimSpace(value)
+						if !token.IsIdentifier(e.methodName) {
+							return nil, fmt.Errorf("%s: method %q is not an identifier", e.plugin, e.methodName)
+						}
+					}
+				default:
+					return nil, fmt.Errorf("%s: unknown option %q", e.plugin, key)
+				}
+			}
+			list = list[end+1:]
+		}
+		list = strings.TrimSpace(list)
+		if strings.HasPrefix(list, ",") {
+			list = list[1:]
+		} else if len(list) > 0 {
+			return nil, fmt.Errorf("expected , after %s, but got %q", e.plugin, list)
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// addDirective adds the functions, which are derived for the type by the plugins listed in the directive,
+// as if they were called, and prints the methods that are requested with the method option.
+// A function is named after the prefix of the plugin and the type, for example deriveEqualPerson,
+// unless there already is a function for the same types.
+func (pkg *pkg) addDirective(d *directive, directives map[string]Directive, methods map[string]bool) *Diagnostic {
+	*pkg.cursor = pkg.position(d.comment)
+	fail := func(plugin string, format string, args ...interface{}) *Diagnostic {
+		return &Diagnostic{
+			Pos:      *pkg.cursor,
+			Plugin:   plugin,
+			Code:     CodeInvalidDirective,
+			Severity: SeverityError,
+			Message:  fmt.Sprintf(format, args...),
+		}
+	}
+	entries, err := parseDirective(d.comment.Text)
+	if err != nil {
+		return fail("", "%v", err)
+	}
+	if d.typ == nil {
+		return fail("", "the type of the directive is not known")
+	}
+	named, ok := d.typ.Type().(*types.Named)
+	if !ok || d.typ.IsAlias() {
+		return fail("", "directives are not supported on the alias %s", d.typ.Name())
+	}
+	if named.TypeParams().Len() > 0 {
+		return fail("", "directives are not supported on the generic type %s", d.typ.Name())
+	}
+	var recv types.Type = named
+	if _, ok := named.Underlying().(*types.Struct); ok {
+		recv = types.NewPointer(named)
+	}
+	for _, e := range entries {
+		p := pkg.pluginNamed(e.plugin)
+		if p == nil {
+			return fail("", "unknown plugin %s", e.plugin)
+		}
+		if pkg.disabled[e.plugin] {
+			return fail(e.plugin, "plugin %s is disabled", e.plugin)
+		}
+		dir, ok := directives[e.plugin]
+		if !ok {
+			return fail(e.plugin, "plugin %s does not support directives", e.plugin)
+		}
+		args := dir.Args(recv)
+		tm := pkg.typesmaps[e.plugin]
+		name, ok := tm.nameOf(args)
+		if !ok {
+			name = p.GetPrefix() + exported(d.typ.Name())
+			_, exists := tm.funcToTyps[name]
+			_, reserved := tm.reserved[name]
+			if exists || reserved {
+				name = tm.newName(args)
+			}
+		}
+		name, err := pkg.generators[e.plugin].Add(name, args)
+		if err != nil {
+			return fail(e.plugin, "%v", err)
+		}
+		if !e.method {
+			continue
+		}
+		methodName := dir.Method
+		if len(e.methodName) > 0 {
+			methodName = e.methodName
+		}
+		if methods[d.typ.Name()+"."+methodName] {
+			return fail(e.plugin, "method %s.%s is derived twice", d.typ.Name(), methodName)
+		}
+		methods[d.typ.Name()+"."+methodName] = true
+		for i := 0; i < named.NumMethods(); i++ {
+			m := named.Method(i)
+			file := pkg.info.Fset.File(m.Pos())
+			if m.Name() == methodName && file != nil && filepath.Base(file.Name()) != pkg.output {
+				return fail(e.plugin, "method %s.%s is already declared", d.typ.Name(), methodName)
+			}
+		}
+		pkg.printer.P("")
+		for _, line := range strings.Split(fmt.Sprintf(dir.Template, tm.TypeString(recv), methodName, name), "\n") {
+			pkg.printer.P("%s", line)
+		}
+	}
+	return nil
+}
+
+// pluginNamed returns the plugin with the given name, or nil if there is none.
+func (pkg *pkg) pluginNamed(name string) Plugin {
+	for _, p := range pkg.plugins {
+		if p.Name() == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// exported returns the name with its first letter in upper case.
+func exported(name string) string {
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToUpper(r)) + name[size:]
+}
@@ -0,0 +1,495 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ExternalPluginPrefix is the prefix of the names of the executables, which are external plugins,
+// for example goderive-plugin-same is an external plugin.
+const ExternalPluginPrefix = "goderive-plugin-"
+
+// PluginMessage is a line of JSON, which is sent between goderive and an external plugin, over the plugin's stdin and stdout.
+//
+// goderive sends requests with the methods:
+//
+//	- describe: the plugin responds with its Name and default Prefix.
+//	- add: a call to the function Name was found with the argument Types.
+//	  The plugin responds with the Result function name, usually after a setFuncName request.
+//	- generate: the plugin responds with the Code of the function for the Types, which it was added for.
+//
+// While handling a request, the plugin can send requests of its own, which goderive responds to with a Result:
+//
+//	- setFuncName: sets the function name of the Types, see TypesMap.SetFuncName.
+//	- getFuncName: returns the function name of the Types, see TypesMap.GetFuncName.
+//	- dependency: returns the function name of the Types, which is generated by another Plugin, see Dependency.
+//	- typeString: returns the Types[0] as it should be printed in the generated code.
+//	- import: imports the package at Path, with the preferred Name, and returns the name to use in the generated code.
+//
+// Responses have an empty Method and errors are returned as an Error, instead of a Result.
+type PluginMessage struct {
+	Method string `json:"method,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Prefix string `json:"prefix,omitempty"`
+	Plugin string `json:"plugin,omitempty"`
+	Path   string `json:"path,omitempty"`
+	// Types refers to types by their ID.
+	Types []int `json:"types,omitempty"`
+	// TypeTable describes the types, which have not been sent to the plugin before.
+	TypeTable []PluginType `json:"typeTable,omitempty"`
+	Code      string       `json:"code,omitempty"`
+	Result    string       `json:"result,omitempty"`
+	Error     string       `json:"error,omitempty"`
+}
+
+// PluginType describes a type to an external plugin.
+// Types refer to other types by their ID, which is never zero.
+// Identical types have the same ID.
+type PluginType struct {
+	ID int `json:"id"`
+	// Kind is one of basic, named, typeparam, pointer, slice, array, map, chan, struct, func or interface.
+	Kind string `json:"kind"`
+	// String is the type including the full package paths, which is useful in error messages.
+	String string `json:"string"`
+	// Name is the name of a basic, named or type parameter type.
+	Name string `json:"name,omitempty"`
+	// Pkg is the package path of a named type.
+	Pkg string `json:"pkg,omitempty"`
+	// Underlying is the underlying type of a named type or the constraint of a type parameter.
+	Underlying int   `json:"underlying,omitempty"`
+	TypeArgs   []int `json:"typeArgs,omitempty"`
+	// Elem is the element type of a pointer, slice, array, map or chan.
+	Elem int `json:"elem,omitempty"`
+	// Key is the key type of a map.
+	Key int   `json:"key,omitempty"`
+	Len int64 `json:"len,omitempty"`
+	// Dir is the direction of a chan, which is one of both, send or recv.
+	Dir string `json:"dir,omitempty"`
+	// Fields are the fields of a struct or the methods of an interface.
+	Fields   []PluginField `json:"fields,omitempty"`
+	Params   []int         `json:"params,omitempty"`
+	Results  []int         `json:"results,omitempty"`
+	Variadic bool          `json:"variadic,omitempty"`
+}
+
+// PluginField describes a field of a struct, or a method of an interface.
+type PluginField struct {
+	Name     string `json:"name"`
+	Type     int    `json:"type"`
+	Embedded bool   `json:"embedded,omitempty"`
+	Exported bool   `json:"exported,omitempty"`
+	Tag      string `json:"tag,omitempty"`
+}
+
+// typeTable assigns IDs to the types, which are sent to an external plugin.
+type typeTable struct {
+	ids map[types.Type]int
+	// byString indexes the types by their string, to find identical types.
+	byString map[string][]int
+	types    []types.Type
+	pending  []PluginType
+}
+
+func newTypeTable() *typeTable {
+	return &typeTable{
+		ids:      make(map[types.Type]int),
+		byString: make(map[string][]int),
+	}
+}
+
+func (t *typeTable) typeIDs(typs []types.Type) []int {
+	ids := make([]int, len(typs))
+	for i, typ := range typs {
+		ids[i] = t.id(typ)
+	}
+	return ids
+}
+
+// id returns the ID of the type, while adding the descriptions of new types to the pending descriptions.
+func (t *typeTable) id(typ types.Type) int {
+	typ = types.Unalias(typ)
+	if id, ok := t.ids[typ]; ok {
+		return id
+	}
+	str := typ.String()
+	for _, id := range t.byString[str] {
+		if types.Identical(t.types[id-1], typ) {
+			t.ids[typ] = id
+			return id
+		}
+	}
+	t.types = append(t.types, typ)
+	id := len(t.types)
+	t.ids[typ] = id
+	t.byString[str] = append(t.byString[str], id)
+	d := PluginType{ID: id, String: str}
+	switch typ := typ.(type) {
+	case *types.Basic:
+		d.Kind, d.Name = "basic", typ.Name()
+	case *types.Named:
+		d.Kind, d.Name = "named", typ.Obj().Name()
+		if typ.Obj().Pkg() != nil {
+			d.Pkg = typ.Obj().Pkg().Path()
+		}
+		d.Underlying = t.id(typ.Underlying())
+		if args := typ.TypeArgs(); args != nil {
+			for i := 0; i < args.Len(); i++ {
+				d.TypeArgs = append(d.TypeArgs, t.id(args.At(i)))
+			}
+		}
+	case *types.TypeParam:
+		d.Kind, d.Name = "typeparam", typ.Obj().Name()
+		d.Underlying = t.id(typ.Constraint())
+	case *types.Pointer:
+		d.Kind, d.Elem = "pointer", t.id(typ.Elem())
+	case *types.Slice:
+		d.Kind, d.Elem = "slice", t.id(typ.Elem())
+	case *types.Array:
+		d.Kind, d.Elem, d.Len = "array", t.id(typ.Elem()), typ.Len()
+	case *types.Map:
+		d.Kind, d.Key, d.Elem = "map", t.id(typ.Key()), t.id(typ.Elem())
+	case *types.Chan:
+		d.Kind, d.Elem = "chan", t.id(typ.Elem())
+		d.Dir = map[types.ChanDir]string{types.SendRecv: "both", types.SendOnly: "send", types.RecvOnly: "recv"}[typ.Dir()]
+	case *types.Struct:
+		d.Kind = "struct"
+		for i := 0; i < typ.NumFields(); i++ {
+			f := typ.Field(i)
+			d.Fields = append(d.Fields, PluginField{
+				Name:     f.Name(),
+				Type:     t.id(f.Type()),
+				Embedded: f.Embedded(),
+				Exported: f.Exported(),
+				Tag:      typ.Tag(i),
+			})
+		}
+	case *types.Signature:
+		d.Kind, d.Variadic = "func", typ.Variadic()
+		for i := 0; i < typ.Params().Len(); i++ {
+			d.Params = append(d.Params, t.id(typ.Params().At(i).Type()))
+		}
+		for i := 0; i < typ.Results().Len(); i++ {
+			d.Results = append(d.Results, t.id(typ.Results().At(i).Type()))
+		}
+	case *types.Interface:
+		d.Kind = "interface"
+		for i := 0; i < typ.NumMethods(); i++ {
+			m := typ.Method(i)
+			d.Fields = append(d.Fields, PluginField{Name: m.Name(), Type: t.id(m.Type()), Exported: m.Exported()})
+		}
+	}
+	t.pending = append(t.pending, d)
+	return id
+}
+
+// flush returns the pending descriptions, which still need to be sent to the plugin.
+func (t *typeTable) flush() []PluginType {
+	pending := t.pending
+	t.pending = nil
+	return pending
+}
+
+func (t *typeTable) lookup(ids []int) ([]types.Type, error) {
+	typs := make([]types.Type, len(ids))
+	for i, id := range ids {
+		if id < 1 || id > len(t.types) {
+			return nil, fmt.Errorf("unknown type id %d", id)
+		}
+		typs[i] = t.types[id-1]
+	}
+	return typs, nil
+}
+
+// process is a running external plugin.
+type process struct {
+	path string
+	cmd  *exec.Cmd
+	in   io.WriteCloser
+	enc  *json.Encoder
+	out  *bufio.Scanner
+}
+
+func startProcess(path string) (*process, error) {
+	cmd := exec.Command(path)
+	cmd.Stderr = os.Stderr
+	in, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	out, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("starting plugin %s: %v", path, err)
+	}
+	scanner := bufio.NewScanner(out)
+	scanner.Buffer(nil, 64*1024*1024)
+	return &process{path, cmd, in, json.NewEncoder(in), scanner}, nil
+}
+
+func (p *process) send(msg PluginMessage) error {
+	if err := p.enc.Encode(msg); err != nil {
+		return fmt.Errorf("writing to plugin %s: %v", p.path, err)
+	}
+	return nil
+}
+
+func (p *process) receive() (PluginMessage, error) {
+	var msg PluginMessage
+	if !p.out.Scan() {
+		err := p.out.Err()
+		if err == nil {
+			err = io.ErrUnexpectedEOF
+		}
+		return msg, fmt.Errorf("reading from plugin %s: %v", p.path, err)
+	}
+	if err := json.Unmarshal(p.out.Bytes(), &msg); err != nil {
+		return msg, fmt.Errorf("reading from plugin %s: %v", p.path, err)
+	}
+	return msg, nil
+}
+
+// Close closes the plugin's stdin, which tells it to exit, and waits for it to exit.
+func (p *process) Close() error {
+	p.in.Close()
+	return p.cmd.Wait()
+}
+
+type externalPlugin struct {
+	name   string
+	prefix string
+	path   string
+}
+
+// NewExternalPlugin returns the plugin implemented by the executable at the path,
+// which is asked for its name and default prefix.
+// Its generators start the executable, when they are first used, see PluginMessage for the protocol.
+func NewExternalPlugin(path string) (Plugin, error) {
+	p, err := startProcess(path)
+	if err != nil {
+		return nil, err
+	}
+	defer p.Close()
+	if err := p.send(PluginMessage{Method: "describe"}); err != nil {
+		return nil, err
+	}
+	msg, err := p.receive()
+	if err != nil {
+		return nil, err
+	}
+	if len(msg.Error) > 0 {
+		return nil, fmt.Errorf("plugin %s: %s", path, msg.Error)
+	}
+	if len(msg.Name) == 0 || len(msg.Prefix) == 0 {
+		return nil, fmt.Errorf("plugin %s: describe needs to return a name and a prefix", path)
+	}
+	return &externalPlugin{msg.Name, msg.Prefix, path}, nil
+}
+
+// FindExternalPlugins returns the external plugins, which are executables on the PATH,
+// with names starting with goderive-plugin-.
+// Like the go tool, only the first executable with a name is used, if it is in multiple directories.
+func FindExternalPlugins() ([]Plugin, error) {
+	seen := make(map[string]bool)
+	var paths []string
+	for _, dir := range filepath.SplitList(os.Getenv("PATH")) {
+		matches, _ := filepath.Glob(filepath.Join(dir, ExternalPluginPrefix+"*"))
+		sort.Strings(matches)
+		for _, path := range matches {
+			if seen[filepath.Base(path)] {
+				continue
+			}
+			if info, err := os.Stat(path); err != nil || info.IsDir() || info.Mode()&0111 == 0 {
+				continue
+			}
+			seen[filepath.Base(path)] = true
+			paths = append(paths, path)
+		}
+	}
+	plugins := make([]Plugin, 0, len(paths))
+	for _, path := range paths {
+		p, err := NewExternalPlugin(path)
+		if err != nil {
+			return nil, err
+		}
+		plugins = append(plugins, p)
+	}
+	return plugins, nil
+}
+
+// lookPlugin returns the path of the executable of an external plugin, which is listed in a config file.
+// A name without a path separator is looked up on the PATH, with the goderive-plugin- prefix, if it does not have it yet.
+func lookPlugin(name string) (string, error) {
+	if strings.ContainsRune(name, filepath.Separator) || strings.Contains(name, "/") {
+		return name, nil
+	}
+	if !strings.HasPrefix(name, ExternalPluginPrefix) {
+		name = ExternalPluginPrefix + name
+	}
+	return exec.LookPath(name)
+}
+
+// hasExternal returns whether the external plugin with the executable at path is one of the plugins.
+func hasExternal(plugins []Plugin, path string) bool {
+	for _, p := range plugins {
+		if ext, ok := p.(*externalPlugin); ok && ext.path == path {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *externalPlugin) GetPrefix() string {
+	return p.prefix
+}
+
+func (p *externalPlugin) SetPrefix(prefix string) {
+	p.prefix = prefix
+}
+
+func (p *externalPlugin) Name() string {
+	return p.name
+}
+
+func (p *externalPlugin) New(typesMap TypesMap, printer Printer, deps map[string]Dependency) Generator {
+	return &externalGen{
+		TypesMap: typesMap,
+		printer:  printer,
+		deps:     deps,
+		path:     p.path,
+		table:    newTypeTable(),
+	}
+}
+
+// externalGen is a generator, which is implemented by an external plugin.
+// The plugin is started when it is first needed and it keeps running until the generator is closed.
+type externalGen struct {
+	TypesMap
+	printer Printer
+	deps    map[string]Dependency
+	path    string
+	table   *typeTable
+	process *process
+}
+
+func (g *externalGen) Add(name string, typs []types.Type) (string, error) {
+	msg, err := g.call(PluginMessage{Method: "add", Name: name, Types: g.table.typeIDs(typs)})
+	if err != nil {
+		return "", err
+	}
+	return msg.Result, nil
+}
+
+func (g *externalGen) Generate(typs []types.Type) error {
+	g.Generating(typs...)
+	msg, err := g.call(PluginMessage{Method: "generate", Types: g.table.typeIDs(typs)})
+	if err != nil {
+		return err
+	}
+	g.printer.P("")
+	for _, line := range strings.Split(strings.TrimRight(msg.Code, "\n"), "\n") {
+		g.printer.P("%s", line)
+	}
+	return nil
+}
+
+// Close stops the plugin, if it was started.
+func (g *externalGen) Close() error {
+	if g.process == nil {
+		return nil
+	}
+	err := g.process.Close()
+	g.process = nil
+	return err
+}
+
+// call sends the request to the plugin and serves the plugin's requests, until the plugin responds.
+func (g *externalGen) call(req PluginMessage) (PluginMessage, error) {
+	if g.process == nil {
+		p, err := startProcess(g.path)
+		if err != nil {
+			return PluginMessage{}, err
+		}
+		g.process = p
+	}
+	req.TypeTable = g.table.flush()
+	if err := g.process.send(req); err != nil {
+		return PluginMessage{}, err
+	}
+	for {
+		msg, err := g.process.receive()
+		if err != nil {
+			return PluginMessage{}, err
+		}
+		if len(msg.Method) == 0 {
+			if len(msg.Error) > 0 {
+				return PluginMessage{}, fmt.Errorf("%s", msg.Error)
+			}
+			return msg, nil
+		}
+		resp := g.serve(msg)
+		resp.TypeTable = g.table.flush()
+		if err := g.process.send(resp); err != nil {
+			return PluginMessage{}, err
+		}
+	}
+}
+
+// serve responds to a request from the plugin.
+func (g *externalGen) serve(req PluginMessage) PluginMessage {
+	typs, err := g.table.lookup(req.Types)
+	if err != nil {
+		return PluginMessage{Error: err.Error()}
+	}
+	result := ""
+	switch req.Method {
+	case "setFuncName":
+		result, err = g.SetFuncName(req.Name, typs...)
+	case "getFuncName":
+		result = g.GetFuncName(typs...)
+	case "dependency":
+		dep, ok := g.deps[req.Plugin]
+		if !ok {
+			err = fmt.Errorf("unknown plugin %q", req.Plugin)
+			break
+		}
+		result = dep.GetFuncName(typs...)
+	case "typeString":
+		if len(typs) != 1 {
+			err = fmt.Errorf("typeString needs one type, but got %d", len(typs))
+			break
+		}
+		result = g.TypeString(typs[0])
+	case "import":
+		result = g.printer.NewImport(req.Name, req.Path)()
+	default:
+		err = fmt.Errorf("unknown method %q", req.Method)
+	}
+	if err != nil {
+		return PluginMessage{Error: err.Error()}
+	}
+	return PluginMessage{Result: result}
+}
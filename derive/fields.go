@@ -15,7 +15,12 @@
 package derive
 
 import (
+	"fmt"
+	"go/ast"
 	"go/types"
+	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -31,6 +36,141 @@ type Field struct {
 	external bool
 	Type     types.Type
 	typeStr  func() string
+	// Tag is the derive struct tag of the field.
+	Tag Tag
+}
+
+// Tag is the parsed derive struct tag of a field, which controls how plugins treat the field, for example:
+//
+//	type Cache struct {
+//		mu      sync.Mutex `derive:"-"`
+//		updated time.Time  `derive:"equal=-,hash=-"`
+//		parent  *Cache     `derive:"shallow"`
+//		history []string   `derive:"merge=append"`
+//		secret  string     `derive:"string=sensitive"`
+//	}
+//
+// The option - skips the field and the option shallow copies a pointer field by reference, in deepcopy.
+// The option append appends a slice to the destination slice, instead of replacing it, in merge.
+// The option sensitive redacts the value of the field, in string.
+// Several options can be combined, for example derive:"shallow,sensitive".
+// The options are indexed by plugin name, where the options without a plugin name apply to all plugins.
+type Tag map[string]map[string]bool
+
+// tagOptions are the options, which can be used in a derive struct tag.
+var tagOptions = map[string]bool{"-": true, "shallow": true, "append": true, "sensitive": true}
+
+// ParseTag parses the derive struct tag, which is part of the given struct tag.
+func ParseTag(structTag string) Tag {
+	value, ok := reflect.StructTag(structTag).Lookup("derive")
+	if !ok {
+		return nil
+	}
+	tag := make(Tag)
+	for _, opt := range strings.Split(value, ",") {
+		opt = strings.TrimSpace(opt)
+		if len(opt) == 0 {
+			continue
+		}
+		plugin := ""
+		if p, v, ok := strings.Cut(opt, "="); ok {
+			plugin, opt = strings.TrimSpace(p), strings.TrimSpace(v)
+		}
+		if tag[plugin] == nil {
+			tag[plugin] = make(map[string]bool)
+		}
+		tag[plugin][opt] = true
+	}
+	return tag
+}
+
+// Check returns an error, if the tag contains an unknown option or a plugin name, which is not one of the given plugins.
+func (t Tag) Check(plugins map[string]bool) error {
+	names := make([]string, 0, len(t))
+	for plugin := range t {
+		names = append(names, plugin)
+	}
+	sort.Strings(names)
+	for _, plugin := range names {
+		if len(plugin) > 0 && !plugins[plugin] {
+			return fmt.Errorf("unknown plugin %q in derive struct tag", plugin)
+		}
+		opts := make([]string, 0, len(t[plugin]))
+		for opt := range t[plugin] {
+			opts = append(opts, opt)
+		}
+		sort.Strings(opts)
+		for _, opt := range opts {
+			if !tagOptions[opt] {
+				return fmt.Errorf("unknown option %q in derive struct tag", opt)
+			}
+		}
+	}
+	return nil
+}
+
+func (t Tag) option(plugin string, opt string) bool {
+	return t[""][opt] || t[plugin][opt]
+}
+
+// Skip returns whether the field is skipped by the plugin, with derive:"-" or derive:"plugin=-".
+func (t Tag) Skip(plugin string) bool {
+	return t.option(plugin, "-")
+}
+
+// Shallow returns whether the field is copied by reference by the plugin, with derive:"shallow" or derive:"plugin=shallow".
+func (t Tag) Shallow(plugin string) bool {
+	return t.option(plugin, "shallow")
+}
+
+// Append returns whether a slice field is appended by the plugin, instead of replaced, with derive:"append" or derive:"plugin=append".
+func (t Tag) Append(plugin string) bool {
+	return t.option(plugin, "append")
+}
+
+// Sensitive returns whether the value of the field is redacted by the plugin, with derive:"sensitive" or derive:"plugin=sensitive".
+func (t Tag) Sensitive(plugin string) bool {
+	return t.option(plugin, "sensitive")
+}
+
+// checkTags returns a diagnostic for the first derive struct tag in the file, which is not valid for the given plugins.
+func (pkg *pkg) checkTags(file *ast.File) *Diagnostic {
+	plugins := make(map[string]bool, len(pkg.plugins))
+	for _, p := range pkg.plugins {
+		plugins[p.Name()] = true
+	}
+	var diag *Diagnostic
+	ast.Inspect(file, func(node ast.Node) bool {
+		field, ok := node.(*ast.Field)
+		if !ok || field.Tag == nil || diag != nil {
+			return diag == nil
+		}
+		structTag, err := strconv.Unquote(field.Tag.Value)
+		if err != nil {
+			return true
+		}
+		if err := ParseTag(structTag).Check(plugins); err != nil {
+			diag = &Diagnostic{
+				Pos:      pkg.position(field.Tag),
+				Code:     CodeInvalidTag,
+				Severity: SeverityError,
+				Message:  err.Error(),
+			}
+		}
+		return true
+	})
+	return diag
+}
+
+// SkipsFields returns whether the plugin skips any of the fields of the struct, because of their derive struct tags.
+// Structs, which skip fields, cannot be compared or copied as a whole.
+func SkipsFields(typ *types.Struct, plugin string) bool {
+	for i := 0; i < typ.NumFields(); i++ {
+		if ParseTag(typ.Tag(i)).Skip(plugin) {
+			return true
+		}
+	}
+	return false
 }
 
 // Name returns the field name, given the receiver and the unsafe import, if needed.
@@ -68,6 +208,7 @@ func Fields(typesMap TypesMap, typ *types.Struct, external bool) *Named {
 			typeStr: func() string {
 				return typesMap.TypeString(fieldType)
 			},
+			Tag: ParseTag(typ.Tag(i)),
 		}
 		if n.Fields[i].Private() {
 			if external {
@@ -78,6 +219,21 @@ func Fields(typesMap TypesMap, typ *types.Struct, external bool) *Named {
 	return n
 }
 
+// For returns the fields, which are not skipped by the plugin, see Tag.
+func (n *Named) For(plugin string) *Named {
+	m := &Named{Fields: make([]*Field, 0, len(n.Fields))}
+	for _, f := range n.Fields {
+		if f.Tag.Skip(plugin) {
+			continue
+		}
+		m.Fields = append(m.Fields, f)
+		if f.Private() && f.external {
+			m.Reflect = true
+		}
+	}
+	return m
+}
+
 func GetStructFields(s *types.Struct) []*types.Var {
 	fields := make([]*types.Var, s.NumFields())
 	for i := 0; i < s.NumFields(); i++ {
@@ -16,10 +16,12 @@ package derive
 
 import (
 	"go/ast"
+	"go/parser"
+	"go/token"
 	"go/types"
 	"path/filepath"
 
-	"golang.org/x/tools/go/loader"
+	"golang.org/x/tools/go/packages"
 )
 
 const derivedFilename = "derived.gen.go"
@@ -30,13 +32,29 @@ type fileInfo struct {
 	undefined []*call
 	derived   []*call
 	funcNames map[string]struct{}
+	// directives are the //goderive: comments on the type declarations in the file.
+	directives []*directive
 }
 
-func newFileInfos(program *loader.Program, pkgInfo *loader.PackageInfo) []*fileInfo {
+// newFileInfos finds the calls in each file of the target package, except for the generated files.
+// Only test files are searched, if the target only generates the calls in test files.
+func newFileInfos(t target) []*fileInfo {
+	pkg := t.pkg
+	// The calls, which are also made from the files of the package itself, are generated into its output file,
+	// even if that has not happened yet, for example when a test variant is analyzed on its own.
+	packageCalls := make(map[string]struct{})
+	if t.testOnly {
+		for _, astFile := range pkg.Syntax {
+			file := pkg.Fset.File(astFile.Pos())
+			if file != nil && !isGenerated(t.generated, filepath.Base(file.Name())) && !isTestFile(file.Name()) {
+				addCallNames(astFile, packageCalls)
+			}
+		}
+	}
 	files := []*fileInfo{}
-	for i := range pkgInfo.Files {
-		astFile := pkgInfo.Files[i]
-		file := program.Fset.File(astFile.Pos())
+	for i := range pkg.Syntax {
+		astFile := pkg.Syntax[i]
+		file := pkg.Fset.File(astFile.Pos())
 		if file == nil {
 			// probably derived.gen.go has non parsable code.
 			continue
@@ -44,37 +62,129 @@ func newFileInfos(program *loader.Program, pkgInfo *loader.PackageInfo) []*fileI
 		fullpath := file.Name()
 
 		_, fname := filepath.Split(fullpath)
-		if fname == derivedFilename {
+		if isGenerated(t.generated, fname) || (t.testOnly && !isTestFile(fname)) {
 			continue
 		}
 
-		f := &finder{program, pkgInfo, nil, nil, make(map[string]struct{})}
+		f := &finder{pkg, t.output, nil, nil, make(map[string]struct{})}
 		for _, d := range astFile.Decls {
 			ast.Walk(f, d)
 		}
-		undefined := make([]*call, len(f.undefined))
-		for i := range f.undefined {
-			undefined[i] = newCall(pkgInfo, f.undefined[i])
+		undefined := make([]*call, 0, len(f.undefined))
+		for _, expr := range f.undefined {
+			c := newCall(pkg, expr)
+			if _, ok := packageCalls[c.Name]; ok {
+				f.funcNames[c.Name] = struct{}{}
+				continue
+			}
+			undefined = append(undefined, c)
 		}
-		derived := make([]*call, len(f.derived))
-		for i := range f.derived {
-			derived[i] = newCall(pkgInfo, f.derived[i])
+		derived := make([]*call, 0, len(f.derived))
+		for _, expr := range f.derived {
+			c := newCall(pkg, expr)
+			if _, ok := packageCalls[c.Name]; ok {
+				f.funcNames[c.Name] = struct{}{}
+				continue
+			}
+			derived = append(derived, c)
 		}
 
 		files = append(files, &fileInfo{
-			astFile:   pkgInfo.Files[i],
-			fullpath:  fullpath,
-			undefined: undefined,
-			derived:   derived,
-			funcNames: f.funcNames,
+			astFile:    pkg.Syntax[i],
+			fullpath:   fullpath,
+			undefined:  undefined,
+			derived:    derived,
+			funcNames:  f.funcNames,
+			directives: findDirectives(pkg, astFile),
 		})
 	}
 	return files
 }
 
+func isGenerated(generated []string, filename string) bool {
+	for _, name := range generated {
+		if name == filename {
+			return true
+		}
+	}
+	return false
+}
+
+// declaredNames returns the names declared at package level, in all the files of the target package,
+// except for its output file, so that generated functions can avoid them.
+func declaredNames(t target) map[string]struct{} {
+	names := make(map[string]struct{})
+	for _, astFile := range t.pkg.Syntax {
+		file := t.pkg.Fset.File(astFile.Pos())
+		if file == nil || filepath.Base(file.Name()) == t.output {
+			continue
+		}
+		for _, decl := range astFile.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				if d.Recv == nil {
+					names[d.Name.Name] = struct{}{}
+				}
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					switch s := spec.(type) {
+					case *ast.TypeSpec:
+						names[s.Name.Name] = struct{}{}
+					case *ast.ValueSpec:
+						for _, name := range s.Names {
+							names[name.Name] = struct{}{}
+						}
+					}
+				}
+			}
+		}
+	}
+	return names
+}
+
+// testCallNames returns the names of the functions called in the test files in the directory of the package.
+// The package itself is generated before its test variants,
+// so it avoids these names for the functions that it generates as dependencies.
+// The test files are only parsed, since the package does not include them.
+func testCallNames(dir string, files *overlay, generated []string) (map[string]struct{}, error) {
+	names := make(map[string]struct{})
+	filenames, err := filepath.Glob(filepath.Join(dir, "*_test.go"))
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	for _, filename := range filenames {
+		if isGenerated(generated, filepath.Base(filename)) {
+			continue
+		}
+		src, err := files.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		// Calls before a syntax error are still found.
+		astFile, _ := parser.ParseFile(fset, filename, src, parser.SkipObjectResolution)
+		if astFile != nil {
+			addCallNames(astFile, names)
+		}
+	}
+	return names, nil
+}
+
+// addCallNames adds the names of the functions, which are called by name in the file.
+func addCallNames(astFile *ast.File, names map[string]struct{}) {
+	ast.Inspect(astFile, func(node ast.Node) bool {
+		if call, ok := node.(*ast.CallExpr); ok {
+			if fn, ok := call.Fun.(*ast.Ident); ok {
+				names[fn.Name] = struct{}{}
+			}
+		}
+		return true
+	})
+}
+
 type finder struct {
-	program   *loader.Program
-	pkgInfo   *loader.PackageInfo
+	pkg       *packages.Package
+	output    string
 	undefined []*ast.CallExpr
 	derived   []*ast.CallExpr
 	funcNames map[string]struct{}
@@ -89,7 +199,7 @@ func (f *finder) Visit(node ast.Node) (w ast.Visitor) {
 	if !ok {
 		return f
 	}
-	def, ok := f.pkgInfo.Uses[fn]
+	def, ok := f.pkg.TypesInfo.Uses[fn]
 	if !ok {
 		f.undefined = append(f.undefined, call)
 		return f
@@ -97,13 +207,13 @@ func (f *finder) Visit(node ast.Node) (w ast.Visitor) {
 	if _, ok := def.(*types.Builtin); ok {
 		return f
 	}
-	file := f.program.Fset.File(def.Pos())
+	file := f.pkg.Fset.File(def.Pos())
 	if file == nil {
 		// probably a cast, for example float64()
 		return f
 	}
 	_, filename := filepath.Split(file.Name())
-	if filename == derivedFilename {
+	if filename == f.output {
 		f.derived = append(f.derived, call)
 		return f
 	}
@@ -117,21 +227,21 @@ type call struct {
 	Args []types.Type
 }
 
-func newCall(pkgInfo *loader.PackageInfo, expr *ast.CallExpr) *call {
+func newCall(pkg *packages.Package, expr *ast.CallExpr) *call {
 	fn, ok := expr.Fun.(*ast.Ident)
 	if !ok {
 		panic("unreachable, finder has already eliminated this option")
 	}
 	name := fn.Name
-	typs := getInputTypes(pkgInfo, expr)
+	typs := getInputTypes(pkg, expr)
 	return &call{expr, name, typs}
 }
 
 // argTypes returns the argument types of a function call.
-func getInputTypes(pkgInfo *loader.PackageInfo, call *ast.CallExpr) []types.Type {
+func getInputTypes(pkg *packages.Package, call *ast.CallExpr) []types.Type {
 	typs := make([]types.Type, len(call.Args))
 	for i, a := range call.Args {
-		typs[i] = pkgInfo.TypeOf(a)
+		typs[i] = pkg.TypesInfo.TypeOf(a)
 	}
 	return typs
 }
@@ -15,46 +15,221 @@
 package derive
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"go/ast"
 	"go/format"
+	"go/token"
 	"go/types"
-	"log"
-	"os"
+	"io"
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 
-	"golang.org/x/tools/go/loader"
+	"golang.org/x/tools/go/packages"
 )
 
 // Program is ready to generate code for a whole program.
 type Program interface {
 	Generate() error
+	// Check generates the code in memory, without writing any files.
+	// For every derived file or renamed source file that is out of date, a unified diff is written to w.
+	// It returns whether all files are up to date.
+	Check(w io.Writer) (bool, error)
+	// SetReporter overrides where diagnostics, which do not stop generation, are reported to.
+	// By default they are logged.
+	SetReporter(report Reporter)
+	// SetJobs sets the number of packages that are generated concurrently, by default one.
+	// Diagnostics and errors are still reported in package order.
+	SetJobs(n int)
 }
 
 // Plugins is a collection of plugins,
-// that given a list of paths becomes a Program.
+// that given a list of package patterns and optional build flags, like -tags, becomes a Program.
 type Plugins interface {
-	Load(paths []string) (Program, error)
+	Load(paths []string, buildFlags ...string) (Program, error)
+	// GeneratePackage generates the code for a single package, which was already loaded and type checked,
+	// for example by an analysis pass, without writing any files.
+	// The ForTest field of a test variant has to be set, like go/packages does,
+	// in which case only the output file of that test variant is generated.
+	// Configured builds are ignored, since the package was only loaded for one build.
+	// It returns the new content of every file that would be written or changed, indexed by filename,
+	// where a file that would be removed has nil content.
+	GeneratePackage(pkg *packages.Package) (map[string][]byte, error)
 }
 
 type plugins struct {
-	plugins  []Plugin
-	autoname bool
-	dedup    bool
+	plugins []Plugin
+	config  Config
+	// findExternal finds more plugins, when a derived function is called, which none of the plugins generate.
+	findExternal func() ([]Plugin, error)
 }
 
 // NewPlugins returns a collection of plugins that is ready to generate code.
+// Config files can still turn on autoname and dedup, if they are false.
 func NewPlugins(ps []Plugin, autoname bool, dedup bool) Plugins {
+	var config Config
+	if autoname {
+		config.Autoname = &autoname
+	}
+	if dedup {
+		config.Dedup = &dedup
+	}
+	return NewPluginsWithConfig(ps, config)
+}
+
+// NewPluginsWithConfig returns a collection of plugins that is ready to generate code,
+// where the fields that are set in the config override those in the config files of each package, see FindConfig.
+func NewPluginsWithConfig(ps []Plugin, config Config) Plugins {
 	sortPlugins(ps)
 	return &plugins{
-		plugins:  ps,
-		autoname: autoname,
-		dedup:    dedup,
+		plugins: ps,
+		config:  config,
 	}
 }
 
+// NewPluginsWithExternal is like NewPluginsWithConfig, but also uses the goderive-plugin- executables on the PATH, see FindExternalPlugins.
+// These are only started to describe themselves, once a derived function is called, which none of the plugins generate.
+func NewPluginsWithExternal(ps []Plugin, config Config) Plugins {
+	p := NewPluginsWithConfig(ps, config).(*plugins)
+	p.findExternal = FindExternalPlugins
+	return p
+}
+
+// addExternal adds the plugins, which are found by findExternal, which is only done once.
+func (p *plugins) addExternal() error {
+	external, err := p.findExternal()
+	if err != nil {
+		return err
+	}
+	p.findExternal = nil
+	p.plugins = append(p.plugins, external...)
+	sortPlugins(p.plugins)
+	return nil
+}
+
+// configure returns the configuration of each unit and the units of its builds.
+// External plugins are added, if a config file refers to a plugin that is not known yet,
+// or if a derived function is called, which none of the known plugins generate.
+func (p *plugins) configure(fset *token.FileSet, buildFlags []string, units []*unit, withBuilds bool) ([]packageConfig, [][]buildUnit, error) {
+	configs, err := p.packageConfigs(units)
+	if err != nil && p.findExternal != nil {
+		if err := p.addExternal(); err != nil {
+			return nil, nil, err
+		}
+		configs, err = p.packageConfigs(units)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	var builds [][]buildUnit
+	if withBuilds {
+		builds, err = loadBuilds(fset, buildFlags, units, configs)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if p.findExternal == nil || !hasUnknownCalls(units, builds, configs) {
+		return configs, builds, nil
+	}
+	if err := p.addExternal(); err != nil {
+		return nil, nil, err
+	}
+	configs, err = p.packageConfigs(units)
+	if err != nil {
+		return nil, nil, err
+	}
+	return configs, builds, nil
+}
+
+// hasUnknownCalls returns whether any variant of the units, or of their builds, calls a function,
+// which is undefined or defined in a generated file, that does not start with the prefix of any of the configured plugins.
+func hasUnknownCalls(units []*unit, builds [][]buildUnit, configs []packageConfig) bool {
+	for i, u := range units {
+		us := []*unit{u}
+		if builds != nil {
+			for _, b := range builds[i] {
+				if b.unit != nil {
+					us = append(us, b.unit)
+				}
+			}
+		}
+		for _, u := range us {
+			for _, t := range u.targets(configs[i]) {
+				for _, f := range newFileInfos(t) {
+					for _, calls := range [][]*call{f.undefined, f.derived} {
+						for _, c := range calls {
+							if !configs[i].hasPrefixOf(c.Name) {
+								return true
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+// packageConfigs returns the configuration of each package, which is shared by its test variants.
+func (p *plugins) packageConfigs(units []*unit) ([]packageConfig, error) {
+	cs := newConfigs()
+	externals := make(map[string]Plugin)
+	pcs := make([]packageConfig, len(units))
+	for i, u := range units {
+		pkg := u.first()
+		var config Config
+		if dir := packageDir(pkg); len(dir) > 0 {
+			var err error
+			config, err = cs.find(dir)
+			if err != nil {
+				return nil, err
+			}
+		}
+		config = config.merge(p.config)
+		plugins, err := p.withExternal(config.Plugins, externals)
+		if err != nil {
+			return nil, fmt.Errorf("config for package %s: %v", pkg.PkgPath, err)
+		}
+		if err := config.validate(plugins); err != nil {
+			return nil, fmt.Errorf("config for package %s: %v", pkg.PkgPath, err)
+		}
+		pcs[i] = config.resolve(plugins)
+	}
+	return pcs, nil
+}
+
+// withExternal returns the plugins, together with the external plugins, which are listed in a config file.
+// External plugins are indexed by the path of their executable, so that they are only started once to describe them.
+func (p *plugins) withExternal(names []string, externals map[string]Plugin) ([]Plugin, error) {
+	if len(names) == 0 {
+		return p.plugins, nil
+	}
+	plugins := append([]Plugin(nil), p.plugins...)
+	for _, name := range names {
+		path, err := lookPlugin(name)
+		if err != nil {
+			return nil, fmt.Errorf("plugins: %v", err)
+		}
+		if hasExternal(plugins, path) {
+			continue
+		}
+		ext, ok := externals[path]
+		if !ok {
+			ext, err = NewExternalPlugin(path)
+			if err != nil {
+				return nil, fmt.Errorf("plugins: %v", err)
+			}
+			externals[path] = ext
+		}
+		plugins = append(plugins, ext)
+	}
+	return plugins, nil
+}
+
 // sortPlugins sorts plugins from biggest to smallest prefix to make sure than conflicts in prefixes are resolved.
 // For example: derivSorted should generated a sorted function and not a sort function.
 func sortPlugins(ps []Plugin) {
@@ -67,25 +242,71 @@ func sortPlugins(ps []Plugin) {
 }
 
 type program struct {
-	plugins  []Plugin
-	autoname bool
-	dedup    bool
-	program  *loader.Program
+	units   []*unit
+	configs []packageConfig
+	cache   *typesCache
+	// builds are the units loaded for each of their configured builds, indexed like units.
+	builds [][]buildUnit
+	report Reporter
+	jobs   int
 }
 
-func (p *plugins) Load(paths []string) (Program, error) {
-	loaded, err := load(paths...)
+func (p *plugins) Load(paths []string, buildFlags ...string) (Program, error) {
+	fset := token.NewFileSet()
+	loaded, err := load(fset, nil, buildFlags, paths...)
+	if err != nil {
+		return nil, err
+	}
+	units := newUnits(loaded)
+	configs, builds, err := p.configure(fset, buildFlags, units, true)
 	if err != nil {
 		return nil, err
 	}
 	return &program{
-		plugins:  p.plugins,
-		autoname: p.autoname,
-		dedup:    p.dedup,
-		program:  loaded,
+		units:   units,
+		configs: configs,
+		cache:   newTypesCache(fset, nil, buildFlags, loaded),
+		builds:  builds,
+		report:  logReporter,
+		jobs:    1,
 	}, nil
 }
 
+func (p *plugins) GeneratePackage(pkg *packages.Package) (map[string][]byte, error) {
+	pkgs := initialPackages([]*packages.Package{pkg})
+	if len(pkgs) == 0 {
+		return nil, nil
+	}
+	units := newUnits(pkgs)
+	configs, _, err := p.configure(pkg.Fset, nil, units, false)
+	if err != nil {
+		return nil, err
+	}
+	pg := &program{
+		units:   units,
+		configs: configs,
+		cache:   newTypesCache(pkg.Fset, nil, nil, pkgs),
+		report:  func(Diagnostic) {},
+		jobs:    1,
+	}
+	overlays, err := pg.generate(true)
+	if err != nil {
+		return nil, err
+	}
+	return overlays[0].files, nil
+}
+
+func (pg *program) SetReporter(report Reporter) {
+	pg.report = report
+}
+
+func (pg *program) SetJobs(n int) {
+	if n < 1 {
+		n = 1
+	}
+	pg.jobs = n
+}
+
 func union(this, that map[string]struct{}) map[string]struct{} {
 	for k := range that {
 		this[k] = struct{}{}
@@ -93,27 +314,37 @@ func union(this, that map[string]struct{}) map[string]struct{} {
 	return this
 }
 
-func newPackage(program *loader.Program, pkgInfo *loader.PackageInfo, plugins []Plugin, autoname, dedup bool) (*pkg, error) {
-	fileInfos := newFileInfos(program, pkgInfo)
+func newPackage(t target, config packageConfig, files *overlay, report Reporter) (_ *pkg, err error) {
+	p := t.pkg
+	plugins := config.plugins
+	fileInfos := newFileInfos(t)
 	fullpath := ""
-	if len(fileInfos) > 0 {
-		abs, err := filepath.Abs(fileInfos[0].fullpath)
+	if dir := packageDir(p); len(dir) > 0 {
+		abs, err := filepath.Abs(dir)
 		if err != nil {
 			return nil, err
 		}
-		fullpath = filepath.Dir(abs)
+		fullpath = abs
 	}
-	reserved := make(map[string]struct{})
+	reserved := declaredNames(t)
 	for _, fileFuncs := range fileInfos {
 		reserved = union(reserved, fileFuncs.funcNames)
 	}
+	if t.output == config.output && len(fullpath) > 0 {
+		testNames, err := testCallNames(fullpath, files, []string{config.testOutput, config.xtestOutput})
+		if err != nil {
+			return nil, err
+		}
+		reserved = union(reserved, testNames)
+	}
 
-	printer := newPrinter(pkgInfo.Pkg.Name())
-	qual := newQualifier(printer, pkgInfo.Pkg)
-	typesmaps := make(map[string]TypesMap, len(plugins))
+	printer := newPrinter(p.Name)
+	qual := newQualifier(printer, p.Types)
+	cursor := &token.Position{}
+	typesmaps := make(map[string]*typesMap, len(plugins))
 	deps := make(map[string]Dependency, len(plugins))
 	for _, plugin := range plugins {
-		tm := newTypesMap(qual, plugin.GetPrefix(), reserved, autoname, dedup)
+		tm := newTypesMap(p.Types, qual, plugin.GetPrefix(), reserved, config.autoname, config.dedup, cursor)
 		deps[plugin.Name()] = tm
 		typesmaps[plugin.Name()] = tm
 	}
@@ -121,7 +352,17 @@ func newPackage(program *loader.Program, pkgInfo *loader.PackageInfo, plugins []
 	for _, plugin := range plugins {
 		generators[plugin.Name()] = plugin.New(typesmaps[plugin.Name()], printer, deps)
 	}
-	pkg := &pkg{pkgInfo, plugins, generators, printer, nil, fullpath}
+	pkg := &pkg{p, plugins, config.disabled, generators, typesmaps, cursor, printer, nil, fullpath, t.output, files}
+	defer func() {
+		if err != nil {
+			pkg.Close()
+		}
+	}()
+	for _, fileInfo := range fileInfos {
+		if err := pkg.checkTags(fileInfo.astFile); err != nil {
+			return nil, Diagnostics{*err}
+		}
+	}
 	for _, fileInfo := range fileInfos {
 
 		changed := false
@@ -132,72 +373,109 @@ func newPackage(program *loader.Program, pkgInfo *loader.PackageInfo, plugins []
 				// Only functions that are supported by a code generator plugin should be added to undefined.
 				// Otherwise things like functions like casts will get into the undefined loop and result in
 				// an error, where a function could not be generated.
-				for _, plugin := range plugins {
-					if strings.HasPrefix(call.Name, plugin.GetPrefix()) {
-						pkg.undefined = append(pkg.undefined, call.Expr)
-						break
-					}
+				if pkg.pluginOf(call.Name) != nil {
+					pkg.undefined = append(pkg.undefined, call.Expr)
 				}
 				continue
 			}
 			name, err := pkg.Add(call)
 			if err != nil {
-				return nil, err
+				return nil, Diagnostics{*err}
 			}
 			if len(name) == 0 {
 				// this call did not match any prefixes of any code generator and is undefined.
 				continue
 			}
 			if name != call.Name {
-				if !autoname && !dedup {
+				if !config.autoname && !config.dedup {
 					panic("unreachable: function names cannot be changed if it is not allowed by the user")
 				}
 				changed = true
-				log.Printf("changing function call name from %s to %s", call.Name, name)
+				report(Diagnostic{
+					Pos:      pkg.position(call.Expr),
+					Plugin:   pkg.pluginOf(call.Name).Name(),
+					Code:     CodeRenamed,
+					Severity: SeverityInfo,
+					Message:  fmt.Sprintf("changing function call name from %s to %s", call.Name, name),
+				})
 				call.Expr.Fun = ast.NewIdent(name)
 			}
 		}
 
 		if changed {
-			info, err := os.Stat(fileInfo.fullpath)
-			if err != nil {
-				return nil, fmt.Errorf("stat %s: %v", fileInfo.fullpath, err)
-			}
-			f, err := os.OpenFile(fileInfo.fullpath, os.O_WRONLY, info.Mode())
-			if err != nil {
-				return nil, fmt.Errorf("opening %s: %v", fileInfo.fullpath, err)
-			}
-			if err := format.Node(f, program.Fset, fileInfo.astFile); err != nil {
+			buf := bytes.NewBuffer(nil)
+			if err := format.Node(buf, p.Fset, fileInfo.astFile); err != nil {
 				return nil, fmt.Errorf("formatting %s: %v", fileInfo.fullpath, err)
 			}
+			if err := files.WriteFile(fileInfo.fullpath, buf.Bytes()); err != nil {
+				return nil, fmt.Errorf("writing %s: %v", fileInfo.fullpath, err)
+			}
 		}
 
 	}
+	// Directives are added after the calls, so that they reuse the names of the functions that are already called.
+	methods := make(map[string]bool)
+	for _, fileInfo := range fileInfos {
+		for _, d := range fileInfo.directives {
+			if err := pkg.addDirective(d, config.directives, methods); err != nil {
+				return nil, Diagnostics{*err}
+			}
+		}
+	}
 	return pkg, nil
 }
 
 type pkg struct {
-	info       *loader.PackageInfo
+	info       *packages.Package
 	plugins    []Plugin
+	disabled   map[string]bool
 	generators map[string]Generator
+	typesmaps  map[string]*typesMap
+	cursor     *token.Position
 	printer    Printer
 	undefined  []*ast.CallExpr
 	fullpath   string
+	output     string
+	files      *overlay
+}
+
+func (pkg *pkg) position(expr ast.Node) token.Position {
+	return pkg.info.Fset.Position(expr.Pos())
 }
 
-func (pkg *pkg) Add(call *call) (string, error) {
+// pluginOf returns the enabled plugin that generates the function with the given name, or nil if there is none.
+func (pkg *pkg) pluginOf(funcName string) Plugin {
 	for _, p := range pkg.plugins {
-		if !strings.HasPrefix(call.Name, p.GetPrefix()) {
-			continue
+		if !pkg.disabled[p.Name()] && strings.HasPrefix(funcName, p.GetPrefix()) {
+			return p
 		}
-		generator := pkg.generators[p.Name()]
-		name, err := generator.Add(call.Name, call.Args)
-		if err != nil {
-			return "", fmt.Errorf("Add Error: %s: %v", p.Name(), err)
+	}
+	return nil
+}
+
+func (pkg *pkg) Add(call *call) (string, *Diagnostic) {
+	p := pkg.pluginOf(call.Name)
+	if p == nil {
+		return "", nil
+	}
+	*pkg.cursor = pkg.position(call.Expr)
+	generator := pkg.generators[p.Name()]
+	name, err := generator.Add(call.Name, call.Args)
+	if err != nil {
+		code := CodeInvalidCall
+		var nameErr *nameError
+		if errors.As(err, &nameErr) {
+			code = CodeNameConflict
+		}
+		return "", &Diagnostic{
+			Pos:      *pkg.cursor,
+			Plugin:   p.Name(),
+			Code:     code,
+			Severity: SeverityError,
+			Message:  err.Error(),
 		}
-		return name, nil
 	}
-	return "", nil
+	return name, nil
 }
 
 func (pkg *pkg) Done() bool {
@@ -209,35 +487,37 @@ func (pkg *pkg) Done() bool {
 	return true
 }
 
+// Close closes the generators, which need to be closed, like those of external plugins.
+func (pkg *pkg) Close() error {
+	var firstErr error
+	for _, g := range pkg.generators {
+		if c, ok := g.(io.Closer); ok {
+			if err := c.Close(); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
 func (pkg *pkg) HasContent() bool {
 	return pkg.printer.HasContent()
 }
 
 func (pkg *pkg) Filename() string {
-	return filepath.Join(pkg.fullpath, derivedFilename)
+	return filepath.Join(pkg.fullpath, pkg.output)
 }
 
 func (pkg *pkg) Print() error {
-	f, err := os.Create(pkg.Filename())
-	if err != nil {
-		return err
-	}
-	if _, err := pkg.printer.WriteTo(f); err != nil {
+	buf := bytes.NewBuffer(nil)
+	if _, err := pkg.printer.WriteTo(buf); err != nil {
 		return err
 	}
-	return f.Close()
+	return pkg.files.WriteFile(pkg.Filename(), buf.Bytes())
 }
 
 func (pkg *pkg) Delete() error {
-	filename := pkg.Filename()
-	_, err := os.Stat(filename)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return nil
-		}
-		return fmt.Errorf("stat %s: %v", filename, err)
-	}
-	return os.Remove(filename)
+	return pkg.files.Remove(pkg.Filename())
 }
 
 func (pkg *pkg) Generate() (bool, error) {
@@ -245,9 +525,18 @@ func (pkg *pkg) Generate() (bool, error) {
 	for !pkg.Done() {
 		for _, plugin := range pkg.plugins {
 			g := pkg.generators[plugin.Name()]
+			tm := pkg.typesmaps[plugin.Name()]
 			for _, typs := range g.ToGenerate() {
+				// Functions, which are generated as dependencies, inherit the position of the call that caused them.
+				*pkg.cursor = tm.positions[tm.GetFuncName(typs...)]
 				if err := g.Generate(typs); err != nil {
-					return false, fmt.Errorf("Generator Error: " + plugin.Name() + ":" + err.Error())
+					return false, Diagnostics{{
+						Pos:      *pkg.cursor,
+						Plugin:   plugin.Name(),
+						Code:     CodeGenerate,
+						Severity: SeverityError,
+						Message:  err.Error(),
+					}}
 				}
 				generated = true
 			}
@@ -257,61 +546,168 @@ func (pkg *pkg) Generate() (bool, error) {
 }
 
 func (pg *program) Generate() error {
-	pkgInfos := pg.program.InitialPackages()
+	_, err := pg.generate(false)
+	return err
+}
 
-	// sort.Slice(pkgInfos, func(i, j int) bool {
-	// 	return pkgInfos[i].String() < pkgInfos[j].String()
-	// })
-	for i := range pkgInfos {
-		if err := pg.generatePackage(pkgInfos[i]); err != nil {
+func (pg *program) Check(w io.Writer) (bool, error) {
+	overlays, err := pg.generate(true)
+	if err != nil {
+		return false, err
+	}
+	upToDate := true
+	for _, files := range overlays {
+		ok, err := files.Diff(w)
+		if err != nil {
+			return false, err
+		}
+		upToDate = upToDate && ok
+	}
+	return upToDate, nil
+}
+
+// generate generates the code for each package and its test variants, using up to jobs goroutines,
+// and returns the files written by each package, in package order.
+// Packages are independent, since they only share the type checked dependencies, which were loaded up front.
+// The diagnostics of each package are buffered, so that they are reported in package order.
+// If a package fails, no new packages are started and the error of the first failed package is returned.
+func (pg *program) generate(dryRun bool) ([]*overlay, error) {
+	overlays := make([]*overlay, len(pg.units))
+	for i := range overlays {
+		overlays[i] = newOverlay(dryRun)
+	}
+	if pg.jobs <= 1 {
+		for i := range pg.units {
+			if err := pg.generateUnit(i, overlays[i], pg.report); err != nil {
+				return nil, err
+			}
+		}
+		return overlays, nil
+	}
+
+	type result struct {
+		index int
+		diags []Diagnostic
+		err   error
+	}
+	results := make(chan result)
+	sem := make(chan struct{}, pg.jobs)
+	var failed atomic.Bool
+	go func() {
+		var wg sync.WaitGroup
+		for i := range pg.units {
+			sem <- struct{}{}
+			if failed.Load() {
+				<-sem
+				break
+			}
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				var diags []Diagnostic
+				err := pg.generateUnit(i, overlays[i], func(d Diagnostic) {
+					diags = append(diags, d)
+				})
+				if err != nil {
+					failed.Store(true)
+				}
+				results <- result{i, diags, err}
+			}(i)
+		}
+		wg.Wait()
+		close(results)
+	}()
+
+	done := make([]*result, len(pg.units))
+	next := 0
+	var firstErr error
+	for r := range results {
+		r := r
+		done[r.index] = &r
+		// Report the diagnostics of finished packages, as soon as all the packages before them have finished.
+		for firstErr == nil && next < len(done) && done[next] != nil {
+			for _, d := range done[next].diags {
+				pg.report(d)
+			}
+			firstErr = done[next].err
+			next++
+		}
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return overlays, nil
+}
+
+// generateUnit generates the i-th package, followed by its test variants.
+// The in-package test variant is reloaded first, since it includes the output file of the package,
+// which has just been generated.
+func (pg *program) generateUnit(i int, files *overlay, report Reporter) error {
+	u, config := pg.units[i], pg.configs[i]
+	if len(config.builds) > 0 && pg.builds != nil {
+		return pg.generateBuilds(pg.builds[i], config, files, report)
+	}
+	for _, t := range u.targets(config) {
+		if t.testOnly && u.pkg != nil {
+			var err error
+			t.pkg, err = pg.cache.reload(t.pkg, files, t.generated)
+			if err != nil {
+				return err
+			}
+		}
+		if err := pg.generatePackage(pg.cache, t, config, files, report); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (pg *program) generatePackage(pkgInfo *loader.PackageInfo) error {
-	path := pkgInfo.Pkg.Path()
-	// ss := make([]string, len(pkgInfo.Files))
-	// for i := range pkgInfo.Files {
-	// 	ss[i] = pg.program.Fset.File(pkgInfo.Files[i].Pos()).Name()
-	// }
-	// log.Printf("package: %s, files %d: %s", path, len(pkgInfo.Files), strings.Join(ss, ", "))
+func (pg *program) generatePackage(cache *typesCache, t target, config packageConfig, files *overlay, report Reporter) error {
 	generated := true
 	var undefined string
-	thisprogram := pg.program
+	var us []string
+	var undefs []Diagnostic
 	for generated {
-		pkgGen, err := newPackage(thisprogram, pkgInfo, pg.plugins, pg.autoname, pg.dedup)
+		pkgGen, err := newPackage(t, config, files, report)
 		if err != nil {
 			return err
 		}
 
-		us := make([]string, len(pkgGen.undefined))
+		sort.Slice(pkgGen.undefined, func(i, j int) bool {
+			return types.ExprString(pkgGen.undefined[i]) < types.ExprString(pkgGen.undefined[j])
+		})
+		us = make([]string, len(pkgGen.undefined))
+		undefs = make([]Diagnostic, len(pkgGen.undefined))
 		for i, u := range pkgGen.undefined {
 			us[i] = types.ExprString(u)
-		}
-		sort.Strings(us)
-
-		for _, u := range us {
-			log.Printf("could not yet generate: %s", u)
+			undefs[i] = Diagnostic{
+				Pos:      pkgGen.position(u),
+				Plugin:   pkgGen.pluginOf(types.ExprString(u.Fun)).Name(),
+				Code:     CodeUndefined,
+				Severity: SeverityWarning,
+				Message:  "could not yet generate: " + us[i],
+			}
+			report(undefs[i])
 		}
 
 		generated, err = pkgGen.Generate()
+		if err == nil {
+			if pkgGen.HasContent() {
+				err = pkgGen.Print()
+			} else {
+				// When the file has no content it should be removed.
+				err = pkgGen.Delete()
+			}
+		}
+		// The generators are closed in each iteration, since the next iteration creates new ones.
+		if cerr := pkgGen.Close(); err == nil {
+			err = cerr
+		}
 		if err != nil {
 			return err
 		}
 
-		if pkgGen.HasContent() {
-			if err := pkgGen.Print(); err != nil {
-				return err
-			}
-		} else {
-			// When the file has no content it should be removed.
-			if err := pkgGen.Delete(); err != nil {
-				return err
-			}
-		}
-
 		if len(us) == 0 {
 			return nil
 		}
@@ -322,16 +718,22 @@ func (pg *program) generatePackage(pkgInfo *loader.PackageInfo) error {
 		}
 		undefined = newundefined
 
-		// reload path with newly generated code, with the hope that some types are now inferable.
-		thisprogram, err = load(path)
+		// reload package with newly generated code, with the hope that some types are now inferable.
+		t.pkg, err = cache.reload(t.pkg, files, t.generated)
 		if err != nil {
 			return err
 		}
-		pkgInfo = thisprogram.Package(path)
 	}
 
 	if len(undefined) > 0 && !generated {
-		return fmt.Errorf("cannot generate: %s", undefined)
+		ds := make(Diagnostics, len(undefs))
+		for i, u := range undefs {
+			ds[i] = u
+			ds[i].Code = CodeCannotGenerate
+			ds[i].Severity = SeverityError
+			ds[i].Message = "cannot generate: " + us[i]
+		}
+		return ds
 	}
 	return nil
 }
@@ -0,0 +1,226 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"go/types"
+	"strings"
+)
+
+// IsTypeParam returns whether the type is a type parameter of a generic function or type.
+func IsTypeParam(typ types.Type) bool {
+	_, ok := typ.(*types.TypeParam)
+	return ok
+}
+
+// HasTypeParams returns whether any of the types refers to a type parameter,
+// in which case the derived function has to be generic itself.
+func HasTypeParams(typs ...types.Type) bool {
+	return len(typeParamsOf(typs)) > 0
+}
+
+// TypeSetAll returns whether the type set of the type parameter is restricted to a finite list of terms,
+// for which the predicate holds for every underlying type.
+// Constraints like any and comparable have an unrestricted type set and always return false.
+func TypeSetAll(tp *types.TypeParam, pred func(types.Type) bool) bool {
+	iface, ok := tp.Constraint().Underlying().(*types.Interface)
+	if !ok {
+		return false
+	}
+	return ifaceAll(iface, pred)
+}
+
+func ifaceAll(iface *types.Interface, pred func(types.Type) bool) bool {
+	restricted := false
+	for i := 0; i < iface.NumEmbeddeds(); i++ {
+		switch e := iface.EmbeddedType(i).(type) {
+		case *types.Union:
+			for j := 0; j < e.Len(); j++ {
+				if !termAll(e.Term(j).Type(), pred) {
+					return false
+				}
+			}
+			restricted = true
+		default:
+			if !termAll(e, pred) {
+				return false
+			}
+			restricted = true
+		}
+	}
+	return restricted
+}
+
+func termAll(typ types.Type, pred func(types.Type) bool) bool {
+	if iface, ok := typ.Underlying().(*types.Interface); ok {
+		return ifaceAll(iface, pred)
+	}
+	return pred(typ.Underlying())
+}
+
+// typeParams returns the type parameter list, including the brackets, that a derived function for these types requires.
+// An empty string is returned if none of the types refer to type parameters.
+func typeParams(typs []types.Type, typeString func(types.Type) string) string {
+	tps := typeParamsOf(typs)
+	if len(tps) == 0 {
+		return ""
+	}
+	ss := make([]string, len(tps))
+	for i, tp := range tps {
+		ss[i] = tp.Obj().Name() + " " + typeString(tp.Constraint())
+	}
+	return "[" + strings.Join(ss, ", ") + "]"
+}
+
+// typeParamsOf returns the type parameters referred to by the types, in order of appearance.
+func typeParamsOf(typs []types.Type) []*types.TypeParam {
+	var tps []*types.TypeParam
+	seen := make(map[*types.TypeParam]bool)
+	var visit func(typ types.Type)
+	visit = func(typ types.Type) {
+		switch t := types.Unalias(typ).(type) {
+		case *types.TypeParam:
+			if seen[t] {
+				return
+			}
+			seen[t] = true
+			tps = append(tps, t)
+			visit(t.Constraint())
+		case *types.Named:
+			args := t.TypeArgs()
+			for i := 0; i < args.Len(); i++ {
+				visit(args.At(i))
+			}
+		case *types.Pointer:
+			visit(t.Elem())
+		case *types.Slice:
+			visit(t.Elem())
+		case *types.Array:
+			visit(t.Elem())
+		case *types.Chan:
+			visit(t.Elem())
+		case *types.Map:
+			visit(t.Key())
+			visit(t.Elem())
+		case *types.Struct:
+			for i := 0; i < t.NumFields(); i++ {
+				visit(t.Field(i).Type())
+			}
+		case *types.Tuple:
+			for i := 0; i < t.Len(); i++ {
+				visit(t.At(i).Type())
+			}
+		case *types.Signature:
+			visit(t.Params())
+			visit(t.Results())
+		case *types.Interface:
+			for i := 0; i < t.NumEmbeddeds(); i++ {
+				visit(t.EmbeddedType(i))
+			}
+		case *types.Union:
+			for i := 0; i < t.Len(); i++ {
+				visit(t.Term(i).Type())
+			}
+		}
+	}
+	for _, typ := range typs {
+		visit(typ)
+	}
+	return tps
+}
+
+// identicalModuloTypeParams returns whether two types are identical,
+// if type parameters with identical constraints are allowed to be renamed consistently.
+// This allows two generic functions, which each have their own type parameter T, to share one derived function.
+func identicalModuloTypeParams(x, y types.Type, m map[*types.TypeParam]*types.TypeParam) bool {
+	x, y = types.Unalias(x), types.Unalias(y)
+	switch xt := x.(type) {
+	case *types.TypeParam:
+		yt, ok := y.(*types.TypeParam)
+		if !ok {
+			return false
+		}
+		if mapped, ok := m[xt]; ok {
+			return mapped == yt
+		}
+		m[xt] = yt
+		return identicalModuloTypeParams(xt.Constraint(), yt.Constraint(), m)
+	case *types.Named:
+		yt, ok := y.(*types.Named)
+		if !ok {
+			return false
+		}
+		if xt.Origin() != yt.Origin() {
+			return false
+		}
+		xargs, yargs := xt.TypeArgs(), yt.TypeArgs()
+		if xargs.Len() != yargs.Len() {
+			return false
+		}
+		for i := 0; i < xargs.Len(); i++ {
+			if !identicalModuloTypeParams(xargs.At(i), yargs.At(i), m) {
+				return false
+			}
+		}
+		return true
+	case *types.Pointer:
+		yt, ok := y.(*types.Pointer)
+		return ok && identicalModuloTypeParams(xt.Elem(), yt.Elem(), m)
+	case *types.Slice:
+		yt, ok := y.(*types.Slice)
+		return ok && identicalModuloTypeParams(xt.Elem(), yt.Elem(), m)
+	case *types.Array:
+		yt, ok := y.(*types.Array)
+		return ok && xt.Len() == yt.Len() && identicalModuloTypeParams(xt.Elem(), yt.Elem(), m)
+	case *types.Chan:
+		yt, ok := y.(*types.Chan)
+		return ok && xt.Dir() == yt.Dir() && identicalModuloTypeParams(xt.Elem(), yt.Elem(), m)
+	case *types.Map:
+		yt, ok := y.(*types.Map)
+		return ok && identicalModuloTypeParams(xt.Key(), yt.Key(), m) && identicalModuloTypeParams(xt.Elem(), yt.Elem(), m)
+	case *types.Struct:
+		yt, ok := y.(*types.Struct)
+		if !ok || xt.NumFields() != yt.NumFields() {
+			return false
+		}
+		for i := 0; i < xt.NumFields(); i++ {
+			xf, yf := xt.Field(i), yt.Field(i)
+			if xf.Name() != yf.Name() || xf.Embedded() != yf.Embedded() || xt.Tag(i) != yt.Tag(i) {
+				return false
+			}
+			if !identicalModuloTypeParams(xf.Type(), yf.Type(), m) {
+				return false
+			}
+		}
+		return true
+	case *types.Tuple:
+		yt, ok := y.(*types.Tuple)
+		if !ok || xt.Len() != yt.Len() {
+			return false
+		}
+		for i := 0; i < xt.Len(); i++ {
+			if !identicalModuloTypeParams(xt.At(i).Type(), yt.At(i).Type(), m) {
+				return false
+			}
+		}
+		return true
+	case *types.Signature:
+		yt, ok := y.(*types.Signature)
+		return ok && xt.Variadic() == yt.Variadic() &&
+			identicalModuloTypeParams(xt.Params(), yt.Params(), m) &&
+			identicalModuloTypeParams(xt.Results(), yt.Results(), m)
+	}
+	return types.Identical(x, y)
+}
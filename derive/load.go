@@ -15,31 +15,337 @@
 package derive
 
 import (
+	"bufio"
 	"fmt"
+	"go/ast"
 	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 
-	"golang.org/x/tools/go/loader"
+	"golang.org/x/tools/go/gcexportdata"
+	"golang.org/x/tools/go/packages"
 )
 
-func load(paths ...string) (*loader.Program, error) {
-	conf := loader.Config{
-		ParserMode:  parser.ParseComments,
-		AllowErrors: true,
+// ImportPaths returns the package patterns to load for the given command line.
+// The patterns are expanded by go/packages, using the same semantics as the go tool.
+func ImportPaths(args []string) []string {
+	if len(args) == 0 {
+		return []string{"."}
 	}
-	conf.TypeChecker.Error = func(err error) {}
-	rest, err := conf.FromArgs(paths, true)
+	return args
+}
+
+// loadMode loads the syntax and types of the initial packages from source,
+// while their dependencies are loaded from export data, just like go build.
+const loadMode = packages.NeedName |
+	packages.NeedFiles |
+	packages.NeedCompiledGoFiles |
+	packages.NeedImports |
+	packages.NeedTypes |
+	packages.NeedTypesSizes |
+	packages.NeedSyntax |
+	packages.NeedTypesInfo |
+	packages.NeedForTest
+
+// load loads the packages, with their tests, in the given environment,
+// which is the environment of the process, if it is nil.
+func load(fset *token.FileSet, env []string, buildFlags []string, patterns ...string) ([]*packages.Package, error) {
+	conf := &packages.Config{
+		Mode:       loadMode,
+		Fset:       fset,
+		Tests:      true,
+		Env:        env,
+		BuildFlags: buildFlags,
+	}
+	pkgs, err := packages.Load(conf, patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("could not load packages: %v", err)
+	}
+	// Type errors and even build errors are expected, since the derived functions do not exist yet,
+	// but a package without any files could not be found at all,
+	// unless all its files are excluded by build constraints.
+	for _, pkg := range pkgs {
+		if len(pkg.GoFiles) > 0 || len(pkg.CompiledGoFiles) > 0 || len(pkg.IgnoredFiles) > 0 {
+			continue
+		}
+		for _, err := range pkg.Errors {
+			if err.Kind == packages.ListError {
+				return nil, fmt.Errorf("could not load package %s: %v", pkg.ID, err)
+			}
+		}
+	}
+	return initialPackages(pkgs), nil
+}
+
+// initialPackages returns the packages for which code should be generated.
+// A package with in-package tests is loaded twice, once with and once without its test files,
+// in which case both variants are returned, together with the external test package, if there is one.
+// Generated test binaries are skipped.
+func initialPackages(pkgs []*packages.Package) []*packages.Package {
+	initial := make([]*packages.Package, 0, len(pkgs))
+	for _, pkg := range pkgs {
+		if pkg.Name == "main" && strings.HasSuffix(pkg.PkgPath, ".test") {
+			continue
+		}
+		if len(pkg.Syntax) == 0 {
+			continue
+		}
+		initial = append(initial, pkg)
+	}
+	return initial
+}
+
+// unit is a package together with its test variants.
+// Each variant is generated into its own output file,
+// so that functions, which are only called from tests, are not compiled into the package itself.
+type unit struct {
+	// pkg is the package without its test files.
+	pkg *packages.Package
+	// test is the package including its in-package test files.
+	test *packages.Package
+	// xtest is the external test package, of which the name has the _test suffix.
+	xtest *packages.Package
+}
+
+// newUnits groups the variants of each package, in the order in which the packages were loaded.
+// Test variants are recognized by their ForTest field, which go/packages sets.
+func newUnits(pkgs []*packages.Package) []*unit {
+	byPath := make(map[string]*unit)
+	var units []*unit
+	get := func(path string) *unit {
+		u, ok := byPath[path]
+		if !ok {
+			u = &unit{}
+			byPath[path] = u
+			units = append(units, u)
+		}
+		return u
+	}
+	for _, pkg := range pkgs {
+		switch {
+		case pkg.ForTest == "":
+			get(pkg.PkgPath).pkg = pkg
+		case pkg.ForTest == pkg.PkgPath:
+			get(pkg.PkgPath).test = pkg
+		case pkg.PkgPath == pkg.ForTest+"_test":
+			get(pkg.ForTest).xtest = pkg
+		}
+	}
+	return units
+}
+
+// first returns the first variant of the package that was loaded.
+func (u *unit) first() *packages.Package {
+	for _, pkg := range []*packages.Package{u.pkg, u.test, u.xtest} {
+		if pkg != nil {
+			return pkg
+		}
+	}
+	panic("unreachable: a unit has at least one variant")
+}
+
+// target is a variant of a package, of which the derived functions are generated into one output file.
+type target struct {
+	pkg *packages.Package
+	// output is the name of the generated file.
+	output string
+	// generated are the names of all the generated files, which are compiled with this variant, including output.
+	generated []string
+	// testOnly means that only the calls in test files are generated into output,
+	// since the other calls were already generated into the output file of the package itself.
+	testOnly bool
+}
+
+// targets returns the variants of the package, in the order in which they have to be generated.
+func (u *unit) targets(config packageConfig) []target {
+	var ts []target
+	if u.pkg != nil {
+		ts = append(ts, target{u.pkg, config.output, []string{config.output}, false})
+	}
+	if u.test != nil {
+		ts = append(ts, target{u.test, config.testOutput, []string{config.output, config.testOutput}, true})
+	}
+	if u.xtest != nil {
+		ts = append(ts, target{u.xtest, config.xtestOutput, []string{config.xtestOutput}, false})
+	}
+	return ts
+}
+
+func isTestFile(filename string) bool {
+	return strings.HasSuffix(filename, "_test.go")
+}
+
+// packageDir returns the directory containing the package's source files.
+func packageDir(pkg *packages.Package) string {
+	if len(pkg.GoFiles) == 0 {
+		return ""
+	}
+	return filepath.Dir(pkg.GoFiles[0])
+}
+
+// typesCache keeps the type checked dependencies of the loaded packages, indexed by package path.
+// This allows a package to be reloaded, after code has been generated for it,
+// by only parsing and type checking the package itself.
+// It is shared by all the packages that are generated concurrently.
+type typesCache struct {
+	fset       *token.FileSet
+	env        []string
+	buildFlags []string
+	mu         sync.Mutex
+	pkgs       map[string]*types.Package
+}
+
+func newTypesCache(fset *token.FileSet, env []string, buildFlags []string, pkgs []*packages.Package) *typesCache {
+	c := &typesCache{
+		fset:       fset,
+		env:        env,
+		buildFlags: buildFlags,
+		pkgs:       make(map[string]*types.Package),
+	}
+	var add func(p *types.Package)
+	add = func(p *types.Package) {
+		if _, ok := c.pkgs[p.Path()]; ok {
+			return
+		}
+		c.pkgs[p.Path()] = p
+		for _, imp := range p.Imports() {
+			add(imp)
+		}
+	}
+	for _, pkg := range pkgs {
+		if pkg.Types == nil {
+			continue
+		}
+		for _, imp := range pkg.Types.Imports() {
+			add(imp)
+		}
+	}
+	return c
+}
+
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) {
+	return f(path)
+}
+
+// importer returns an importer for the imports of the given package.
+// Imports, which have not been seen yet, for example those added by the generated code,
+// are read from export data and added to the cache.
+func (c *typesCache) importer(pkg *packages.Package) types.Importer {
+	return importerFunc(func(path string) (*types.Package, error) {
+		if path == "unsafe" {
+			return types.Unsafe, nil
+		}
+		pkgPath := path
+		if imp, ok := pkg.Imports[path]; ok {
+			// The external test package imports the test variant of the package, which is not in the cache.
+			if imp.Types != nil && imp.Types.Complete() && imp.ForTest != "" {
+				return imp.Types, nil
+			}
+			pkgPath = imp.PkgPath
+		}
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if p, ok := c.pkgs[pkgPath]; ok && p.Complete() {
+			return p, nil
+		}
+		return c.importExportData(path, packageDir(pkg))
+	})
+}
+
+// importExportData reads the export data of a package into the cache, while the lock is held.
+func (c *typesCache) importExportData(path string, dir string) (*types.Package, error) {
+	conf := &packages.Config{
+		Mode:       packages.NeedName | packages.NeedExportFile,
+		Dir:        dir,
+		Env:        c.env,
+		BuildFlags: c.buildFlags,
+	}
+	pkgs, err := packages.Load(conf, path)
 	if err != nil {
-		return nil, fmt.Errorf("could not parse arguments: %s", err)
+		return nil, err
 	}
-	if len(rest) > 0 {
-		return nil, fmt.Errorf("unhandled extra arguments: %v", rest)
+	if len(pkgs) != 1 || len(pkgs[0].ExportFile) == 0 {
+		return nil, fmt.Errorf("could not find export data for %s", path)
 	}
-	p, err := conf.Load()
+	f, err := os.Open(pkgs[0].ExportFile)
 	if err != nil {
 		return nil, err
 	}
-	if p.Fset == nil {
-		return nil, fmt.Errorf("program == nil")
+	defer f.Close()
+	r, err := gcexportdata.NewReader(bufio.NewReader(f))
+	if err != nil {
+		return nil, fmt.Errorf("reading export data for %s: %v", path, err)
+	}
+	return gcexportdata.Read(r, c.fset, c.pkgs, pkgs[0].PkgPath)
+}
+
+// reload parses and type checks the package again, including newly generated and without deleted generated files.
+// Files are read through the overlay, so that files which were only generated in memory are also seen.
+// Dependencies are not type checked again, but rather taken from the cache.
+func (c *typesCache) reload(pkg *packages.Package, files *overlay, generated []string) (*packages.Package, error) {
+	isGenerated := make(map[string]bool, len(generated))
+	for _, name := range generated {
+		isGenerated[name] = true
+	}
+	filenames := make([]string, 0, len(pkg.CompiledGoFiles)+len(generated))
+	for _, filename := range pkg.CompiledGoFiles {
+		if isGenerated[filepath.Base(filename)] {
+			continue
+		}
+		filenames = append(filenames, filename)
+	}
+	if dir := packageDir(pkg); len(dir) > 0 {
+		for _, name := range generated {
+			if filename := filepath.Join(dir, name); files.Exists(filename) {
+				filenames = append(filenames, filename)
+			}
+		}
+	}
+	syntax := make([]*ast.File, 0, len(filenames))
+	for _, filename := range filenames {
+		src, err := files.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		file, err := parser.ParseFile(c.fset, filename, src, parser.ParseComments)
+		if file == nil {
+			return nil, fmt.Errorf("parsing %s: %v", filename, err)
+		}
+		syntax = append(syntax, file)
+	}
+	info := &types.Info{
+		Types:      make(map[ast.Expr]types.TypeAndValue),
+		Instances:  make(map[*ast.Ident]types.Instance),
+		Defs:       make(map[*ast.Ident]types.Object),
+		Uses:       make(map[*ast.Ident]types.Object),
+		Implicits:  make(map[ast.Node]types.Object),
+		Selections: make(map[*ast.SelectorExpr]*types.Selection),
+		Scopes:     make(map[ast.Node]*types.Scope),
+	}
+	conf := types.Config{
+		Importer: c.importer(pkg),
+		Sizes:    pkg.TypesSizes,
+		Error:    func(err error) {},
 	}
-	return p, nil
+	typesPkg, _ := conf.Check(pkg.PkgPath, c.fset, syntax, info)
+	return &packages.Package{
+		ID:              pkg.ID,
+		Name:            pkg.Name,
+		PkgPath:         pkg.PkgPath,
+		GoFiles:         filenames,
+		CompiledGoFiles: filenames,
+		Imports:         pkg.Imports,
+		ForTest:         pkg.ForTest,
+		Fset:            c.fset,
+		Syntax:          syntax,
+		Types:           typesPkg,
+		TypesInfo:       info,
+		TypesSizes:      pkg.TypesSizes,
+	}, nil
 }
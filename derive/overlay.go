@@ -0,0 +1,124 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// overlay is where generated files and renamed source files are written to.
+// Normally writes go straight to the filesystem,
+// but in dry run mode they are only kept in memory, so that they can be compared to the files on disk.
+type overlay struct {
+	dryRun bool
+	files  map[string][]byte
+	// order keeps the filenames in the order in which they were first written or removed.
+	order []string
+}
+
+func newOverlay(dryRun bool) *overlay {
+	return &overlay{
+		dryRun: dryRun,
+		files:  make(map[string][]byte),
+	}
+}
+
+func (o *overlay) record(filename string, data []byte) {
+	if _, ok := o.files[filename]; !ok {
+		o.order = append(o.order, filename)
+	}
+	o.files[filename] = data
+}
+
+// ReadFile returns the content of the file, as it would be after all previous writes.
+func (o *overlay) ReadFile(filename string) ([]byte, error) {
+	if data, ok := o.files[filename]; ok {
+		if data == nil {
+			return nil, &os.PathError{Op: "open", Path: filename, Err: os.ErrNotExist}
+		}
+		return data, nil
+	}
+	return os.ReadFile(filename)
+}
+
+// Exists returns whether the file exists, as it would be after all previous writes.
+func (o *overlay) Exists(filename string) bool {
+	if data, ok := o.files[filename]; ok {
+		return data != nil
+	}
+	_, err := os.Stat(filename)
+	return err == nil
+}
+
+// WriteFile writes the file, while keeping the permissions of an existing file.
+func (o *overlay) WriteFile(filename string, data []byte) error {
+	if data == nil {
+		data = []byte{}
+	}
+	if o.dryRun {
+		o.record(filename, data)
+		return nil
+	}
+	mode := os.FileMode(0666)
+	if info, err := os.Stat(filename); err == nil {
+		mode = info.Mode()
+	} else if !os.IsNotExist(err) {
+		return fmt.Errorf("stat %s: %v", filename, err)
+	}
+	return os.WriteFile(filename, data, mode)
+}
+
+// Remove removes the file, if it exists.
+func (o *overlay) Remove(filename string) error {
+	if !o.Exists(filename) {
+		return nil
+	}
+	if o.dryRun {
+		o.record(filename, nil)
+		return nil
+	}
+	return os.Remove(filename)
+}
+
+// Diff writes a unified diff to w for every file written or removed in dry run mode,
+// for which the content differs from the file on disk.
+// It returns whether all files were up to date.
+func (o *overlay) Diff(w io.Writer) (bool, error) {
+	upToDate := true
+	for _, filename := range o.order {
+		old, err := os.ReadFile(filename)
+		if err != nil && !os.IsNotExist(err) {
+			return false, err
+		}
+		new := o.files[filename]
+		if string(old) == string(new) {
+			continue
+		}
+		upToDate = false
+		name := filename
+		if wd, err := os.Getwd(); err == nil {
+			if rel, err := filepath.Rel(wd, filename); err == nil {
+				name = rel
+			}
+		}
+		if _, err := io.WriteString(w, unifiedDiff(name, old, new)); err != nil {
+			return false, err
+		}
+	}
+	return upToDate, nil
+}
@@ -57,6 +57,54 @@ func NewPlugin(name, prefix string, newFunc func(typesMap TypesMap, p Printer, d
 	}
 }
 
+// Directive describes how a plugin derives a function for a type, which is annotated with a directive comment,
+// instead of for the arguments of a call, for example:
+//
+//	//goderive:equal(method),hash
+//	type Person struct {...}
+//
+// The receiver is a pointer to the annotated type, if it is a struct, and otherwise the annotated type itself.
+type Directive struct {
+	// Args returns the types of the arguments of the derived function for the receiver.
+	Args func(recv types.Type) []types.Type
+	// Method is the name of the method, which is generated with the method option, for example Equal.
+	Method string
+	// Template is the generated method, as a format string,
+	// which is given the receiver type, the method name and the name of the derived function.
+	// For example:
+	//
+	//	func (this %[1]s) %[2]s(that %[1]s) bool {\n\treturn %[3]s(this, that)\n}
+	Template string
+}
+
+// DirectivePlugin is a plugin, which also derives functions for types that are annotated with a directive comment.
+type DirectivePlugin interface {
+	Plugin
+	Directive() Directive
+}
+
+type directivePlugin struct {
+	plugin
+	directive Directive
+}
+
+// NewPluginWithDirective is used by a plugin library to create a plugin, like NewPlugin,
+// which also derives functions for types that are annotated with a directive comment.
+func NewPluginWithDirective(name, prefix string, newFunc func(typesMap TypesMap, p Printer, deps map[string]Dependency) Generator, directive Directive) Plugin {
+	return &directivePlugin{
+		plugin: plugin{
+			name:    name,
+			prefix:  prefix,
+			newFunc: newFunc,
+		},
+		directive: directive,
+	}
+}
+
+func (g *directivePlugin) Directive() Directive {
+	return g.directive
+}
+
 func (g *plugin) New(typesMap TypesMap, p Printer, deps map[string]Dependency) Generator {
 	return g.newFunc(typesMap, p, deps)
 }
@@ -54,6 +54,26 @@ func IsError(t types.Type) bool {
 	return false
 }
 
+// IsContext returns whether a type is context.Context.
+func IsContext(t types.Type) bool {
+	typ, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := typ.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == "context" && obj.Name() == "Context"
+}
+
+// IsDuration returns whether a type is time.Duration.
+func IsDuration(t types.Type) bool {
+	typ, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := typ.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == "Duration"
+}
+
 // Zero returns the zero value as a string, for a given type.
 func Zero(typ types.Type) string {
 	switch t := typ.(type) {
@@ -69,6 +89,9 @@ func Zero(typ types.Type) string {
 }
 
 func IsComparable(tt types.Type) bool {
+	if tp, ok := tt.(*types.TypeParam); ok {
+		return types.Comparable(tp)
+	}
 	t := tt.Underlying()
 	switch typ := t.(type) {
 	case *types.Basic:
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"fmt"
 	"go/format"
+	"go/token"
 	"go/types"
 	"strconv"
 )
@@ -31,12 +32,15 @@ type TypesMap interface {
 	ToGenerate() [][]types.Type
 	Prefix() string
 	TypeString(typ types.Type) string
+	TypeParams(typs ...types.Type) string
 	FieldStrings(fields []*types.Var) ([]string, error)
 	IsExternal(typ *types.Named) bool
 	Done() bool
 }
 
 type typesMap struct {
+	// pkg is the package, that code is generated for.
+	pkg        *types.Package
 	qual       types.Qualifier
 	prefix     string
 	generated  map[string]bool
@@ -45,10 +49,16 @@ type typesMap struct {
 	reserved   map[string]struct{}
 	autoname   bool
 	dedup      bool
+	// cursor is the position of the call, which is currently being added or generated.
+	// It is shared between all the typesMaps of a package.
+	cursor *token.Position
+	// positions remembers the call, which caused each function to be generated.
+	positions map[string]token.Position
 }
 
-func newTypesMap(qual types.Qualifier, prefix string, reserved map[string]struct{}, autoname bool, dedup bool) TypesMap {
+func newTypesMap(pkg *types.Package, qual types.Qualifier, prefix string, reserved map[string]struct{}, autoname bool, dedup bool, cursor *token.Position) *typesMap {
 	return &typesMap{
+		pkg:        pkg,
 		qual:       qual,
 		prefix:     prefix,
 		generated:  make(map[string]bool),
@@ -57,6 +67,8 @@ func newTypesMap(qual types.Qualifier, prefix string, reserved map[string]struct
 		reserved:   reserved,
 		autoname:   autoname,
 		dedup:      dedup,
+		cursor:     cursor,
+		positions:  make(map[string]token.Position),
 	}
 }
 
@@ -68,6 +80,12 @@ func (tm *typesMap) TypeString(typ types.Type) string {
 	return types.TypeString(types.Default(typ), tm.qual)
 }
 
+// TypeParams returns the type parameter list, for example [T comparable],
+// that a function generated for these input types requires, or an empty string if it is not generic.
+func (tm *typesMap) TypeParams(typs ...types.Type) string {
+	return typeParams(typs, tm.TypeString)
+}
+
 func (tm *typesMap) FieldStrings(fields []*types.Var) ([]string, error) {
 	strct := types.NewStruct(fields, nil)
 	strctStr, err := format.Source([]byte("var a " + tm.TypeString(strct)))
@@ -75,6 +93,15 @@ func (tm *typesMap) FieldStrings(fields []*types.Var) ([]string, error) {
 		return nil, err
 	}
 	strctLines := bytes.Split(strctStr, []byte{'\n'})
+	if len(strctLines) < 3 {
+		// a struct with a single field is formatted on one line, for example: var a struct{ Param0 int }
+		line := strctLines[0]
+		field := bytes.TrimSpace(line[bytes.IndexByte(line, '{')+1 : bytes.LastIndexByte(line, '}')])
+		if len(field) == 0 {
+			return nil, nil
+		}
+		return []string{string(field)}, nil
+	}
 	ss := make([]string, len(strctLines)-2)
 	for i := range strctLines[1 : len(strctLines)-1] {
 		ss[i] = string(bytes.TrimSpace(strctLines[i+1]))
@@ -82,6 +109,78 @@ func (tm *typesMap) FieldStrings(fields []*types.Var) ([]string, error) {
 	return ss, nil
 }
 
+// Implementations returns the concrete types, which are declared in the package that code is generated for
+// and implement the interface, sorted by name, where T comes before *T, if both implement the interface.
+// Generic types, types for which supported returns false, and nil for an empty interface, since every type implements it, are not included.
+// Plugins use these types to type switch over the values of an interface,
+// where the values of the types, which are not included, are handled at runtime.
+func Implementations(tm TypesMap, iface *types.Interface, supported func(types.Type) bool) []types.Type {
+	m, ok := tm.(*typesMap)
+	if !ok || m.pkg == nil || iface.Empty() {
+		return nil
+	}
+	var typs []types.Type
+	scope := m.pkg.Scope()
+	for _, name := range scope.Names() {
+		obj, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || obj.IsAlias() {
+			continue
+		}
+		named, ok := obj.Type().(*types.Named)
+		if !ok || named.TypeParams().Len() > 0 || types.IsInterface(named) {
+			continue
+		}
+		if types.Implements(named, iface) && supported(named) {
+			typs = append(typs, named)
+		}
+		if ptr := types.NewPointer(named); types.Implements(ptr, iface) && supported(ptr) {
+			typs = append(typs, ptr)
+		}
+	}
+	return typs
+}
+
+// Supported returns whether a plugin, which cannot generate code for channels and functions, can generate code for the type,
+// by looking into its elements, keys and the fields, which are not skipped by the plugin's struct tags.
+// Interfaces and type parameters are supported, since their types are only known at runtime or instantiation.
+// Types for which leaf returns true are supported, without looking into them,
+// which plugins use for types, that have a method, like Equal, which is called instead of generated code.
+func Supported(plugin string, typ types.Type, leaf func(types.Type) bool) bool {
+	return supported(plugin, typ, leaf, make(map[types.Type]bool))
+}
+
+func supported(plugin string, typ types.Type, leaf func(types.Type) bool, seen map[types.Type]bool) bool {
+	if seen[typ] || leaf(typ) {
+		return true
+	}
+	seen[typ] = true
+	switch t := typ.Underlying().(type) {
+	case *types.Basic:
+		return t.Kind() != types.UntypedNil
+	case *types.Pointer:
+		return supported(plugin, t.Elem(), leaf, seen)
+	case *types.Slice:
+		return supported(plugin, t.Elem(), leaf, seen)
+	case *types.Array:
+		return supported(plugin, t.Elem(), leaf, seen)
+	case *types.Map:
+		return supported(plugin, t.Key(), leaf, seen) && supported(plugin, t.Elem(), leaf, seen)
+	case *types.Struct:
+		for i := 0; i < t.NumFields(); i++ {
+			if ParseTag(t.Tag(i)).Skip(plugin) {
+				continue
+			}
+			if !supported(plugin, t.Field(i).Type(), leaf, seen) {
+				return false
+			}
+		}
+		return true
+	case *types.Interface:
+		return true
+	}
+	return false
+}
+
 func (tm *typesMap) TypeStringBypass(typ types.Type) string {
 	return types.TypeString(types.Default(typ), bypassQual)
 }
@@ -99,7 +198,7 @@ func (tm *typesMap) SetFuncName(funcName string, typs ...types.Type) (string, er
 		if tm.dedup {
 			return fName, nil
 		}
-		return "", fmt.Errorf("ambigious function names for type %s = (%s | %s)", typs, fName, funcName)
+		return "", &nameError{fmt.Sprintf("ambigious function names for type %s = (%s | %s)", typs, fName, funcName)}
 	}
 	if ts, ok := tm.funcToTyps[funcName]; ok {
 		if eq(ts, typs) {
@@ -108,9 +207,10 @@ func (tm *typesMap) SetFuncName(funcName string, typs ...types.Type) (string, er
 		if tm.autoname {
 			return tm.GetFuncName(typs...), nil
 		}
-		return "", fmt.Errorf("conflicting function names %s(%v) and %s(%v)", funcName, ts, funcName, typs)
+		return "", &nameError{fmt.Sprintf("conflicting function names %s(%v) and %s(%v)", funcName, ts, funcName, typs)}
 	}
 	tm.funcToTyps[funcName] = typs
+	tm.positions[funcName] = *tm.cursor
 	tm.typss = append(tm.typss, typs)
 	return funcName, nil
 }
@@ -162,7 +262,23 @@ func eq(this, that []types.Type) bool {
 	if len(this) != len(that) {
 		return false
 	}
+	if HasTypeParams(this...) || HasTypeParams(that...) {
+		m := make(map[*types.TypeParam]*types.TypeParam)
+		for i, t := range this {
+			if !identicalModuloTypeParams(t, that[i], m) {
+				return false
+			}
+		}
+		return true
+	}
 	for i, t := range this {
+		// Every type, that implements an interface, is assignable to it, but needs its own function.
+		if types.IsInterface(t) || types.IsInterface(that[i]) {
+			if !types.Identical(t, that[i]) {
+				return false
+			}
+			continue
+		}
 		if !types.AssignableTo(types.Default(t), types.Default(that[i])) {
 			return false
 		}
@@ -0,0 +1,19 @@
+// Example: config shows how a goderive.yaml file, in the package directory or any ancestor directory,
+// configures goderive for a package, without having to pass any flags:
+//
+//   pluginprefix:
+//     equal: eq
+//   output: zz_derived.gen.go
+//
+// This sets the prefix of the equal plugin to "eq" and writes the generated code to zz_derived.gen.go.
+// A goderive.toml file with the same fields is also supported.
+package config
+
+type MyStruct struct {
+	Int64     int64
+	StringPtr *string
+}
+
+func (this *MyStruct) Equal(that *MyStruct) bool {
+	return eq(this, that)
+}
@@ -0,0 +1,11 @@
+// Code generated by goderive DO NOT EDIT.
+
+package config
+
+// eq returns whether this and that are equal.
+func eq(this, that *MyStruct) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Int64 == that.Int64 &&
+			((this.StringPtr == nil && that.StringPtr == nil) || (this.StringPtr != nil && that.StringPtr != nil && *(this.StringPtr) == *(that.StringPtr)))
+}
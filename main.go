@@ -17,111 +17,115 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"log"
+	"os"
 	"strings"
 
 	"github.com/awalterschulze/goderive/derive"
-	"github.com/awalterschulze/goderive/plugin/all"
-	"github.com/awalterschulze/goderive/plugin/any"
-	"github.com/awalterschulze/goderive/plugin/clone"
-	"github.com/awalterschulze/goderive/plugin/compare"
-	"github.com/awalterschulze/goderive/plugin/compose"
-	"github.com/awalterschulze/goderive/plugin/contains"
-	"github.com/awalterschulze/goderive/plugin/curry"
-	"github.com/awalterschulze/goderive/plugin/deepcopy"
-	"github.com/awalterschulze/goderive/plugin/do"
-	"github.com/awalterschulze/goderive/plugin/dup"
-	"github.com/awalterschulze/goderive/plugin/equal"
-	"github.com/awalterschulze/goderive/plugin/filter"
-	"github.com/awalterschulze/goderive/plugin/flip"
-	"github.com/awalterschulze/goderive/plugin/fmap"
-	"github.com/awalterschulze/goderive/plugin/gostring"
-	"github.com/awalterschulze/goderive/plugin/hash"
-	"github.com/awalterschulze/goderive/plugin/intersect"
-	"github.com/awalterschulze/goderive/plugin/join"
-	"github.com/awalterschulze/goderive/plugin/keys"
-	"github.com/awalterschulze/goderive/plugin/max"
-	"github.com/awalterschulze/goderive/plugin/mem"
-	"github.com/awalterschulze/goderive/plugin/min"
-	"github.com/awalterschulze/goderive/plugin/pipeline"
-	"github.com/awalterschulze/goderive/plugin/set"
-	"github.com/awalterschulze/goderive/plugin/sort"
-	"github.com/awalterschulze/goderive/plugin/takewhile"
-	"github.com/awalterschulze/goderive/plugin/traverse"
-	"github.com/awalterschulze/goderive/plugin/tuple"
-	"github.com/awalterschulze/goderive/plugin/uncurry"
-	"github.com/awalterschulze/goderive/plugin/union"
-	"github.com/awalterschulze/goderive/plugin/unique"
+	"github.com/awalterschulze/goderive/plugin"
 )
 
 var autoname = flag.Bool("autoname", false, "rename functions that are conflicting with other functions")
 var dedup = flag.Bool("dedup", false, "rename functions to functions that are duplicates")
 var prefix = flag.String("prefix", "derive", "prefix of all functions")
 var pluginprefix = flag.String("pluginprefix", "", "used to override function prefixes.  The input is a comma separated list of function are prefix pairs.  For example equal=deriveEqual,copyto=copyTo,fmap=fmap,")
+var tags = flag.String("tags", "", "a comma-separated list of build tags to consider satisfied while loading packages, as in go build")
+var jsonOut = flag.Bool("json", false, "print diagnostics, like errors and warnings, as JSON lines to stdout, in which case the diffs of -check are printed to stderr")
+var jobs = flag.Int("j", 1, "the number of packages to generate concurrently")
+var builds = flag.String("builds", "", "a comma-separated list of builds to generate for, each of the form GOOS[/GOARCH][+tag...], for example linux,windows/amd64,linux+integration")
+var check = flag.Bool("check", false, "do not write any files, but print a diff of every file that is out of date and exit with a non-zero status if there are any")
 
 func main() {
-	plugins := []derive.Plugin{
-		equal.NewPlugin(),
-		compare.NewPlugin(),
-		fmap.NewPlugin(),
-		join.NewPlugin(),
-		keys.NewPlugin(),
-		sort.NewPlugin(),
-		deepcopy.NewPlugin(),
-		set.NewPlugin(),
-		min.NewPlugin(),
-		max.NewPlugin(),
-		contains.NewPlugin(),
-		intersect.NewPlugin(),
-		union.NewPlugin(),
-		filter.NewPlugin(),
-		takewhile.NewPlugin(),
-		unique.NewPlugin(),
-		flip.NewPlugin(),
-		curry.NewPlugin(),
-		uncurry.NewPlugin(),
-		all.NewPlugin(),
-		any.NewPlugin(),
-		tuple.NewPlugin(),
-		gostring.NewPlugin(),
-		compose.NewPlugin(),
-		do.NewPlugin(),
-		pipeline.NewPlugin(),
-		dup.NewPlugin(),
-		clone.NewPlugin(),
-		hash.NewPlugin(),
-		mem.NewPlugin(),
-		traverse.NewPlugin(),
-	}
+	plugins := plugin.Default()
 	log.SetFlags(0)
 	flag.Parse()
-	overridePrefixes := make(map[string]string)
-	if len(*pluginprefix) > 0 {
-		pairs := strings.Split(*pluginprefix, ",")
-		for _, pair := range pairs {
-			ss := strings.Split(pair, "=")
-			if len(ss) != 2 {
-				log.Fatalf("invalid syntax for plugin prefix <%s>", pair)
+	// Flags, which are set, override the goderive.yaml or goderive.toml config files.
+	var config derive.Config
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "prefix":
+			config.Prefix = *prefix
+		case "pluginprefix":
+			config.PluginPrefix = parsePluginPrefix(*pluginprefix)
+		case "autoname":
+			config.Autoname = autoname
+		case "dedup":
+			config.Dedup = dedup
+		case "builds":
+			bs, err := derive.ParseBuilds(*builds)
+			if err != nil {
+				log.Fatal(err)
 			}
-			overridePrefixes[ss[0]] = ss[1]
+			config.Builds = bs
 		}
+	})
+	paths := derive.ImportPaths(flag.Args())
+	var buildFlags []string
+	if len(*tags) > 0 {
+		buildFlags = append(buildFlags, "-tags="+*tags)
+	}
+	// goderive-plugin- executables on the PATH are used alongside the plugins that are included in goderive.
+	// They are only started, once a call is found, which none of the included plugins generate.
+	g, err := derive.NewPluginsWithExternal(plugins, config).Load(paths, buildFlags...)
+	if err != nil {
+		fatal(derive.AsDiagnostics(err, derive.CodeLoad))
+	}
+	if *jsonOut {
+		g.SetReporter(printJSON)
 	}
-	for _, p := range plugins {
-		pluginprefix := p.GetPrefix()
-		pluginprefix = strings.Replace(pluginprefix, "derive", *prefix, 1)
-		newprefix, override := overridePrefixes[p.Name()]
-		if override {
-			pluginprefix = newprefix
+	g.SetJobs(*jobs)
+	if *check {
+		// The diffs are kept apart from the JSON lines, so that stdout can still be parsed line by line.
+		diffs := os.Stdout
+		if *jsonOut {
+			diffs = os.Stderr
 		}
-		p.SetPrefix(pluginprefix)
+		upToDate, err := g.Check(diffs)
+		if err != nil {
+			fatal(derive.AsDiagnostics(err, derive.CodeInternal))
+		}
+		if !upToDate {
+			os.Exit(1)
+		}
+		return
 	}
-	paths := derive.ImportPaths(flag.Args())
-	g, err := derive.NewPlugins(plugins, *autoname, *dedup).Load(paths)
+	if err := g.Generate(); err != nil {
+		fatal(derive.AsDiagnostics(err, derive.CodeInternal))
+	}
+}
+
+func parsePluginPrefix(s string) map[string]string {
+	overridePrefixes := make(map[string]string)
+	if len(s) == 0 {
+		return overridePrefixes
+	}
+	pairs := strings.Split(s, ",")
+	for _, pair := range pairs {
+		ss := strings.Split(pair, "=")
+		if len(ss) != 2 {
+			log.Fatalf("invalid syntax for plugin prefix <%s>", pair)
+		}
+		overridePrefixes[ss[0]] = ss[1]
+	}
+	return overridePrefixes
+}
+
+func printJSON(d derive.Diagnostic) {
+	data, err := json.Marshal(d)
 	if err != nil {
 		log.Fatal(err)
 	}
-	if err := g.Generate(); err != nil {
-		log.Fatal(err)
+	os.Stdout.Write(append(data, '\n'))
+}
+
+func fatal(ds derive.Diagnostics) {
+	if !*jsonOut {
+		log.Fatal(ds)
+	}
+	for _, d := range ds {
+		printJSON(d)
 	}
+	os.Exit(1)
 }
@@ -0,0 +1,158 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package batch contains the implementation of the batch plugin, which generates the deriveBatch function.
+//
+// The deriveBatch function groups the messages received on c into batches, which are sent on the output channel.
+//   deriveBatch(size int, timeout time.Duration, c <-chan T) <-chan []T
+// A batch is sent, when it contains size messages or when timeout has passed since its first message was received.
+// A timeout of zero or less means that a batch is only sent when it is full.
+// The last batch, which might not be full, is sent after c is closed, after which the output channel is closed.
+package batch
+
+import (
+	"fmt"
+	"go/types"
+
+	"github.com/awalterschulze/goderive/derive"
+)
+
+// NewPlugin creates a new batch plugin.
+// This function returns the plugin name, default prefix and a constructor for the batch code generator.
+func NewPlugin() derive.Plugin {
+	return derive.NewPlugin("batch", "deriveBatch", New)
+}
+
+// New is a constructor for the batch code generator.
+// This generator should be reconstructed for each package.
+func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
+	return &gen{
+		TypesMap: typesMap,
+		printer:  p,
+		timePkg:  p.NewImport("time", "time"),
+	}
+}
+
+type gen struct {
+	derive.TypesMap
+	printer derive.Printer
+	timePkg derive.Import
+}
+
+func (g *gen) Add(name string, typs []types.Type) (string, error) {
+	if len(typs) != 3 {
+		return "", fmt.Errorf("%s expected three arguments", name)
+	}
+	// The types are copied, so that defaulting the size does not change the types of the caller.
+	typs = append([]types.Type(nil), typs...)
+	typs[0] = types.Default(typs[0])
+	if !types.Identical(typs[0], types.Typ[types.Int]) {
+		return "", fmt.Errorf("%s, the size, %s, is not an int", name, g.TypeString(typs[0]))
+	}
+	if !derive.IsDuration(typs[1]) {
+		return "", fmt.Errorf("%s, the timeout, %s, is not a time.Duration", name, g.TypeString(typs[1]))
+	}
+	if _, ok := typs[2].(*types.Chan); !ok {
+		return "", fmt.Errorf("%s, the argument, %s, is not of type chan", name, g.TypeString(typs[2]))
+	}
+	return g.SetFuncName(name, typs...)
+}
+
+func (g *gen) Generate(typs []types.Type) error {
+	name := g.GetFuncName(typs...)
+	g.Generating(typs...)
+	p := g.printer
+	timeStr := g.timePkg()
+	chanTyp := typs[2].(*types.Chan)
+	dirstr := ""
+	if chanTyp.Dir() == types.RecvOnly {
+		dirstr = "<-"
+	}
+	typstr := g.TypeString(chanTyp.Elem())
+	p.P("")
+	p.P("// %s groups the messages received on c into batches of at most size messages,", name)
+	p.P("// where a batch, which is not full, is sent when timeout has passed since its first message was received.")
+	p.P("func %s(size int, timeout %s.Duration, c %schan %s) <-chan []%s {", name, timeStr, dirstr, typstr, typstr)
+	p.In()
+	p.P("if size < 1 {")
+	p.In()
+	p.P("size = 1")
+	p.Out()
+	p.P("}")
+	p.P("out := make(chan []%s)", typstr)
+	p.P("go func() {")
+	p.In()
+	p.P("defer close(out)")
+	p.P("var batch []%s", typstr)
+	p.P("var timer *%s.Timer", timeStr)
+	p.P("var expired <-chan %s.Time", timeStr)
+	p.P("flush := func() {")
+	p.In()
+	p.P("if timer != nil {")
+	p.In()
+	p.P("timer.Stop()")
+	p.P("timer, expired = nil, nil")
+	p.Out()
+	p.P("}")
+	p.P("out <- batch")
+	p.P("batch = nil")
+	p.Out()
+	p.P("}")
+	p.P("for {")
+	p.In()
+	p.P("select {")
+	p.P("case v, ok := <-c:")
+	p.In()
+	p.P("if !ok {")
+	p.In()
+	p.P("if len(batch) > 0 {")
+	p.In()
+	p.P("flush()")
+	p.Out()
+	p.P("}")
+	p.P("return")
+	p.Out()
+	p.P("}")
+	p.P("if batch == nil {")
+	p.In()
+	p.P("batch = make([]%s, 0, size)", typstr)
+	p.P("if timeout > 0 {")
+	p.In()
+	p.P("timer = %s.NewTimer(timeout)", timeStr)
+	p.P("expired = timer.C")
+	p.Out()
+	p.P("}")
+	p.Out()
+	p.P("}")
+	p.P("batch = append(batch, v)")
+	p.P("if len(batch) == size {")
+	p.In()
+	p.P("flush()")
+	p.Out()
+	p.P("}")
+	p.Out()
+	p.P("case <-expired:")
+	p.In()
+	p.P("flush()")
+	p.Out()
+	p.P("}")
+	p.Out()
+	p.P("}")
+	p.Out()
+	p.P("}()")
+	p.P("return out")
+	p.Out()
+	p.P("}")
+	return nil
+}
@@ -25,6 +25,8 @@
 //	- maps
 //	- pointers to these types
 //	- private fields of structs in external packages (using reflect and unsafe)
+//	- instantiated generic types
+//	- type parameters that only allow basic types, in which case a generic function is generated
 //	- and many more
 // Unsupported types:
 //	- chan
@@ -42,8 +44,16 @@ import (
 
 // NewPlugin creates a new clone plugin.
 // This function returns the plugin name, default prefix and a constructor for the clone code generator.
+// It also derives functions for types that are annotated with the //goderive:clone directive,
+// where the method option generates the Clone method.
 func NewPlugin() derive.Plugin {
-	return derive.NewPlugin("clone", "deriveClone", New)
+	return derive.NewPluginWithDirective("clone", "deriveClone", New, derive.Directive{
+		Args: func(recv types.Type) []types.Type {
+			return []types.Type{recv}
+		},
+		Method:   "Clone",
+		Template: "// %[2]s returns a clone of this.\nfunc (this %[1]s) %[2]s() %[1]s {\n\treturn %[3]s(this)\n}",
+	})
 }
 
 // New is a constructor for the clone code generator.
@@ -79,7 +89,7 @@ func (g *gen) genFuncFor(in types.Type) error {
 	inStr := g.TypeString(in)
 	p.P("")
 	p.P("// %s returns a clone of the src parameter.", g.GetFuncName(in))
-	p.P("func %s(src %s) %s {", g.GetFuncName(in), inStr, inStr)
+	p.P("func %s%s(src %s) %s {", g.GetFuncName(in), g.TypeParams(in), inStr, inStr)
 	p.In()
 	switch ttyp := in.Underlying().(type) {
 	case *types.Pointer:
@@ -48,10 +48,12 @@
 //	- maps
 //	- pointers to these types
 //	- private fields of structs in external packages (using reflect and unsafe)
+//	- instantiated generic types
+//	- ordered type parameters, in which case a generic function is generated
+//	- interfaces, by type switching over the types in the package, which implement them
 //	- and many more
 // Unsupported types:
 //	- chan
-//	- interface
 //	- function
 //	- unnamed structs, which are not comparable with the == operator
 //
@@ -71,8 +73,16 @@ import (
 
 // NewPlugin creates a new compare plugin.
 // This function returns the plugin name, default prefix and a constructor for the compare code generator.
+// It also derives functions for types that are annotated with the //goderive:compare directive,
+// where the method option generates the Compare method.
 func NewPlugin() derive.Plugin {
-	return derive.NewPlugin("compare", "deriveCompare", New)
+	return derive.NewPluginWithDirective("compare", "deriveCompare", New, derive.Directive{
+		Args: func(recv types.Type) []types.Type {
+			return []types.Type{recv, recv}
+		},
+		Method:   "Compare",
+		Template: "// %[2]s returns -1 if this is less than that, 0 if they are equal and 1 if this is greater than that.\nfunc (this %[1]s) %[2]s(that %[1]s) int {\n\treturn %[3]s(this, that)\n}",
+	})
 }
 
 // New is a constructor for the compare code generator.
@@ -83,6 +93,7 @@ func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Depe
 		printer:    p,
 		bytesPkg:   p.NewImport("bytes", "bytes"),
 		stringsPkg: p.NewImport("strings", "strings"),
+		fmtPkg:     p.NewImport("fmt", "fmt"),
 		reflectPkg: p.NewImport("reflect", "reflect"),
 		unsafePkg:  p.NewImport("unsafe", "unsafe"),
 		keys:       deps["keys"],
@@ -95,6 +106,7 @@ type gen struct {
 	printer    derive.Printer
 	bytesPkg   derive.Import
 	stringsPkg derive.Import
+	fmtPkg     derive.Import
 	reflectPkg derive.Import
 	unsafePkg  derive.Import
 	keys       derive.Dependency
@@ -161,7 +173,7 @@ func (g *gen) genCurriedFunc(typ types.Type) error {
 	p.P("//   * 0 if this and that are equal,")
 	p.P("//   * -1 is this is smaller and")
 	p.P("//   * +1 is this is bigger.")
-	p.P("func %s(this %s) func(%s) int {", g.GetFuncName(typ), typeStr, typeStr)
+	p.P("func %s%s(this %s) func(%s) int {", g.GetFuncName(typ), g.TypeParams(typ), typeStr, typeStr)
 	p.In()
 	p.P("return func(that %s) int {", typeStr)
 	p.In()
@@ -184,7 +196,7 @@ func (g *gen) genFunc(typs []types.Type) error {
 	p.P("//   * 0 if this and that are equal,")
 	p.P("//   * -1 is this is smaller and")
 	p.P("//   * +1 is this is bigger.")
-	p.P("func %s(this, that %s) int {", g.GetFuncName(typs...), typeStr)
+	p.P("func %s%s(this, that %s) int {", g.GetFuncName(typs...), g.TypeParams(typs...), typeStr)
 	p.In()
 	if err := g.genStatement(typs[0], "this", "that"); err != nil {
 		return err
@@ -196,6 +208,23 @@ func (g *gen) genFunc(typs []types.Type) error {
 
 func (g *gen) genStatement(typ types.Type, this, that string) error {
 	p := g.printer
+	if tp, ok := typ.(*types.TypeParam); ok {
+		if !isOrdered(tp) {
+			return fmt.Errorf("unsupported type parameter %s, which is not ordered", tp)
+		}
+		p.P("if %s != %s {", this, that)
+		p.In()
+		p.P("if %s < %s {", this, that)
+		p.In()
+		p.P("return -1")
+		p.Out()
+		p.P("}")
+		p.P("return 1")
+		p.Out()
+		p.P("}")
+		p.P("return 0")
+		return nil
+	}
 	switch ttyp := typ.Underlying().(type) {
 	case *types.Pointer:
 		p.P("if %s == nil {", this)
@@ -221,7 +250,7 @@ func (g *gen) genStatement(typ types.Type, this, that string) error {
 			return nil
 		}
 		external := g.TypesMap.IsExternal(named)
-		fields := derive.Fields(g.TypesMap, strct, external)
+		fields := derive.Fields(g.TypesMap, strct, external).For("compare")
 		if fields.Reflect {
 			p.P(`thisv := ` + g.reflectPkg() + `.Indirect(` + g.reflectPkg() + `.ValueOf(` + this + `))`)
 			p.P(`thatv := ` + g.reflectPkg() + `.Indirect(` + g.reflectPkg() + `.ValueOf(` + that + `))`)
@@ -316,6 +345,8 @@ func (g *gen) genStatement(typ types.Type, this, that string) error {
 			p.P("return " + fieldStr)
 			return nil
 		}
+	case *types.Interface:
+		return g.genInterface(ttyp, this, that)
 	case *types.Slice:
 		p.P("if %s == nil {", this)
 		p.In()
@@ -449,6 +480,80 @@ func (g *gen) genStatement(typ types.Type, this, that string) error {
 	return fmt.Errorf("unsupported compare type: %s", g.TypeString(typ))
 }
 
+// isOrdered returns whether all the types in the type set of the type parameter support the < operator.
+func isOrdered(tp *types.TypeParam) bool {
+	return derive.TypeSetAll(tp, func(t types.Type) bool {
+		b, ok := t.(*types.Basic)
+		return ok && b.Info()&types.IsOrdered != 0
+	})
+}
+
+// supported returns whether the type can be compared with generated code or its Compare method.
+func (g *gen) supported(typ types.Type) bool {
+	return derive.Supported("compare", typ, func(t types.Type) bool {
+		named, ok := t.(*types.Named)
+		return ok && compareMethodInputParam(named) != nil
+	})
+}
+
+// genInterface orders values by the name of their dynamic type first.
+// Values of the same type are compared by type switching over the types in the package, which implement the interface and are supported,
+// and otherwise by a Compare method, that accepts the dynamic type, or by their Go syntax representation.
+func (g *gen) genInterface(typ *types.Interface, this, that string) error {
+	p := g.printer
+	p.P("if %s == nil {", this)
+	p.In()
+	p.P("if %s == nil {", that)
+	p.In()
+	p.P("return 0")
+	p.Out()
+	p.P("}")
+	p.P("return -1")
+	p.Out()
+	p.P("}")
+	p.P("if %s == nil {", that)
+	p.In()
+	p.P("return 1")
+	p.Out()
+	p.P("}")
+	p.P("if thisType, thatType := %s.TypeOf(%s), %s.TypeOf(%s); thisType != thatType {", g.reflectPkg(), this, g.reflectPkg(), that)
+	p.In()
+	p.P("return %s.Compare(thisType.String(), thatType.String())", g.stringsPkg())
+	p.Out()
+	p.P("}")
+	if impls := derive.Implementations(g.TypesMap, typ, g.supported); len(impls) > 0 {
+		p.P("switch %s := %s.(type) {", this, this)
+		for _, impl := range impls {
+			p.P("case %s:", g.TypeString(impl))
+			p.In()
+			p.P("%s := %s.(%s)", that, that, g.TypeString(impl))
+			fieldStr, err := g.field(this, that, impl)
+			if err != nil {
+				return err
+			}
+			p.P("return %s", fieldStr)
+			p.Out()
+		}
+		p.P("}")
+	}
+	p.P("if cmp := %s.ValueOf(%s).MethodByName(\"Compare\"); cmp.IsValid() {", g.reflectPkg(), this)
+	p.In()
+	p.P("if t := cmp.Type(); t.NumIn() == 1 && t.In(0) == %s.TypeOf(%s) && t.NumOut() == 1 && t.Out(0).Kind() == %s.Int {", g.reflectPkg(), that, g.reflectPkg())
+	p.In()
+	p.P("return int(cmp.Call([]%s.Value{%s.ValueOf(%s)})[0].Int())", g.reflectPkg(), g.reflectPkg(), that)
+	p.Out()
+	p.P("}")
+	p.Out()
+	p.P("}")
+	p.P("if %s.DeepEqual(%s, %s) {", g.reflectPkg(), this, that)
+	p.In()
+	p.P("return 0")
+	p.Out()
+	p.P("}")
+	p.P("return %s.Compare(%s.Sprintf(\"%%#v\", %s), %s.Sprintf(\"%%#v\", %s))", g.stringsPkg(), g.fmtPkg(), this, g.fmtPkg(), that)
+	return nil
+}
+
 func wrap(value string) string {
 	if strings.HasPrefix(value, "*") || strings.HasPrefix(value, "&") {
 		return "(" + value + ")"
@@ -470,6 +575,12 @@ func (g *gen) field(thisField, thatField string, fieldType types.Type) (string,
 			}
 		}
 	}
+	if tp, ok := fieldType.(*types.TypeParam); ok {
+		if !isOrdered(tp) {
+			return "", fmt.Errorf("unsupported type parameter %s, which is not ordered", tp)
+		}
+		return fmt.Sprintf("%s(%s, %s)", g.GetFuncName(fieldType, fieldType), thisField, thatField), nil
+	}
 	switch typ := fieldType.Underlying().(type) {
 	case *types.Basic:
 		if typ.Kind() == types.String {
@@ -501,9 +612,11 @@ func (g *gen) field(thisField, thatField string, fieldType types.Type) (string,
 			return fmt.Sprintf("%s.Compare(%s, %s)", g.bytesPkg(), thisField, thatField), nil
 		}
 		return fmt.Sprintf("%s(%s, %s)", g.GetFuncName(typ, typ), thisField, thatField), nil
+	case *types.Interface:
+		return fmt.Sprintf("%s(%s, %s)", g.GetFuncName(fieldType, fieldType), thisField, thatField), nil
 	case *types.Struct:
 		return g.field("&"+thisField, "&"+thatField, types.NewPointer(fieldType))
-	default: // *Chan, *Tuple, *Signature, *Interface, *types.Basic.Kind() == types.UntypedNil, *Struct
+	default: // *Chan, *Tuple, *Signature, *types.Basic.Kind() == types.UntypedNil, *Struct
 		return "", fmt.Errorf("unsupported field type %s", g.TypeString(fieldType))
 	}
 }
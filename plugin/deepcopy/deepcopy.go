@@ -56,13 +56,21 @@
 //	- maps
 //	- pointers to these types
 //	- private fields of structs in external packages (using reflect and unsafe)
+//	- instantiated generic types
+//	- type parameters that only allow basic types, in which case a generic function is generated
+//	- interfaces, by type switching over the types in the package, which implement them
 //	- and many more
 // Unsupported types:
 //	- chan
-//	- interface
 //	- function
 //	- unnamed structs, which are not comparable with the == operator
 //
+// The deriveDeepCopyGraph function copies pointer graphs, which contain shared or cyclic pointers, such as doubly linked lists.
+// It remembers the copy of each pointer that it visits, so that pointers, which are shared or cyclic in src,
+// are also shared or cyclic in dst, where deriveDeepCopy would copy shared pointers more than once and never return on cycles.
+// DeepCopy methods of types in the package are not called, since they do not pass along the visited pointers.
+//	deriveDeepCopyGraph(dst *T, src *T)
+//
 // Example output can be found here:
 // https://github.com/awalterschulze/goderive/tree/master/example/plugin/deepcopy
 //
@@ -79,8 +87,32 @@ import (
 
 // NewPlugin creates a new deepcopy plugin.
 // This function returns the plugin name, default prefix and a constructor for the deepcopy code generator.
+// It also derives functions for types that are annotated with the //goderive:deepcopy directive,
+// where the method option generates the DeepCopy method.
 func NewPlugin() derive.Plugin {
-	return derive.NewPlugin("deepcopy", "deriveDeepCopy", New)
+	return derive.NewPluginWithDirective("deepcopy", "deriveDeepCopy", New, derive.Directive{
+		Args: func(recv types.Type) []types.Type {
+			return []types.Type{recv, recv}
+		},
+		Method:   "DeepCopy",
+		Template: "// %[2]s copies this into that.\nfunc (this %[1]s) %[2]s(that %[1]s) {\n\t%[3]s(that, this)\n}",
+	})
+}
+
+// NewGraphPlugin creates a new deepcopygraph plugin.
+// This function returns the plugin name, default prefix and a constructor for the deepcopygraph code generator.
+func NewGraphPlugin() derive.Plugin {
+	return derive.NewPlugin("deepcopygraph", "deriveDeepCopyGraph", NewGraph)
+}
+
+// NewGraph is a constructor for the deepcopygraph code generator,
+// which passes along the copies of the visited pointers.
+// This generator should be reconstructed for each package.
+func NewGraph(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
+	g := New(typesMap, p, deps).(*gen)
+	empty := types.NewInterfaceType(nil, nil)
+	g.visited = types.NewMap(empty, empty)
+	return g
 }
 
 // New is a constructor for the deepcopy code generator.
@@ -101,6 +133,8 @@ type gen struct {
 	bytesPkg   derive.Import
 	reflectPkg derive.Import
 	unsafePkg  derive.Import
+	// visited is the type of the map from the visited pointers to their copies, which is nil, unless copying graphs.
+	visited types.Type
 }
 
 func (g *gen) Add(name string, typs []types.Type) (string, error) {
@@ -111,20 +145,76 @@ func (g *gen) Add(name string, typs []types.Type) (string, error) {
 		return "", fmt.Errorf("%s has two arguments, but they are of different types %s != %s",
 			name, g.TypeString(typs[0]), g.TypeString(typs[1]))
 	}
+	if types.IsInterface(typs[0]) {
+		return "", fmt.Errorf("%s cannot copy into an interface, use pointers to %s", name, g.TypeString(typs[0]))
+	}
 	return g.SetFuncName(name, typs[0])
 }
 
 func (g *gen) Generate(typs []types.Type) error {
+	if g.visited != nil && len(typs) == 1 {
+		return g.genGraphFunc(typs[0])
+	}
 	return g.genFunc(typs[0])
 }
 
-func (g *gen) genFunc(typ types.Type) error {
+// funcTypes returns the types of the function, which copies the type,
+// which include the visited pointers, when copying graphs.
+func (g *gen) funcTypes(typ types.Type) []types.Type {
+	if g.visited == nil {
+		return []types.Type{typ}
+	}
+	return []types.Type{typ, g.visited}
+}
+
+// params returns the extra parameters of the function, which copies the type.
+func (g *gen) params() string {
+	if g.visited == nil {
+		return ""
+	}
+	return ", visited " + g.TypeString(g.visited)
+}
+
+// call returns a call to the function, which copies the type.
+func (g *gen) call(typ types.Type, args ...string) string {
+	if g.visited != nil {
+		args = append(args, "visited")
+	}
+	return g.GetFuncName(g.funcTypes(typ)...) + "(" + strings.Join(args, ", ") + ")"
+}
+
+// genGraphFunc generates the function, which is called to copy a graph,
+// and which calls the function that passes along the visited pointers.
+func (g *gen) genGraphFunc(typ types.Type) error {
 	p := g.printer
 	g.Generating(typ)
+	name := g.GetFuncName(typ)
+	visited := fmt.Sprintf("make(%s)", g.TypeString(g.visited))
+	if _, ok := typ.Underlying().(*types.Pointer); ok {
+		visited = fmt.Sprintf("%s{src: dst}", g.TypeString(g.visited))
+	}
+	p.P("")
+	p.P("// %s recursively copies the contents of src into dst,", name)
+	p.P("// where pointers, which are shared or cyclic in src, are also shared or cyclic in dst.")
+	p.P("func %s%s(dst, src %s) {", name, g.TypeParams(typ), g.TypeString(typ))
+	p.In()
+	p.P("%s(dst, src, %s)", g.GetFuncName(g.funcTypes(typ)...), visited)
+	p.Out()
+	p.P("}")
+	return nil
+}
+
+func (g *gen) genFunc(typ types.Type) error {
+	p := g.printer
+	g.Generating(g.funcTypes(typ)...)
 	typeStr := g.TypeString(typ)
+	if iface, ok := typ.Underlying().(*types.Interface); ok {
+		return g.genInterfaceFunc(typ, iface)
+	}
+	name := g.GetFuncName(g.funcTypes(typ)...)
 	p.P("")
-	p.P("// %s recursively copies the contents of src into dst.", g.GetFuncName(typ))
-	p.P("func %s(dst, src %s) {", g.GetFuncName(typ), typeStr)
+	p.P("// %s recursively copies the contents of src into dst.", name)
+	p.P("func %s%s(dst, src %s%s) {", name, g.TypeParams(typ), typeStr, g.params())
 	p.In()
 	if err := g.genStatement(typ, "src", "dst"); err != nil {
 		return err
@@ -134,6 +224,62 @@ func (g *gen) genFunc(typ types.Type) error {
 	return nil
 }
 
+// supported returns whether the type can be copied with generated code or its DeepCopy method.
+func (g *gen) supported(typ types.Type) bool {
+	return derive.Supported("deepcopy", typ, func(t types.Type) bool {
+		named, ok := t.(*types.Named)
+		return canCopy(t) || (ok && g.hasDeepCopyMethod(named))
+	})
+}
+
+// genInterfaceFunc generates a function, which returns a copy of the value of an interface,
+// by type switching over the types in the package, which implement the interface and are supported.
+// Values of other types are copied with their DeepCopy method, if they have one,
+// and are otherwise copied by reference.
+func (g *gen) genInterfaceFunc(typ types.Type, iface *types.Interface) error {
+	p := g.printer
+	typeStr := g.TypeString(typ)
+	name := g.GetFuncName(g.funcTypes(typ)...)
+	p.P("")
+	p.P("// %s returns a recursive copy of src.", name)
+	p.P("func %s(src %s%s) %s {", name, typeStr, g.params(), typeStr)
+	p.In()
+	p.P("if src == nil {")
+	p.In()
+	p.P("return nil")
+	p.Out()
+	p.P("}")
+	if impls := derive.Implementations(g.TypesMap, iface, g.supported); len(impls) > 0 {
+		p.P("switch src := src.(type) {")
+		for _, impl := range impls {
+			p.P("case %s:", g.TypeString(impl))
+			p.In()
+			p.P("var dst %s", g.TypeString(impl))
+			if err := g.genField(impl, "src", "dst"); err != nil {
+				return err
+			}
+			p.P("return dst")
+			p.Out()
+		}
+		p.P("}")
+	}
+	p.P("if typ := %s.TypeOf(src); typ.Kind() == %s.Ptr {", g.reflectPkg(), g.reflectPkg())
+	p.In()
+	p.P("if cp := %s.ValueOf(src).MethodByName(\"DeepCopy\"); cp.IsValid() && cp.Type().NumIn() == 1 && cp.Type().In(0) == typ {", g.reflectPkg())
+	p.In()
+	p.P("dst := %s.New(typ.Elem())", g.reflectPkg())
+	p.P("cp.Call([]%s.Value{dst})", g.reflectPkg())
+	p.P("return dst.Interface().(%s)", typeStr)
+	p.Out()
+	p.P("}")
+	p.Out()
+	p.P("}")
+	p.P("return src")
+	p.Out()
+	p.P("}")
+	return nil
+}
+
 func (g *gen) genStatement(typ types.Type, this, that string) error {
 	p := g.printer
 	if canCopy(typ) {
@@ -154,7 +300,7 @@ func (g *gen) genStatement(typ types.Type, this, that string) error {
 			return nil
 		} else if isNamed {
 			external := g.TypesMap.IsExternal(named)
-			fields := derive.Fields(g.TypesMap, strct, external)
+			fields := derive.Fields(g.TypesMap, strct, external).For("deepcopy")
 			if len(fields.Fields) > 0 {
 				thisv := prepend(this, "v")
 				thatv := prepend(that, "v")
@@ -170,6 +316,10 @@ func (g *gen) genStatement(typ types.Type, this, that string) error {
 					} else {
 						thisField, thatField = field.Name(this, nil), field.Name(that, nil)
 					}
+					if field.Tag.Shallow("deepcopy") {
+						p.P("%s = %s", thatField, thisField)
+						continue
+					}
 					if err := g.genField(fieldType, thisField, thatField); err != nil {
 						return err
 					}
@@ -266,11 +416,17 @@ func prepend(before, after string) string {
 }
 
 func canCopy(tt types.Type) bool {
+	if tp, ok := tt.(*types.TypeParam); ok {
+		return derive.TypeSetAll(tp, canCopy)
+	}
 	t := tt.Underlying()
 	switch typ := t.(type) {
 	case *types.Basic:
 		return typ.Kind() != types.UntypedNil
 	case *types.Struct:
+		if derive.SkipsFields(typ, "deepcopy") {
+			return false
+		}
 		for i := 0; i < typ.NumFields(); i++ {
 			f := typ.Field(i)
 			ft := f.Type()
@@ -285,7 +441,13 @@ func canCopy(tt types.Type) bool {
 	return false
 }
 
-func hasDeepCopyMethod(typ *types.Named) bool {
+// hasDeepCopyMethod returns whether the type has a DeepCopy method, which is called instead of generating a function.
+// When copying graphs, the DeepCopy methods of types in the package are not called,
+// since they do not pass along the visited pointers.
+func (g *gen) hasDeepCopyMethod(typ *types.Named) bool {
+	if g.visited != nil && !g.TypesMap.IsExternal(typ) {
+		return false
+	}
 	for i := 0; i < typ.NumMethods(); i++ {
 		meth := typ.Method(i)
 		if meth.Name() != "DeepCopy" {
@@ -320,16 +482,25 @@ func (g *gen) genField(fieldType types.Type, thisField, thatField string) error
 		p.In()
 		p.P("%s = nil", thatField)
 		p.Out()
+		if g.visited != nil {
+			p.P("} else if v, ok := visited[%s]; ok {", thisField)
+			p.In()
+			p.P("%s = v.(%s)", thatField, g.TypeString(fieldType))
+			p.Out()
+		}
 		p.P("} else {")
 		p.In()
 		ref := typ.Elem()
 		p.P("%s = new(%s)", thatField, g.TypeString(typ.Elem()))
-		if named, ok := ref.(*types.Named); ok && hasDeepCopyMethod(named) {
+		if g.visited != nil {
+			p.P("visited[%s] = %s", thisField, thatField)
+		}
+		if named, ok := ref.(*types.Named); ok && g.hasDeepCopyMethod(named) {
 			p.P("%s.DeepCopy(%s)", wrap(thisField), thatField)
 		} else if canCopy(typ.Elem()) {
 			p.P("*%s = *%s", thatField, thisField)
 		} else {
-			p.P("%s(%s, %s)", g.GetFuncName(typ), thatField, thisField)
+			p.P("%s", g.call(typ, thatField, thisField))
 		}
 		p.Out()
 		p.P("}")
@@ -372,7 +543,7 @@ func (g *gen) genField(fieldType types.Type, thisField, thatField string) error
 		if canCopy(typ.Elem()) {
 			p.P("copy(%s, %s)", thatField, thisField)
 		} else {
-			p.P("%s(%s, %s)", g.GetFuncName(typ), thatField, thisField)
+			p.P("%s", g.call(typ, thatField, thisField))
 		}
 		p.Out()
 		p.P("}") // nil
@@ -381,7 +552,7 @@ func (g *gen) genField(fieldType types.Type, thisField, thatField string) error
 		p.P("if %s != nil {", thisField)
 		p.In()
 		p.P("%s = make(%s, len(%s))", thatField, g.TypeString(typ), thisField)
-		p.P("%s(%s, %s)", g.GetFuncName(typ), thatField, thisField)
+		p.P("%s", g.call(typ, thatField, thisField))
 		p.Out()
 		p.P("} else {")
 		p.In()
@@ -392,14 +563,17 @@ func (g *gen) genField(fieldType types.Type, thisField, thatField string) error
 	case *types.Struct:
 		p.P("field := new(%s)", g.TypeString(fieldType))
 		named, isNamed := fieldType.(*types.Named)
-		if isNamed && hasDeepCopyMethod(named) {
+		if isNamed && g.hasDeepCopyMethod(named) {
 			p.P("%s.DeepCopy(field)", wrap(thisField))
 		} else {
-			p.P("%s(field, &%s)", g.GetFuncName(types.NewPointer(fieldType)), wrap(thisField))
+			p.P("%s", g.call(types.NewPointer(fieldType), "field", "&"+wrap(thisField)))
 		}
 		p.P("%s = *field", thatField)
 		return nil
-	default: // *Chan, *Tuple, *Signature, *Interface, *types.Basic.Kind() == types.UntypedNil, *Struct
+	case *types.Interface:
+		p.P("%s = %s", thatField, g.call(fieldType, thisField))
+		return nil
+	default: // *Chan, *Tuple, *Signature, *types.Basic.Kind() == types.UntypedNil, *Struct
 		return fmt.Errorf("unsupported field type %s", g.TypeString(fieldType))
 	}
 }
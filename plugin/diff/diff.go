@@ -0,0 +1,476 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package diff contains the implementation of the diff plugin, which generates the deriveDiff function.
+//
+// The deriveDiff function returns the differences between two values, for example to report why a test failed.
+//   deriveDiff(T, T) []string
+//
+// Each difference is the path to the difference, followed by the two different values, for example:
+//
+//	.Name: "a" != "b"
+//	.Parent.Ages[2]: 3 != 4
+//	.Ages: len 3 != 2
+//	.Tags["b"]: 1 != <missing>
+//
+// Values of types with an Equal method, which has a value receiver and accepts the same type, like time.Time,
+// are compared with the Equal method.
+// The differences in a map are sorted, so that they are always in the same order.
+//
+// Supported types:
+//	- basic types
+//	- named structs
+//	- slices
+//	- maps
+//	- pointers to these types
+//	- private fields of structs in external packages (using reflect and unsafe)
+//	- instantiated generic types
+//	- comparable type parameters, in which case a generic function is generated
+//	- interfaces, by type switching over the types in the package, which implement them
+//	- and many more
+// Unsupported types:
+//	- chan
+//	- function
+package diff
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+
+	"github.com/awalterschulze/goderive/derive"
+)
+
+// NewPlugin creates a new diff plugin.
+// This function returns the plugin name, default prefix and a constructor for the diff code generator.
+func NewPlugin() derive.Plugin {
+	return derive.NewPlugin("diff", "deriveDiff", New)
+}
+
+// New is a constructor for the diff code generator.
+// This generator should be reconstructed for each package.
+func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
+	return &gen{
+		TypesMap:   typesMap,
+		printer:    p,
+		fmtPkg:     p.NewImport("fmt", "fmt"),
+		reflectPkg: p.NewImport("reflect", "reflect"),
+		sortPkg:    p.NewImport("sort", "sort"),
+		stringsPkg: p.NewImport("strings", "strings"),
+		unsafePkg:  p.NewImport("unsafe", "unsafe"),
+		path:       types.Typ[types.String],
+	}
+}
+
+type gen struct {
+	derive.TypesMap
+	printer    derive.Printer
+	fmtPkg     derive.Import
+	reflectPkg derive.Import
+	sortPkg    derive.Import
+	stringsPkg derive.Import
+	unsafePkg  derive.Import
+	// path is the type of the path, which is passed to the functions that append the differences.
+	path types.Type
+}
+
+func (g *gen) Add(name string, typs []types.Type) (string, error) {
+	if len(typs) != 2 {
+		return "", fmt.Errorf("%s does not have two arguments", name)
+	}
+	if !types.Identical(typs[0], typs[1]) {
+		return "", fmt.Errorf("%s has two arguments, but they are of different types %s != %s",
+			name, g.TypeString(typs[0]), g.TypeString(typs[1]))
+	}
+	return g.SetFuncName(name, typs...)
+}
+
+func (g *gen) Generate(typs []types.Type) error {
+	if len(typs) == 2 {
+		return g.genDiffFunc(typs[0])
+	}
+	return g.genFunc(typs[0])
+}
+
+// funcTypes returns the types of the function, which appends the differences between values of the type.
+func (g *gen) funcTypes(typ types.Type) []types.Type {
+	return []types.Type{typ, typ, g.path}
+}
+
+// genDiffFunc generates the function, which is called to return the differences.
+func (g *gen) genDiffFunc(typ types.Type) error {
+	p := g.printer
+	g.Generating(typ, typ)
+	name := g.GetFuncName(typ, typ)
+	p.P("")
+	p.P("// %s returns the differences between this and that, each prefixed by the path to the difference.", name)
+	if err := g.genSignature(name, typ, "", "[]string"); err != nil {
+		return err
+	}
+	p.In()
+	p.P("diffs := %s(this, that, \"\", nil)", g.GetFuncName(g.funcTypes(typ)...))
+	p.P("for i := range diffs {")
+	p.In()
+	p.P("diffs[i] = %s.TrimPrefix(diffs[i], \": \")", g.stringsPkg())
+	p.Out()
+	p.P("}")
+	p.P("return diffs")
+	p.Out()
+	p.P("}")
+	return nil
+}
+
+func (g *gen) genFunc(typ types.Type) error {
+	p := g.printer
+	g.Generating(g.funcTypes(typ)...)
+	name := g.GetFuncName(g.funcTypes(typ)...)
+	p.P("")
+	p.P("// %s appends the differences between this and that to diffs, where path is the path to this and that.", name)
+	if err := g.genSignature(name, typ, ", path string, diffs []string", "[]string"); err != nil {
+		return err
+	}
+	p.In()
+	if err := g.genStatement(typ, "this", "that"); err != nil {
+		return err
+	}
+	p.Out()
+	p.P("}")
+	return nil
+}
+
+// genSignature prints the signature of a function, with the parameters this and that, followed by the extra parameters.
+func (g *gen) genSignature(name string, typ types.Type, params string, result string) error {
+	p := g.printer
+	strct, ok := typ.(*types.Struct)
+	if !ok {
+		p.P("func %s%s(this, that %s%s) %s {", name, g.TypeParams(typ), g.TypeString(typ), params, result)
+		return nil
+	}
+	fieldStrs, err := g.FieldStrings(derive.GetStructFields(strct))
+	if err != nil {
+		return err
+	}
+	p.P("func %s%s(this, that struct {", name, g.TypeParams(typ))
+	p.In()
+	for _, fieldStr := range fieldStrs {
+		p.P(fieldStr)
+	}
+	p.Out()
+	p.P("}%s) %s {", params, result)
+	return nil
+}
+
+// path is the path to a value, as the arguments of fmt.Sprintf.
+type path struct {
+	format string
+	args   []string
+}
+
+var root = path{"%s", []string{"path"}}
+
+func (p path) field(name string) path {
+	return path{p.format + "." + name, p.args}
+}
+
+func (p path) index(i string) path {
+	return path{p.format + "[%d]", append(append([]string(nil), p.args...), i)}
+}
+
+func (p path) key(k string) path {
+	return path{p.format + "[%#v]", append(append([]string(nil), p.args...), k)}
+}
+
+// expr returns the expression, which evaluates to the path.
+func (g *gen) expr(p path) string {
+	if p.format == root.format {
+		return p.args[0]
+	}
+	if strings.HasPrefix(p.format, "%s") && !strings.Contains(p.format[2:], "%") {
+		return fmt.Sprintf("%s+%q", p.args[0], p.format[2:])
+	}
+	return fmt.Sprintf("%s.Sprintf(%q, %s)", g.fmtPkg(), p.format, strings.Join(p.args, ", "))
+}
+
+// genDiff prints the statement, which appends a difference with the two values at the path.
+func (g *gen) genDiff(p path, this, that string) {
+	args := append(append([]string(nil), p.args...), this, that)
+	g.printer.P("diffs = append(diffs, %s.Sprintf(%q, %s))", g.fmtPkg(), p.format+": %#v != %#v", strings.Join(args, ", "))
+}
+
+// genNil prints the statements, which return, if either this or that is nil,
+// after appending a difference, if only one of them is nil.
+func (g *gen) genNil(this, that string) {
+	p := g.printer
+	p.P("if %s == nil || %s == nil {", this, that)
+	p.In()
+	p.P("if (%s == nil) != (%s == nil) {", this, that)
+	p.In()
+	g.genDiff(root, this, that)
+	p.Out()
+	p.P("}")
+	p.P("return diffs")
+	p.Out()
+	p.P("}")
+}
+
+func (g *gen) genStatement(typ types.Type, this, that string) error {
+	p := g.printer
+	if _, ok := typ.(*types.TypeParam); ok {
+		if err := g.genField(typ, this, that, root); err != nil {
+			return err
+		}
+		p.P("return diffs")
+		return nil
+	}
+	switch ttyp := typ.Underlying().(type) {
+	case *types.Basic:
+		if err := g.genField(typ, this, that, root); err != nil {
+			return err
+		}
+		p.P("return diffs")
+		return nil
+	case *types.Pointer:
+		g.genNil(this, that)
+		reftyp := ttyp.Elem()
+		strct, isStruct := reftyp.Underlying().(*types.Struct)
+		named, isNamed := reftyp.(*types.Named)
+		if !isStruct || !isNamed {
+			if err := g.genField(reftyp, "*"+this, "*"+that, root); err != nil {
+				return err
+			}
+			p.P("return diffs")
+			return nil
+		}
+		external := g.TypesMap.IsExternal(named)
+		fields := derive.Fields(g.TypesMap, strct, external).For("diff")
+		if fields.Reflect {
+			p.P(`thisv := `+g.reflectPkg()+`.Indirect(`+g.reflectPkg()+`.ValueOf(%s))`, this)
+			p.P(`thatv := `+g.reflectPkg()+`.Indirect(`+g.reflectPkg()+`.ValueOf(%s))`, that)
+		}
+		for _, field := range fields.Fields {
+			var thisField, thatField string
+			if field.Private() && external {
+				thisField, thatField = field.Name("thisv", g.unsafePkg), field.Name("thatv", g.unsafePkg)
+			} else {
+				thisField, thatField = field.Name(this, nil), field.Name(that, nil)
+			}
+			if err := g.genField(field.Type, thisField, thatField, root.field(field.DebugName())); err != nil {
+				return err
+			}
+		}
+		p.P("return diffs")
+		return nil
+	case *types.Struct:
+		if _, isNamed := typ.(*types.Named); isNamed {
+			p.P("return %s(&%s, &%s, path, diffs)", g.GetFuncName(g.funcTypes(types.NewPointer(typ))...), this, that)
+			return nil
+		}
+		for _, field := range derive.Fields(g.TypesMap, ttyp, false).For("diff").Fields {
+			if err := g.genField(field.Type, field.Name(this, nil), field.Name(that, nil), root.field(field.DebugName())); err != nil {
+				return err
+			}
+		}
+		p.P("return diffs")
+		return nil
+	case *types.Interface:
+		return g.genInterface(ttyp, this, that)
+	case *types.Slice:
+		g.genNil(this, that)
+		p.P("if len(%s) != len(%s) {", this, that)
+		p.In()
+		p.P("diffs = append(diffs, %s.Sprintf(\"%%s: len %%d != %%d\", path, len(%s), len(%s)))", g.fmtPkg(), this, that)
+		p.Out()
+		p.P("}")
+		p.P("for i := 0; i < len(%s) && i < len(%s); i++ {", this, that)
+		p.In()
+		if err := g.genField(ttyp.Elem(), wrap(this)+"[i]", wrap(that)+"[i]", root.index("i")); err != nil {
+			return err
+		}
+		p.Out()
+		p.P("}")
+		p.P("return diffs")
+		return nil
+	case *types.Array:
+		p.P("for i := 0; i < len(%s); i++ {", this)
+		p.In()
+		if err := g.genField(ttyp.Elem(), wrap(this)+"[i]", wrap(that)+"[i]", root.index("i")); err != nil {
+			return err
+		}
+		p.Out()
+		p.P("}")
+		p.P("return diffs")
+		return nil
+	case *types.Map:
+		g.genNil(this, that)
+		p.P("n := len(diffs)")
+		p.P("for k, thisv := range %s {", this)
+		p.In()
+		p.P("thatv, ok := %s[k]", wrap(that))
+		p.P("if !ok {")
+		p.In()
+		p.P("diffs = append(diffs, %s.Sprintf(\"%%s[%%#v]: %%#v != <missing>\", path, k, thisv))", g.fmtPkg())
+		p.P("continue")
+		p.Out()
+		p.P("}")
+		if err := g.genField(ttyp.Elem(), "thisv", "thatv", root.key("k")); err != nil {
+			return err
+		}
+		p.Out()
+		p.P("}")
+		p.P("for k, thatv := range %s {", that)
+		p.In()
+		p.P("if _, ok := %s[k]; !ok {", wrap(this))
+		p.In()
+		p.P("diffs = append(diffs, %s.Sprintf(\"%%s[%%#v]: <missing> != %%#v\", path, k, thatv))", g.fmtPkg())
+		p.Out()
+		p.P("}")
+		p.Out()
+		p.P("}")
+		p.P("%s.Strings(diffs[n:])", g.sortPkg())
+		p.P("return diffs")
+		return nil
+	}
+	return fmt.Errorf("unsupported type: %s", g.TypeString(typ))
+}
+
+// supported returns whether the differences between values of the type can be found with generated code or its Equal method.
+func (g *gen) supported(typ types.Type) bool {
+	return derive.Supported("diff", typ, func(t types.Type) bool {
+		named, ok := t.(*types.Named)
+		return ok && hasEqualMethod(named)
+	})
+}
+
+// genInterface type switches over the types in the package, which implement the interface and are supported,
+// and otherwise falls back to reflect.DeepEqual.
+func (g *gen) genInterface(typ *types.Interface, this, that string) error {
+	p := g.printer
+	p.P("if %s == nil || %s == nil {", this, that)
+	p.In()
+	p.P("if %s != nil || %s != nil {", this, that)
+	p.In()
+	g.genDiff(root, this, that)
+	p.Out()
+	p.P("}")
+	p.P("return diffs")
+	p.Out()
+	p.P("}")
+	p.P("if %s.TypeOf(%s) != %s.TypeOf(%s) {", g.reflectPkg(), this, g.reflectPkg(), that)
+	p.In()
+	g.genDiff(root, this, that)
+	p.P("return diffs")
+	p.Out()
+	p.P("}")
+	if impls := derive.Implementations(g.TypesMap, typ, g.supported); len(impls) > 0 {
+		p.P("switch %s := %s.(type) {", this, this)
+		for _, impl := range impls {
+			p.P("case %s:", g.TypeString(impl))
+			p.In()
+			p.P("%s := %s.(%s)", that, that, g.TypeString(impl))
+			if err := g.genField(impl, this, that, root); err != nil {
+				return err
+			}
+			p.P("return diffs")
+			p.Out()
+		}
+		p.P("}")
+	}
+	p.P("if !%s.DeepEqual(%s, %s) {", g.reflectPkg(), this, that)
+	p.In()
+	g.genDiff(root, this, that)
+	p.Out()
+	p.P("}")
+	p.P("return diffs")
+	return nil
+}
+
+// genField prints the statements, which append the differences between the fields at the path.
+func (g *gen) genField(fieldType types.Type, thisField, thatField string, at path) error {
+	p := g.printer
+	if tp, ok := fieldType.(*types.TypeParam); ok {
+		if !types.Comparable(tp) {
+			return fmt.Errorf("unsupported type parameter %s, which is not comparable", tp)
+		}
+		p.P("if %s != %s {", thisField, thatField)
+		p.In()
+		g.genDiff(at, thisField, thatField)
+		p.Out()
+		p.P("}")
+		return nil
+	}
+	if named, ok := fieldType.(*types.Named); ok && hasEqualMethod(named) {
+		p.P("if !%s.Equal(%s) {", wrap(thisField), thatField)
+		p.In()
+		g.genDiff(at, thisField, thatField)
+		p.Out()
+		p.P("}")
+		return nil
+	}
+	switch typ := fieldType.Underlying().(type) {
+	case *types.Basic:
+		if typ.Kind() == types.UntypedNil {
+			return fmt.Errorf("unsupported type %s", g.TypeString(fieldType))
+		}
+		p.P("if %s != %s {", thisField, thatField)
+		p.In()
+		g.genDiff(at, thisField, thatField)
+		p.Out()
+		p.P("}")
+		return nil
+	case *types.Struct:
+		if _, isNamed := fieldType.(*types.Named); isNamed {
+			return g.genCall(types.NewPointer(fieldType), "&"+thisField, "&"+thatField, at)
+		}
+		return g.genCall(fieldType, thisField, thatField, at)
+	case *types.Pointer, *types.Slice, *types.Array, *types.Map, *types.Interface:
+		return g.genCall(fieldType, thisField, thatField, at)
+	}
+	// *Chan, *Tuple, *Signature
+	return fmt.Errorf("unsupported type %s", g.TypeString(fieldType))
+}
+
+// genCall prints the call to the function, which appends the differences between the values at the path.
+func (g *gen) genCall(typ types.Type, this, that string, at path) error {
+	g.printer.P("diffs = %s(%s, %s, %s, diffs)", g.GetFuncName(g.funcTypes(typ)...), this, that, g.expr(at))
+	return nil
+}
+
+// hasEqualMethod returns whether the type has an Equal method, which accepts the same type.
+func hasEqualMethod(typ *types.Named) bool {
+	for i := 0; i < typ.NumMethods(); i++ {
+		meth := typ.Method(i)
+		if meth.Name() != "Equal" {
+			continue
+		}
+		sig := meth.Type().(*types.Signature)
+		if sig.Params().Len() != 1 || sig.Results().Len() != 1 {
+			continue
+		}
+		if b, ok := sig.Results().At(0).Type().(*types.Basic); !ok || b.Kind() != types.Bool {
+			continue
+		}
+		if _, ptrRecv := sig.Recv().Type().(*types.Pointer); ptrRecv {
+			continue
+		}
+		return types.Identical(sig.Params().At(0).Type(), typ)
+	}
+	return false
+}
+
+func wrap(value string) string {
+	if strings.HasPrefix(value, "*") || strings.HasPrefix(value, "&") {
+		return "(" + value + ")"
+	}
+	return value
+}
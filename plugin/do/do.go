@@ -19,6 +19,15 @@
 // Each function is executed in a go routine and the first error is returned.
 // It waits for all functions to complete.
 //
+// The context aware deriveDo function passes a child context of ctx to each function,
+// which is canceled as soon as one of the functions returns an error.
+//   deriveDo(ctx context.Context, func(context.Context) (A, error), func(context.Context) (B, error)) (A, B, error)
+// The number of functions, which are executed at the same time, can be bounded by n,
+// where functions, which have not started yet, when the context is canceled, return the error of the context.
+// A bound less than one is the same as no bound.
+//   deriveDo(ctx context.Context, n int, func(context.Context) (A, error), func(context.Context) (B, error)) (A, B, error)
+// It also waits for all functions to complete and then returns the first error.
+//
 // The concept is stolen from applicative do in haskell or rather haxl.
 // http://simonmar.github.io/bib/papers/applicativedo.pdf
 // The applicative do rewrites the monadic do notation:
@@ -93,35 +102,57 @@ func NewPlugin() derive.Plugin {
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
 	return &gen{
-		TypesMap: typesMap,
-		printer:  p,
+		TypesMap:   typesMap,
+		printer:    p,
+		contextPkg: p.NewImport("context", "context"),
 	}
 }
 
 type gen struct {
 	derive.TypesMap
-	printer derive.Printer
+	printer    derive.Printer
+	contextPkg derive.Import
 }
 
 func (g *gen) Add(name string, typs []types.Type) (string, error) {
-	if len(typs) < 2 {
-		return "", fmt.Errorf("%s expected at least two arguments", name)
+	// The types are copied, so that defaulting the bound does not change the types of the caller.
+	typs = append([]types.Type(nil), typs...)
+	funcs := typs
+	if len(typs) > 0 && derive.IsContext(typs[0]) {
+		funcs = typs[1:]
+		if len(funcs) > 0 && isInteger(funcs[0]) {
+			typs[1] = types.Default(typs[1])
+			funcs = funcs[1:]
+		}
 	}
-	for i, typ := range typs {
+	if len(funcs) < 2 {
+		return "", fmt.Errorf("%s expected at least two function arguments", name)
+	}
+	ctx := len(funcs) != len(typs)
+	for i, typ := range funcs {
 		sig, ok := typ.(*types.Signature)
 		if !ok {
-			return "", fmt.Errorf("%s's argument number %d is not a function, but %s", name, i, typ)
+			return "", fmt.Errorf("%s's argument number %d is not a function, but %s", name, i+len(typs)-len(funcs), typ)
 		}
-		if _, err := g.errorOut(name, sig); err != nil {
+		if _, err := g.errorOut(name, sig, ctx); err != nil {
 			return "", err
 		}
 	}
 	return g.SetFuncName(name, typs...)
 }
 
-func (g *gen) errorOut(name string, sig *types.Signature) (typ types.Type, err error) {
+func isInteger(typ types.Type) bool {
+	basic, ok := typ.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsInteger != 0
+}
+
+func (g *gen) errorOut(name string, sig *types.Signature, ctx bool) (typ types.Type, err error) {
 	params := sig.Params()
-	if params.Len() != 0 {
+	if ctx {
+		if params.Len() != 1 || !derive.IsContext(params.At(0).Type()) {
+			return nil, fmt.Errorf("%s, the function argument does not take a context as its only parameter", g.TypeString(sig))
+		}
+	} else if params.Len() != 0 {
 		return nil, fmt.Errorf("%s, the function argument does not take zero parameters", g.TypeString(sig))
 	}
 	res := sig.Results()
@@ -136,6 +167,9 @@ func (g *gen) errorOut(name string, sig *types.Signature) (typ types.Type, err e
 }
 
 func (g *gen) Generate(typs []types.Type) error {
+	if derive.IsContext(typs[0]) {
+		return g.genCtx(typs)
+	}
 	name := g.GetFuncName(typs...)
 	outs := make([]types.Type, len(typs))
 	outstrs := make([]string, len(typs))
@@ -143,7 +177,7 @@ func (g *gen) Generate(typs []types.Type) error {
 	vars := make([]string, len(typs))
 	fs := make([]string, len(typs))
 	for i, typ := range typs {
-		out, err := g.errorOut(name, typ.(*types.Signature))
+		out, err := g.errorOut(name, typ.(*types.Signature), false)
 		if err != nil {
 			return err
 		}
@@ -191,3 +225,89 @@ func (g *gen) Generate(typs []types.Type) error {
 	p.P("}")
 	return nil
 }
+
+// genCtx generates the context aware function, which cancels the context of the functions, when one of them returns an error.
+func (g *gen) genCtx(typs []types.Type) error {
+	name := g.GetFuncName(typs...)
+	funcs := typs[1:]
+	params := []string{"ctx " + g.TypeString(typs[0])}
+	bounded := isInteger(funcs[0])
+	if bounded {
+		params = append(params, "n "+g.TypeString(funcs[0]))
+		funcs = funcs[1:]
+	}
+	outstrs := make([]string, len(funcs))
+	vars := make([]string, len(funcs))
+	fs := make([]string, len(funcs))
+	for i, typ := range funcs {
+		out, err := g.errorOut(name, typ.(*types.Signature), true)
+		if err != nil {
+			return err
+		}
+		outstrs[i] = g.TypeString(out)
+		fs[i] = "f" + strconv.Itoa(i)
+		params = append(params, fmt.Sprintf("%s func(%s) (%s, error)", fs[i], g.TypeString(typs[0]), outstrs[i]))
+		vars[i] = fmt.Sprintf("v%d", i)
+	}
+	outstrs = append(outstrs, "error")
+	g.Generating(typs...)
+	p := g.printer
+	p.P("")
+	p.P("// %s concurrently executes the input functions %s and %s with a context, which is canceled as soon as one of them returns an error,", name, strings.Join(fs[:len(fs)-1], ", "), fs[len(fs)-1])
+	if bounded {
+		p.P("// where at most n functions are executed at the same time,")
+	}
+	p.P("// and when all functions are finished the first error, if any, and results are returned.")
+	p.P("func %s(%s) (%s) {", name, strings.Join(params, ", "), strings.Join(outstrs, ", "))
+	p.In()
+	p.P("ctx, cancel := %s.WithCancel(ctx)", g.contextPkg())
+	p.P("defer cancel()")
+	p.P("errChan := make(chan error)")
+	if bounded {
+		p.P("if n < 1 {")
+		p.In()
+		p.P("n = %d", len(funcs))
+		p.Out()
+		p.P("}")
+		p.P("sem := make(chan struct{}, n)")
+	}
+	for i := range funcs {
+		p.P("var %s %s", vars[i], outstrs[i])
+		p.P("go func() {")
+		p.In()
+		if bounded {
+			p.P("select {")
+			p.P("case sem <- struct{}{}:")
+			p.In()
+			p.P("defer func() { <-sem }()")
+			p.Out()
+			p.P("case <-ctx.Done():")
+			p.In()
+			p.P("errChan <- ctx.Err()")
+			p.P("return")
+			p.Out()
+			p.P("}")
+		}
+		p.P("var %serr error", vars[i])
+		p.P("%s, %serr = f%d(ctx)", vars[i], vars[i], i)
+		p.P("errChan <- %serr", vars[i])
+		p.Out()
+		p.P("}()")
+	}
+	p.P("var err error")
+	p.P("for i := 0; i < %d; i++ {", len(funcs))
+	p.In()
+	p.P("errc := <-errChan")
+	p.P("if errc != nil && err == nil {")
+	p.In()
+	p.P("err = errc")
+	p.P("cancel()")
+	p.Out()
+	p.P("}")
+	p.Out()
+	p.P("}")
+	p.P("return %s, err", strings.Join(vars, ", "))
+	p.Out()
+	p.P("}")
+	return nil
+}
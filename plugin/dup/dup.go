@@ -16,6 +16,10 @@
 //
 // The deriveDup duplicates messages received on c to both c1 and c2.
 //   deriveDup(c <-chan T) (c1, c2 <-chan T)
+//
+// The deriveTee function duplicates messages received on c to all n output channels.
+//   deriveTee(n int, c <-chan T) []<-chan T
+// Each message is sent to the output channels in order, so a consumer, which does not receive, blocks the other consumers.
 package dup
 
 import (
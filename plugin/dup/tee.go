@@ -0,0 +1,109 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package dup
+
+import (
+	"fmt"
+	"go/types"
+
+	"github.com/awalterschulze/goderive/derive"
+)
+
+// NewTeePlugin creates a new tee plugin.
+// This function returns the plugin name, default prefix and a constructor for the tee code generator.
+func NewTeePlugin() derive.Plugin {
+	return derive.NewPlugin("tee", "deriveTee", NewTee)
+}
+
+// NewTee is a constructor for the tee code generator, which duplicates messages to n channels.
+// This generator should be reconstructed for each package.
+func NewTee(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
+	return &teeGen{
+		TypesMap: typesMap,
+		printer:  p,
+	}
+}
+
+type teeGen struct {
+	derive.TypesMap
+	printer derive.Printer
+}
+
+func (g *teeGen) Add(name string, typs []types.Type) (string, error) {
+	if len(typs) != 2 {
+		return "", fmt.Errorf("%s expected two arguments", name)
+	}
+	// The types are copied, so that defaulting the number of channels does not change the types of the caller.
+	typs = append([]types.Type(nil), typs...)
+	typs[0] = types.Default(typs[0])
+	if !types.Identical(typs[0], types.Typ[types.Int]) {
+		return "", fmt.Errorf("%s, the number of channels, %s, is not an int", name, g.TypeString(typs[0]))
+	}
+	if _, ok := typs[1].(*types.Chan); !ok {
+		return "", fmt.Errorf("%s is not a channel: %s", name, typs[1])
+	}
+	return g.SetFuncName(name, typs...)
+}
+
+func (g *teeGen) Generate(typs []types.Type) error {
+	name := g.GetFuncName(typs...)
+	g.Generating(typs...)
+	p := g.printer
+	chanTyp := typs[1].(*types.Chan)
+	dirstr := ""
+	if chanTyp.Dir() == types.RecvOnly {
+		dirstr = "<-"
+	}
+	typstr := g.TypeString(chanTyp.Elem())
+	p.P("")
+	p.P("// %s duplicates messages received on c to all n output channels.", name)
+	p.P("func %s(n int, c %schan %s) []<-chan %s {", name, dirstr, typstr, typstr)
+	p.In()
+	p.P("if n < 1 {")
+	p.In()
+	p.P("n = 1")
+	p.Out()
+	p.P("}")
+	p.P("cs := make([]chan %s, n)", typstr)
+	p.P("outs := make([]<-chan %s, n)", typstr)
+	p.P("for i := range cs {")
+	p.In()
+	p.P("cs[i] = make(chan %s, cap(c))", typstr)
+	p.P("outs[i] = cs[i]")
+	p.Out()
+	p.P("}")
+	p.P("go func() {")
+	p.In()
+	p.P("for v := range c {")
+	p.In()
+	p.P("for _, o := range cs {")
+	p.In()
+	p.P("o <- v")
+	p.Out()
+	p.P("}")
+	p.Out()
+	p.P("}")
+	p.P("for _, o := range cs {")
+	p.In()
+	p.P("close(o)")
+	p.Out()
+	p.P("}")
+	p.Out()
+	p.P("}()")
+	p.P("return outs")
+	p.Out()
+	p.P("}")
+	return nil
+}
@@ -54,13 +54,22 @@
 //	- maps
 //	- pointers to these types
 //	- private fields of structs in external packages (using reflect and unsafe)
+//	- instantiated generic types
+//	- comparable type parameters, in which case a generic function is generated
+//	- interfaces, by type switching over the types in the package, which implement them
 //	- and many more
 // Unsupported types:
 //	- chan
-//	- interface
 //	- function
 //	- unnamed structs, which are not comparable with the == operator
 //
+// The deriveEqualGraph function compares pointer graphs, which contain cyclic pointers, such as doubly linked lists.
+// It remembers the pairs of pointers that it is comparing and assumes that a pair is equal, when it is compared again,
+// so that it returns on cycles, where deriveEqual would never return.
+// Equal methods of types in the package are not called, since they do not pass along the visited pointers.
+//	deriveEqualGraph(T, T) bool
+//	deriveEqualGraph(T) func(T) bool
+//
 // Example output can be found here:
 // https://github.com/awalterschulze/goderive/tree/master/example/plugin/equal
 //
@@ -77,8 +86,32 @@ import (
 
 // NewPlugin creates a new equal plugin.
 // This function returns the plugin name, default prefix and a constructor for the equal code generator.
+// It also derives functions for types that are annotated with the //goderive:equal directive,
+// where the method option generates the Equal method.
 func NewPlugin() derive.Plugin {
-	return derive.NewPlugin("equal", "deriveEqual", New)
+	return derive.NewPluginWithDirective("equal", "deriveEqual", New, derive.Directive{
+		Args: func(recv types.Type) []types.Type {
+			return []types.Type{recv, recv}
+		},
+		Method:   "Equal",
+		Template: "// %[2]s returns whether this and that are equal.\nfunc (this %[1]s) %[2]s(that %[1]s) bool {\n\treturn %[3]s(this, that)\n}",
+	})
+}
+
+// NewGraphPlugin creates a new equalgraph plugin.
+// This function returns the plugin name, default prefix and a constructor for the equalgraph code generator.
+func NewGraphPlugin() derive.Plugin {
+	return derive.NewPlugin("equalgraph", "deriveEqualGraph", NewGraph)
+}
+
+// NewGraph is a constructor for the equalgraph code generator,
+// which passes along the pairs of visited pointers.
+// This generator should be reconstructed for each package.
+func NewGraph(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
+	g := New(typesMap, p, deps).(*gen)
+	empty := types.NewInterfaceType(nil, nil)
+	g.visited = types.NewMap(types.NewArray(empty, 2), types.Typ[types.Bool])
+	return g
 }
 
 // New is a constructor for the equal code generator.
@@ -99,6 +132,8 @@ type gen struct {
 	bytesPkg   derive.Import
 	reflectPkg derive.Import
 	unsafePkg  derive.Import
+	// visited is the type of the set of the visited pairs of pointers, which is nil, unless comparing graphs.
+	visited types.Type
 }
 
 func (g *gen) Add(name string, typs []types.Type) (string, error) {
@@ -118,9 +153,49 @@ func (g *gen) Generate(typs []types.Type) error {
 	if len(typs) == 1 {
 		return g.genCurriedFunc(typs[0])
 	}
+	if g.visited != nil && len(typs) == 2 {
+		return g.genGraphFunc(typs[0])
+	}
 	return g.genFunc(typs)
 }
 
+// funcTypes returns the types of the function, which compares the type,
+// which include the visited pairs of pointers, when comparing graphs.
+func (g *gen) funcTypes(typ types.Type) []types.Type {
+	if g.visited == nil {
+		return []types.Type{typ, typ}
+	}
+	return []types.Type{typ, typ, g.visited}
+}
+
+// call returns a call to the function, which compares the type.
+func (g *gen) call(typ types.Type, this, that string) string {
+	name := g.GetFuncName(g.funcTypes(typ)...)
+	if g.visited != nil {
+		return fmt.Sprintf("%s(%s, %s, visited)", name, this, that)
+	}
+	return fmt.Sprintf("%s(%s, %s)", name, this, that)
+}
+
+// genGraphFunc generates the function, which is called to compare graphs,
+// and which calls the function that passes along the visited pairs of pointers.
+func (g *gen) genGraphFunc(typ types.Type) error {
+	p := g.printer
+	g.Generating(typ, typ)
+	name := g.GetFuncName(typ, typ)
+	p.P("")
+	p.P("// %s returns whether this and that are equal,", name)
+	p.P("// where pointers, which are compared again on a cycle, are assumed to be equal.")
+	if err := g.genSignature(name, typ, ""); err != nil {
+		return err
+	}
+	p.In()
+	p.P("return %s(this, that, make(%s))", g.GetFuncName(g.funcTypes(typ)...), g.TypeString(g.visited))
+	p.Out()
+	p.P("}")
+	return nil
+}
+
 func (g *gen) genCurriedFunc(typ types.Type) error {
 	p := g.printer
 	g.Generating(typ)
@@ -128,12 +203,14 @@ func (g *gen) genCurriedFunc(typ types.Type) error {
 	name := g.GetFuncName(typ)
 	p.P("")
 	p.P("// %s returns an equal closure, with the first parameter already filled in.", name)
-	p.P("func %s(this %s) func(%s) bool {", name, typeStr, typeStr)
+	p.P("func %s%s(this %s) func(%s) bool {", name, g.TypeParams(typ), typeStr, typeStr)
 	p.In()
 	p.P("return func(that %s) bool {", typeStr)
 	p.In()
-	if err := g.genStatement(typ, "this", "that"); err != nil {
-		return nil
+	if g.visited != nil {
+		p.P("return %s(this, that, make(%s))", g.GetFuncName(g.funcTypes(typ)...), g.TypeString(g.visited))
+	} else if err := g.genStatement(typ, "this", "that"); err != nil {
+		return err
 	}
 	p.Out()
 	p.P("}")
@@ -145,37 +222,75 @@ func (g *gen) genCurriedFunc(typ types.Type) error {
 func (g *gen) genFunc(typs []types.Type) error {
 	p := g.printer
 	g.Generating(typs...)
-	typeStr := g.TypeString(typs[0])
 	name := g.GetFuncName(typs...)
 	p.P("")
 	p.P("// %s returns whether this and that are equal.", name)
-	if strct, ok := typs[0].(*types.Struct); ok {
-		fields := derive.GetStructFields(strct)
-		fieldStrs, err := g.FieldStrings(fields)
-		if err != nil {
-			return err
-		}
-		p.P("func %s(this, that struct {", name)
-		p.In()
-		for _, fieldStr := range fieldStrs {
-			p.P(fieldStr)
-		}
-		p.Out()
-		p.P("}) bool {")
-	} else {
-		p.P("func %s(this, that %s) bool {", name, typeStr)
+	params := ""
+	if g.visited != nil {
+		params = ", visited " + g.TypeString(g.visited)
+	}
+	if err := g.genSignature(name, typs[0], params); err != nil {
+		return err
 	}
 	p.In()
 	if err := g.genStatement(typs[0], "this", "that"); err != nil {
-		return nil
+		return err
 	}
 	p.Out()
 	p.P("}")
 	return nil
 }
 
+// genSignature prints the signature of the function, which compares this and that, followed by the extra parameters.
+func (g *gen) genSignature(name string, typ types.Type, params string) error {
+	p := g.printer
+	strct, ok := typ.(*types.Struct)
+	if !ok {
+		p.P("func %s%s(this, that %s%s) bool {", name, g.TypeParams(typ), g.TypeString(typ), params)
+		return nil
+	}
+	fields := derive.GetStructFields(strct)
+	fieldStrs, err := g.FieldStrings(fields)
+	if err != nil {
+		return err
+	}
+	p.P("func %s%s(this, that struct {", name, g.TypeParams(typ))
+	p.In()
+	for _, fieldStr := range fieldStrs {
+		p.P(fieldStr)
+	}
+	p.Out()
+	p.P("}%s) bool {", params)
+	return nil
+}
+
+// genVisit prints the checks, which return when either pointer is nil or the pair of pointers is already being compared,
+// and otherwise remembers the pair of pointers.
+func (g *gen) genVisit(this, that string) {
+	p := g.printer
+	p.P("if %s == nil || %s == nil {", this, that)
+	p.In()
+	p.P("return %s == nil && %s == nil", this, that)
+	p.Out()
+	p.P("}")
+	key := fmt.Sprintf("%s{%s, %s}", g.TypeString(g.visited.(*types.Map).Key()), this, that)
+	p.P("if visited[%s] {", key)
+	p.In()
+	p.P("return true")
+	p.Out()
+	p.P("}")
+	p.P("visited[%s] = true", key)
+}
+
 func (g *gen) genStatement(typ types.Type, this, that string) error {
 	p := g.printer
+	if tp, ok := typ.(*types.TypeParam); ok {
+		if !canEqual(tp) {
+			return fmt.Errorf("unsupported type parameter %s, which is not comparable", tp)
+		}
+		p.P("return %s == %s", this, that)
+		return nil
+	}
 	switch ttyp := typ.Underlying().(type) {
 	case *types.Basic:
 		fieldStr, err := g.field(this, that, typ)
@@ -189,6 +304,10 @@ func (g *gen) genStatement(typ types.Type, this, that string) error {
 		reftyp := ttyp.Elem()
 		named, isNamed := reftyp.(*types.Named)
 		strct, isStruct := reftyp.Underlying().(*types.Struct)
+		if !isStruct && g.visited != nil {
+			g.genVisit(this, that)
+			return g.genStatement(reftyp, thisref, thatref)
+		}
 		if !isStruct {
 			p.P("if %s == nil && %s == nil {", this, that)
 			p.In()
@@ -207,8 +326,14 @@ func (g *gen) genStatement(typ types.Type, this, that string) error {
 		}
 		if isNamed {
 			external := g.TypesMap.IsExternal(named)
-			fields := derive.Fields(g.TypesMap, strct, external)
-			if len(fields.Fields) == 0 {
+			fields := derive.Fields(g.TypesMap, strct, external).For("equal")
+			if g.visited != nil {
+				g.genVisit(this, that)
+				if len(fields.Fields) == 0 {
+					p.P("return true")
+					return nil
+				}
+			} else if len(fields.Fields) == 0 {
 				p.P("return (%s == nil && %s == nil) || (%s != nil) && (%s != nil)", this, that, this, that)
 				return nil
 			}
@@ -216,9 +341,11 @@ func (g *gen) genStatement(typ types.Type, this, that string) error {
 				p.P(`thisv := `+g.reflectPkg()+`.Indirect(`+g.reflectPkg()+`.ValueOf(%s))`, this)
 				p.P(`thatv := `+g.reflectPkg()+`.Indirect(`+g.reflectPkg()+`.ValueOf(%s))`, that)
 			}
-			p.P("return (%s == nil && %s == nil) ||", this, that)
-			p.In()
-			p.P("%s != nil && %s != nil &&", this, that)
+			if g.visited == nil {
+				p.P("return (%s == nil && %s == nil) ||", this, that)
+				p.In()
+				p.P("%s != nil && %s != nil &&", this, that)
+			}
 			for i, field := range fields.Fields {
 				fieldType := field.Type
 				var thisField, thatField string
@@ -234,13 +361,20 @@ func (g *gen) genStatement(typ types.Type, this, that string) error {
 				if (i + 1) != len(fields.Fields) {
 					fieldStr += " &&"
 				}
+				if i == 0 && g.visited != nil {
+					p.P("return " + fieldStr)
+					p.In()
+					continue
+				}
 				if i == 0 {
 					p.In()
 				}
 				p.P(fieldStr)
 			}
 			p.Out()
-			p.Out()
+			if g.visited == nil {
+				p.Out()
+			}
 			return nil
 		}
 	case *types.Struct:
@@ -256,7 +390,11 @@ func (g *gen) genStatement(typ types.Type, this, that string) error {
 			p.P("return " + fieldStr)
 			return nil
 		}
-		fields := derive.Fields(g.TypesMap, ttyp, false)
+		fields := derive.Fields(g.TypesMap, ttyp, false).For("equal")
+		if len(fields.Fields) == 0 {
+			p.P("return true")
+			return nil
+		}
 		for i, field := range fields.Fields {
 			fieldType := field.Type
 			thisField, thatField := field.Name(this, nil), field.Name(that, nil)
@@ -276,6 +414,8 @@ func (g *gen) genStatement(typ types.Type, this, that string) error {
 		}
 		p.Out()
 		return nil
+	case *types.Interface:
+		return g.genInterface(ttyp, this, that)
 	case *types.Slice:
 		p.P("if %s == nil || %s == nil {", this, that)
 		p.In()
@@ -370,12 +510,68 @@ func wrap(value string) string {
 	return value
 }
 
+// supported returns whether the type can be compared with generated code or its Equal method.
+func (g *gen) supported(typ types.Type) bool {
+	return derive.Supported("equal", typ, func(t types.Type) bool {
+		named, ok := t.(*types.Named)
+		return canEqual(t) || (ok && g.equalMethodInputParam(named) != nil)
+	})
+}
+
+// genInterface type switches over the types in the package, which implement the interface and are supported,
+// and otherwise falls back to an Equal method, that accepts the dynamic type, or reflect.DeepEqual.
+func (g *gen) genInterface(typ *types.Interface, this, that string) error {
+	p := g.printer
+	p.P("if %s == nil || %s == nil {", this, that)
+	p.In()
+	p.P("return %s == nil && %s == nil", this, that)
+	p.Out()
+	p.P("}")
+	p.P("if %s.TypeOf(%s) != %s.TypeOf(%s) {", g.reflectPkg(), this, g.reflectPkg(), that)
+	p.In()
+	p.P("return false")
+	p.Out()
+	p.P("}")
+	if impls := derive.Implementations(g.TypesMap, typ, g.supported); len(impls) > 0 {
+		p.P("switch %s := %s.(type) {", this, this)
+		for _, impl := range impls {
+			p.P("case %s:", g.TypeString(impl))
+			p.In()
+			p.P("%s := %s.(%s)", that, that, g.TypeString(impl))
+			fieldStr, err := g.field(this, that, impl)
+			if err != nil {
+				return err
+			}
+			p.P("return %s", fieldStr)
+			p.Out()
+		}
+		p.P("}")
+	}
+	p.P("if eq := %s.ValueOf(%s).MethodByName(\"Equal\"); eq.IsValid() {", g.reflectPkg(), this)
+	p.In()
+	p.P("if t := eq.Type(); t.NumIn() == 1 && t.In(0) == %s.TypeOf(%s) && t.NumOut() == 1 && t.Out(0).Kind() == %s.Bool {", g.reflectPkg(), that, g.reflectPkg())
+	p.In()
+	p.P("return eq.Call([]%s.Value{%s.ValueOf(%s)})[0].Bool()", g.reflectPkg(), g.reflectPkg(), that)
+	p.Out()
+	p.P("}")
+	p.Out()
+	p.P("}")
+	p.P("return %s.DeepEqual(%s, %s)", g.reflectPkg(), this, that)
+	return nil
+}
+
 func canEqual(tt types.Type) bool {
+	if tp, ok := tt.(*types.TypeParam); ok {
+		return types.Comparable(tp)
+	}
 	t := tt.Underlying()
 	switch typ := t.(type) {
 	case *types.Basic:
 		return typ.Kind() != types.UntypedNil
 	case *types.Struct:
+		if derive.SkipsFields(typ, "equal") {
+			return false
+		}
 		for i := 0; i < typ.NumFields(); i++ {
 			f := typ.Field(i)
 			ft := f.Type()
@@ -390,7 +586,13 @@ func canEqual(tt types.Type) bool {
 	return false
 }
 
-func equalMethodInputParam(typ *types.Named) *types.Type {
+// equalMethodInputParam returns the type of the parameter of the Equal method of the type, if it has one.
+// When comparing graphs, the Equal methods of types in the package are not called,
+// since they do not pass along the visited pairs of pointers.
+func (g *gen) equalMethodInputParam(typ *types.Named) *types.Type {
+	if g.visited != nil && !g.TypesMap.IsExternal(typ) {
+		return nil
+	}
 	for i := 0; i < typ.NumMethods(); i++ {
 		meth := typ.Method(i)
 		if meth.Name() != "Equal" {
@@ -426,7 +628,7 @@ func (g *gen) field(thisField, thatField string, fieldType types.Type) (string,
 		return fmt.Sprintf("%s == %s", thisField, thatField), nil
 	}
 	if named, isNamed := fieldType.(*types.Named); isNamed {
-		inputType := equalMethodInputParam(named)
+		inputType := g.equalMethodInputParam(named)
 		if inputType != nil {
 			ityp := *inputType
 			if _, ok := ityp.(*types.Pointer); ok {
@@ -443,7 +645,7 @@ func (g *gen) field(thisField, thatField string, fieldType types.Type) (string,
 	case *types.Pointer:
 		ref := typ.Elem()
 		if named, ok := ref.(*types.Named); ok {
-			inputType := equalMethodInputParam(named)
+			inputType := g.equalMethodInputParam(named)
 			if inputType != nil {
 				ityp := *inputType
 				if _, ok := ityp.(*types.Pointer); ok {
@@ -454,23 +656,28 @@ func (g *gen) field(thisField, thatField string, fieldType types.Type) (string,
 					// fall through to deferencing of pointers
 				}
 			} else {
-				return fmt.Sprintf("%s(%s, %s)", g.GetFuncName(typ, typ), thisField, thatField), nil
+				return g.call(typ, thisField, thatField), nil
 			}
 		}
+		if g.visited != nil && !canEqual(ref) {
+			return g.call(typ, thisField, thatField), nil
+		}
 		eqStr, err := g.field("*("+thisField+")", "*("+thatField+")", ref)
 		if err != nil {
 			return "", err
 		}
 		return fmt.Sprintf("((%[1]s == nil && %[2]s == nil) || (%[1]s != nil && %[2]s != nil && %[3]s))", thisField, thatField, eqStr), nil
 	case *types.Array:
-		return fmt.Sprintf("%s(%s, %s)", g.GetFuncName(typ, typ), thisField, thatField), nil
+		return g.call(typ, thisField, thatField), nil
 	case *types.Slice:
 		if b, ok := typ.Elem().(*types.Basic); ok && b.Kind() == types.Byte {
 			return fmt.Sprintf("%s.Equal(%s, %s)", g.bytesPkg(), thisField, thatField), nil
 		}
-		return fmt.Sprintf("%s(%s, %s)", g.GetFuncName(typ, typ), thisField, thatField), nil
+		return g.call(typ, thisField, thatField), nil
 	case *types.Map:
-		return fmt.Sprintf("%s(%s, %s)", g.GetFuncName(typ, typ), thisField, thatField), nil
+		return g.call(typ, thisField, thatField), nil
+	case *types.Interface:
+		return g.call(fieldType, thisField, thatField), nil
 	case *types.Struct:
 		return g.field("&"+thisField, "&"+thatField, types.NewPointer(fieldType))
 	default: // *Chan, *Tuple, *Signature, *Interface, *types.Basic.Kind() == types.UntypedNil, *Struct
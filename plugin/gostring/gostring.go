@@ -50,6 +50,8 @@
 //	- slices
 //	- maps
 //	- pointers to these types
+//	- instantiated generic types
+//	- type parameters, in which case a generic function is generated
 //	- and many more
 // Unsupported types:
 //	- chan
@@ -73,8 +75,16 @@ import (
 
 // NewPlugin creates a new gostring plugin.
 // This function returns the plugin name, default prefix and a constructor for the gostring code generator.
+// It also derives functions for types that are annotated with the //goderive:gostring directive,
+// where the method option generates the GoString method.
 func NewPlugin() derive.Plugin {
-	return derive.NewPlugin("gostring", "deriveGoString", New)
+	return derive.NewPluginWithDirective("gostring", "deriveGoString", New, derive.Directive{
+		Args: func(recv types.Type) []types.Type {
+			return []types.Type{recv}
+		},
+		Method:   "GoString",
+		Template: "// %[2]s returns a string that reproduces this in valid go syntax.\nfunc (this %[1]s) %[2]s() string {\n\treturn %[3]s(this)\n}",
+	})
 }
 
 // New is a constructor for the gostring code generator.
@@ -124,7 +134,7 @@ func (g *gen) genFunc(typ types.Type) error {
 	gotypeStr := g.TypeString(typ)
 	p.P("")
 	p.P("// %s returns a recursive representation of this as a valid go string.", name)
-	p.P("func %s(this %s) string {", name, typeStr)
+	p.P("func %s%s(this %s) string {", name, g.TypeParams(typ), typeStr)
 	p.In()
 	p.P("buf := %s.NewBuffer(nil)", g.bytesPkg())
 	p.P("%s.Fprintf(buf, \"func() %s {\\n\")", g.fmtPkg(), gotypeStr)
@@ -158,6 +168,10 @@ func isBasicPointer(typ types.Type) bool {
 
 func (g *gen) genStatement(typ types.Type, this string) error {
 	p := g.printer
+	if derive.IsTypeParam(typ) {
+		p.P("%s.Fprintf(buf, \"return %s\\n\", %s)", g.fmtPkg(), "%#v", this)
+		return nil
+	}
 	switch ttyp := typ.Underlying().(type) {
 	case *types.Basic:
 		p.P("%s.Fprintf(buf, \"return %s\\n\", %s)", g.fmtPkg(), "%#v", this)
@@ -180,7 +194,7 @@ func (g *gen) genStatement(typ types.Type, this string) error {
 		} else {
 			gotypeStr := g.TypeString(reftyp)
 			external := isNamed && g.TypesMap.IsExternal(named)
-			fields := derive.Fields(g.TypesMap, strct, external)
+			fields := derive.Fields(g.TypesMap, strct, external).For("gostring")
 			if len(fields.Fields) == 0 {
 				g.W("return &%s{}", gotypeStr)
 			} else {
@@ -201,7 +215,7 @@ func (g *gen) genStatement(typ types.Type, this string) error {
 		p.P("}")
 		return nil
 	case *types.Struct:
-		fields := derive.Fields(g.TypesMap, ttyp, false)
+		fields := derive.Fields(g.TypesMap, ttyp, false).For("gostring")
 		gotypeStr := g.TypeString(typ)
 		g.W("%s := &%s{}", this, gotypeStr)
 		for _, field := range fields.Fields {
@@ -294,6 +308,10 @@ func (g *gen) genStatement(typ types.Type, this string) error {
 
 func (g *gen) genField(fieldType types.Type, this string) error {
 	p := g.printer
+	if derive.IsTypeParam(fieldType) {
+		p.P("%s.Fprintf(buf, \"%s = %s\\n\", %s)", g.fmtPkg(), this, "%#v", this)
+		return nil
+	}
 	switch typ := fieldType.Underlying().(type) {
 	case *types.Basic:
 		p.P("%s.Fprintf(buf, \"%s = %s\\n\", %s)", g.fmtPkg(), this, "%#v", this)
@@ -23,13 +23,36 @@
 //	- slices
 //	- maps
 //	- pointers to these types
+//	- instantiated generic types
+//	- interfaces, by type switching over the types in the package, which implement them
 //	- and many more
 // Unsupported types:
 //	- chan
-//	- interface
 //	- function
 //	- unnamed structs, which are not comparable with the == operator
 //
+// The algorithm of deriveHash is not documented and can change between versions of goderive.
+// The deriveHashSeed function returns a hash, which is the same across processes, architectures and versions of goderive,
+// so that it can be persisted, for example as a cache key.
+//   deriveHashSeed(seed uint64, T) uint64
+//
+// It is the FNV-1a 64 hash of an encoding of the object, where the state starts from the offset basis xor the seed.
+// The encoding is:
+//	- bool: one byte, 0 or 1
+//	- integers: eight bytes in little endian order, where signed integers are sign extended
+//	- floats: the IEEE 754 binary representation of the float64 value, as eight bytes in little endian order
+//	- complex numbers: the real part followed by the imaginary part
+//	- strings: the length followed by the bytes
+//	- pointers, slices, maps and interfaces: one byte 0, if nil, otherwise one byte 1 followed by the value
+//	- slices: the length followed by the elements
+//	- arrays: the elements
+//	- maps: the length followed by the keys and values, in the order of the sorted keys
+//	- structs: the fields in the order of declaration, except skipped fields
+//	- structs in other packages with private fields, like time.Time: the length followed by the bytes returned by MarshalBinary,
+//	  where an error from MarshalBinary panics and structs without a MarshalBinary method are not supported
+//	- interfaces: the name of the dynamic type followed by the value,
+//	  if it is of one of the types in the package, which implement the interface
+//
 // Example output can be found here:
 // https://github.com/awalterschulze/goderive/tree/master/example/plugin/hash
 //
@@ -46,8 +69,16 @@ import (
 
 // NewPlugin creates a new hash plugin.
 // This function returns the plugin name, default prefix and a constructor for the hash code generator.
+// It also derives functions for types that are annotated with the //goderive:hash directive,
+// where the method option generates the Hash method.
 func NewPlugin() derive.Plugin {
-	return derive.NewPlugin("hash", "deriveHash", New)
+	return derive.NewPluginWithDirective("hash", "deriveHash", New, derive.Directive{
+		Args: func(recv types.Type) []types.Type {
+			return []types.Type{recv}
+		},
+		Method:   "Hash",
+		Template: "// %[2]s returns the hash of this.\nfunc (this %[1]s) %[2]s() uint64 {\n\treturn %[3]s(this)\n}",
+	})
 }
 
 // New is a constructor for the hash code generator.
@@ -56,18 +87,20 @@ func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Depe
 	return &gen{
 		TypesMap: typesMap,
 		printer:  p,
-		mathPkg:  p.NewImport("math", "math"),
-		keys:     deps["keys"],
-		sort:     deps["sort"],
+		mathPkg:    p.NewImport("math", "math"),
+		reflectPkg: p.NewImport("reflect", "reflect"),
+		keys:       deps["keys"],
+		sort:       deps["sort"],
 	}
 }
 
 type gen struct {
 	derive.TypesMap
-	printer derive.Printer
-	mathPkg derive.Import
-	keys    derive.Dependency
-	sort    derive.Dependency
+	printer    derive.Printer
+	mathPkg    derive.Import
+	reflectPkg derive.Import
+	keys       derive.Dependency
+	sort       derive.Dependency
 }
 
 func (g *gen) Add(name string, typs []types.Type) (string, error) {
@@ -94,7 +127,7 @@ func (g *gen) genFunc(typs []types.Type) error {
 		if err != nil {
 			return err
 		}
-		p.P("func %s(object struct {", name)
+		p.P("func %s%s(object struct {", name, g.TypeParams(typs...))
 		p.In()
 		for _, fieldStr := range fieldStrs {
 			p.P(fieldStr)
@@ -102,11 +135,11 @@ func (g *gen) genFunc(typs []types.Type) error {
 		p.Out()
 		p.P("}) uint64 {")
 	} else {
-		p.P("func %s(object %s) uint64 {", name, typeStr)
+		p.P("func %s%s(object %s) uint64 {", name, g.TypeParams(typs...), typeStr)
 	}
 	p.In()
 	if err := g.genStatement("object", typs[0]); err != nil {
-		return nil
+		return err
 	}
 	p.Out()
 	p.P("}")
@@ -154,7 +187,7 @@ func (g *gen) genStatement(o string, typ types.Type) error {
 		p.P("}")
 		if isStruct && isNamed {
 			external := g.TypesMap.IsExternal(named)
-			fields := derive.Fields(g.TypesMap, strct, external)
+			fields := derive.Fields(g.TypesMap, strct, external).For("hash")
 			if len(fields.Fields) == 0 {
 				p.P("return 17")
 				return nil
@@ -191,7 +224,7 @@ func (g *gen) genStatement(o string, typ types.Type) error {
 			p.P("return " + fieldStr)
 			return nil
 		} else {
-			fields := derive.Fields(g.TypesMap, ttyp, false)
+			fields := derive.Fields(g.TypesMap, ttyp, false).For("hash")
 			if len(fields.Fields) == 0 {
 				p.P("return 17")
 				return nil
@@ -209,6 +242,8 @@ func (g *gen) genStatement(o string, typ types.Type) error {
 			p.P("return h")
 			return nil
 		}
+	case *types.Interface:
+		return g.genInterface(o, ttyp)
 	case *types.Slice:
 		p.P("if %s == nil {", o)
 		p.In()
@@ -269,6 +304,51 @@ func (g *gen) genStatement(o string, typ types.Type) error {
 	return fmt.Errorf("unsupported type: %#v", typ)
 }
 
+// supported returns whether the type can be hashed with generated code or its Hash method.
+func (g *gen) supported(typ types.Type) bool {
+	return derive.Supported("hash", typ, func(t types.Type) bool {
+		named, ok := t.(*types.Named)
+		return ok && hasHashMethod(named)
+	})
+}
+
+// genInterface type switches over the types in the package, which implement the interface and are supported,
+// and otherwise falls back to a Hash method or the hash of the name of the dynamic type,
+// which is consistent with equal, since equal values have the same dynamic type.
+func (g *gen) genInterface(o string, typ *types.Interface) error {
+	p := g.printer
+	p.P("if %s == nil {", o)
+	p.In()
+	p.P("return 0")
+	p.Out()
+	p.P("}")
+	if impls := derive.Implementations(g.TypesMap, typ, g.supported); len(impls) > 0 {
+		p.P("switch %s := %s.(type) {", o, o)
+		for _, impl := range impls {
+			p.P("case %s:", g.TypeString(impl))
+			p.In()
+			fieldStr, err := g.field(o, impl)
+			if err != nil {
+				return err
+			}
+			p.P("return %s", fieldStr)
+			p.Out()
+		}
+		p.P("}")
+	}
+	p.P("if h, ok := %s.(interface{ Hash() uint64 }); ok {", o)
+	p.In()
+	p.P("return h.Hash()")
+	p.Out()
+	p.P("}")
+	typeName, err := g.field(g.reflectPkg()+".TypeOf("+o+").String()", types.Typ[types.String])
+	if err != nil {
+		return err
+	}
+	p.P("return %s", typeName)
+	return nil
+}
+
 func wrap(value string) string {
 	if strings.HasPrefix(value, "*") || strings.HasPrefix(value, "&") {
 		return "(" + value + ")"
@@ -343,7 +423,7 @@ func (g *gen) field(fieldName string, fieldType types.Type) (string, error) {
 		return fmt.Sprintf("%s(%s)", g.GetFuncName(fieldType), fieldName), nil
 	case *types.Slice:
 		return fmt.Sprintf("%s(%s)", g.GetFuncName(fieldType), fieldName), nil
-	case *types.Map:
+	case *types.Map, *types.Interface:
 		return fmt.Sprintf("%s(%s)", g.GetFuncName(fieldType), fieldName), nil
 	case *types.Struct:
 		if named, isNamed := fieldType.(*types.Named); isNamed {
@@ -353,6 +433,6 @@ func (g *gen) field(fieldName string, fieldType types.Type) (string, error) {
 		}
 		return fmt.Sprintf("%s(%s)", g.GetFuncName(fieldType), fieldName), nil
 	}
-	// *Chan, *Tuple, *Signature, *Interface, *types.Basic.Kind() == types.UntypedNil, *Struct
+	// *Chan, *Tuple, *Signature, *types.Basic.Kind() == types.UntypedNil, *Struct
 	return "", fmt.Errorf("unsupported type %#v", fieldType)
 }
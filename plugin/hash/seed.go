@@ -0,0 +1,440 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package hash
+
+import (
+	"fmt"
+	"go/types"
+
+	"github.com/awalterschulze/goderive/derive"
+)
+
+const (
+	fnvOffset = "14695981039346656037"
+	fnvPrime  = "1099511628211"
+)
+
+// NewSeedPlugin creates a new hashseed plugin.
+// This function returns the plugin name, default prefix and a constructor for the hashseed code generator.
+func NewSeedPlugin() derive.Plugin {
+	return derive.NewPlugin("hashseed", "deriveHashSeed", NewSeed)
+}
+
+// NewSeed is a constructor for the hashseed code generator,
+// which generates FNV-1a 64 hashes of the encoding of the object, which is described in the package documentation.
+// This generator should be reconstructed for each package.
+func NewSeed(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
+	return &seedGen{
+		TypesMap:   typesMap,
+		printer:    p,
+		mathPkg:    p.NewImport("math", "math"),
+		reflectPkg: p.NewImport("reflect", "reflect"),
+		keys:       deps["keys"],
+		sort:       deps["sort"],
+		state:      types.Typ[types.Uint64],
+	}
+}
+
+type seedGen struct {
+	derive.TypesMap
+	printer    derive.Printer
+	mathPkg    derive.Import
+	reflectPkg derive.Import
+	keys       derive.Dependency
+	sort       derive.Dependency
+	// state is the type of the FNV-1a state, which is passed to and returned from the functions that write an object.
+	state types.Type
+}
+
+func (g *seedGen) Add(name string, typs []types.Type) (string, error) {
+	if len(typs) != 2 {
+		return "", fmt.Errorf("%s does not have two arguments", name)
+	}
+	if seed, ok := typs[0].(*types.Basic); !ok || (seed.Kind() != types.Uint64 && seed.Kind() != types.UntypedInt) {
+		return "", fmt.Errorf("%s does not have a uint64 seed as its first argument, but %s", name, g.TypeString(typs[0]))
+	}
+	// Untyped constants are hashed as their default type, which is the type of the parameter.
+	typ := types.Default(typs[1])
+	if err := g.check(typ, nil); err != nil {
+		return "", fmt.Errorf("%s cannot hash %s: %v", name, g.TypeString(typ), err)
+	}
+	return g.SetFuncName(name, typ)
+}
+
+// check returns an error for types, which do not have a stable encoding.
+func (g *seedGen) check(typ types.Type, seen map[types.Type]bool) error {
+	if seen == nil {
+		seen = make(map[types.Type]bool)
+	}
+	if seen[typ] {
+		return nil
+	}
+	seen[typ] = true
+	switch ttyp := typ.Underlying().(type) {
+	case *types.Basic:
+		if ttyp.Kind() == types.UnsafePointer {
+			return fmt.Errorf("unsafe.Pointer is an address, which is not the same across processes")
+		}
+		return nil
+	case *types.Pointer:
+		return g.check(ttyp.Elem(), seen)
+	case *types.Slice:
+		return g.check(ttyp.Elem(), seen)
+	case *types.Array:
+		return g.check(ttyp.Elem(), seen)
+	case *types.Map:
+		if err := g.check(ttyp.Key(), seen); err != nil {
+			return err
+		}
+		return g.check(ttyp.Elem(), seen)
+	case *types.Struct:
+		if g.hidesFields(typ) {
+			if hasMarshalBinary(typ) {
+				return nil
+			}
+			return fmt.Errorf("%s has private fields, which cannot be accessed from this package, and no MarshalBinary method", g.TypeString(typ))
+		}
+		// The same fields are checked, which are written by genFields.
+		for _, field := range derive.Fields(g.TypesMap, ttyp, false).For("hash").Fields {
+			if err := g.check(field.Type, seen); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *types.Interface:
+		return nil
+	}
+	return fmt.Errorf("unsupported type %s", g.TypeString(typ))
+}
+
+// hidesFields returns whether the type is a struct in another package, with private fields, which are not skipped.
+func (g *seedGen) hidesFields(typ types.Type) bool {
+	named, ok := typ.(*types.Named)
+	if !ok || !g.TypesMap.IsExternal(named) {
+		return false
+	}
+	strct, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return false
+	}
+	for _, field := range derive.Fields(g.TypesMap, strct, true).For("hash").Fields {
+		if field.Private() {
+			return true
+		}
+	}
+	return false
+}
+
+// hasMarshalBinary returns whether values of the type have a MarshalBinary() ([]byte, error) method.
+func hasMarshalBinary(typ types.Type) bool {
+	sel := types.NewMethodSet(typ).Lookup(nil, "MarshalBinary")
+	if sel == nil {
+		return false
+	}
+	sig := sel.Type().(*types.Signature)
+	if sig.Params().Len() != 0 || sig.Results().Len() != 2 || !derive.IsError(sig.Results().At(1).Type()) {
+		return false
+	}
+	bs, ok := sig.Results().At(0).Type().(*types.Slice)
+	if !ok {
+		return false
+	}
+	b, ok := bs.Elem().(*types.Basic)
+	return ok && b.Kind() == types.Byte
+}
+
+func (g *seedGen) Generate(typs []types.Type) error {
+	if len(typs) == 1 {
+		return g.genSeedFunc(typs[0])
+	}
+	return g.genFunc(typs[0])
+}
+
+// genSeedFunc generates the function, which is called with the seed,
+// and which starts the FNV-1a state from the offset basis xor the seed.
+func (g *seedGen) genSeedFunc(typ types.Type) error {
+	p := g.printer
+	g.Generating(typ)
+	name := g.GetFuncName(typ)
+	p.P("")
+	p.P("// %s returns the FNV-1a 64 hash of the object, which starts from the offset basis xor the seed.", name)
+	p.P("// The hash is the same across processes, architectures and versions of goderive.")
+	p.P("func %s%s(seed uint64, object %s) uint64 {", name, g.TypeParams(typ), g.TypeString(typ))
+	p.In()
+	p.P("return %s(object, seed^%s)", g.GetFuncName(typ, g.state), fnvOffset)
+	p.Out()
+	p.P("}")
+	return nil
+}
+
+func (g *seedGen) genFunc(typ types.Type) error {
+	p := g.printer
+	g.Generating(typ, g.state)
+	name := g.GetFuncName(typ, g.state)
+	p.P("")
+	p.P("// %s writes the object to the FNV-1a 64 state h and returns the new state.", name)
+	if strct, ok := typ.(*types.Struct); ok {
+		fieldStrs, err := g.FieldStrings(derive.GetStructFields(strct))
+		if err != nil {
+			return err
+		}
+		p.P("func %s%s(object struct {", name, g.TypeParams(typ))
+		p.In()
+		for _, fieldStr := range fieldStrs {
+			p.P(fieldStr)
+		}
+		p.Out()
+		p.P("}, h uint64) uint64 {")
+	} else {
+		p.P("func %s%s(object %s, h uint64) uint64 {", name, g.TypeParams(typ), g.TypeString(typ))
+	}
+	p.In()
+	if err := g.genStatement("object", typ); err != nil {
+		return err
+	}
+	p.Out()
+	p.P("}")
+	return nil
+}
+
+// writeByte returns the state after writing a byte to the state h.
+func writeByte(h string, b string) string {
+	return fmt.Sprintf("(%s ^ %s) * %s", h, b, fnvPrime)
+}
+
+func (g *seedGen) genStatement(o string, typ types.Type) error {
+	p := g.printer
+	if g.hidesFields(typ) {
+		// The check guarantees that the struct has a MarshalBinary method, since its private fields cannot be written.
+		p.P("data, err := %s.MarshalBinary()", o)
+		p.P("if err != nil {")
+		p.In()
+		p.P("panic(err)")
+		p.Out()
+		p.P("}")
+		p.P("h = %s", g.write("h", "uint64(len(data))"))
+		p.P("for i := 0; i < len(data); i++ {")
+		p.In()
+		p.P("h = %s", writeByte("h", "uint64(data[i])"))
+		p.Out()
+		p.P("}")
+		p.P("return h")
+		return nil
+	}
+	switch ttyp := typ.Underlying().(type) {
+	case *types.Basic:
+		switch ttyp.Kind() {
+		case types.Uint64:
+			if types.Identical(typ, g.state) {
+				p.P("for i := 0; i < 8; i++ {")
+				p.In()
+				p.P("h = %s", writeByte("h", "("+o+" & 0xff)"))
+				p.P("%s >>= 8", o)
+				p.Out()
+				p.P("}")
+				p.P("return h")
+				return nil
+			}
+		case types.Bool:
+			p.P("if %s {", o)
+			p.In()
+			p.P("return %s", writeByte("h", "1"))
+			p.Out()
+			p.P("}")
+			p.P("return %s", writeByte("h", "0"))
+			return nil
+		case types.String:
+			p.P("h = %s", g.write("h", "uint64(len("+o+"))"))
+			p.P("for i := 0; i < len(%s); i++ {", o)
+			p.In()
+			p.P("h = %s", writeByte("h", "uint64("+o+"[i])"))
+			p.Out()
+			p.P("}")
+			p.P("return h")
+			return nil
+		}
+		fieldStr, err := g.field("h", o, typ)
+		if err != nil {
+			return err
+		}
+		p.P("return %s", fieldStr)
+		return nil
+	case *types.Pointer:
+		p.P("if %s == nil {", o)
+		p.In()
+		p.P("return %s", writeByte("h", "0"))
+		p.Out()
+		p.P("}")
+		p.P("h = %s", writeByte("h", "1"))
+		if strct, ok := ttyp.Elem().Underlying().(*types.Struct); ok && !g.hidesFields(ttyp.Elem()) {
+			return g.genFields(o, ttyp.Elem(), strct)
+		}
+		fieldStr, err := g.field("h", "*"+o, ttyp.Elem())
+		if err != nil {
+			return err
+		}
+		p.P("return %s", fieldStr)
+		return nil
+	case *types.Struct:
+		return g.genFields(o, typ, ttyp)
+	case *types.Interface:
+		return g.genInterface(o, ttyp)
+	case *types.Slice:
+		p.P("if %s == nil {", o)
+		p.In()
+		p.P("return %s", writeByte("h", "0"))
+		p.Out()
+		p.P("}")
+		p.P("h = %s", writeByte("h", "1"))
+		p.P("h = %s", g.write("h", "uint64(len("+o+"))"))
+		if b, ok := ttyp.Elem().Underlying().(*types.Basic); ok && b.Kind() == types.Uint8 {
+			p.P("for i := 0; i < len(%s); i++ {", o)
+			p.In()
+			p.P("h = %s", writeByte("h", "uint64("+wrap(o)+"[i])"))
+			p.Out()
+			p.P("}")
+			p.P("return h")
+			return nil
+		}
+		return g.genElems(o, ttyp.Elem())
+	case *types.Array:
+		return g.genElems(o, ttyp.Elem())
+	case *types.Map:
+		p.P("if %s == nil {", o)
+		p.In()
+		p.P("return %s", writeByte("h", "0"))
+		p.Out()
+		p.P("}")
+		p.P("h = %s", writeByte("h", "1"))
+		p.P("h = %s", g.write("h", "uint64(len("+o+"))"))
+		p.P("for _, k := range %s(%s(%s)) {", g.sort.GetFuncName(types.NewSlice(ttyp.Key())), g.keys.GetFuncName(typ), o)
+		p.In()
+		keyStr, err := g.field("h", "k", ttyp.Key())
+		if err != nil {
+			return err
+		}
+		p.P("h = %s", keyStr)
+		valStr, err := g.field("h", wrap(o)+"[k]", ttyp.Elem())
+		if err != nil {
+			return err
+		}
+		p.P("h = %s", valStr)
+		p.Out()
+		p.P("}")
+		p.P("return h")
+		return nil
+	}
+	return fmt.Errorf("unsupported type: %s", g.TypeString(typ))
+}
+
+// genFields writes the fields of the struct in the order in which they are declared.
+// Structs in other packages, with private fields, are written by genStatement instead.
+func (g *seedGen) genFields(o string, typ types.Type, strct *types.Struct) error {
+	p := g.printer
+	fields := derive.Fields(g.TypesMap, strct, false).For("hash")
+	for _, field := range fields.Fields {
+		fieldStr, err := g.field("h", field.Name(o, nil), field.Type)
+		if err != nil {
+			return err
+		}
+		p.P("h = %s", fieldStr)
+	}
+	p.P("return h")
+	return nil
+}
+
+// genElems writes the elements of a slice or an array.
+func (g *seedGen) genElems(o string, elem types.Type) error {
+	p := g.printer
+	p.P("for i := 0; i < len(%s); i++ {", o)
+	p.In()
+	fieldStr, err := g.field("h", wrap(o)+"[i]", elem)
+	if err != nil {
+		return err
+	}
+	p.P("h = %s", fieldStr)
+	p.Out()
+	p.P("}")
+	p.P("return h")
+	return nil
+}
+
+// genInterface writes the name of the dynamic type, which includes the package name, but not the package path,
+// followed by the value, if its type is one of the types in the package, which implement the interface and can be hashed.
+func (g *seedGen) genInterface(o string, typ *types.Interface) error {
+	p := g.printer
+	p.P("if %s == nil {", o)
+	p.In()
+	p.P("return %s", writeByte("h", "0"))
+	p.Out()
+	p.P("}")
+	p.P("h = %s", writeByte("h", "1"))
+	typeName, err := g.field("h", g.reflectPkg()+".TypeOf("+o+").String()", types.Typ[types.String])
+	if err != nil {
+		return err
+	}
+	p.P("h = %s", typeName)
+	if impls := derive.Implementations(g.TypesMap, typ, func(impl types.Type) bool {
+		return g.check(impl, nil) == nil
+	}); len(impls) > 0 {
+		p.P("switch %s := %s.(type) {", o, o)
+		for _, impl := range impls {
+			p.P("case %s:", g.TypeString(impl))
+			p.In()
+			fieldStr, err := g.field("h", o, impl)
+			if err != nil {
+				return err
+			}
+			p.P("return %s", fieldStr)
+			p.Out()
+		}
+		p.P("}")
+	}
+	p.P("return h")
+	return nil
+}
+
+// write returns the state after writing the uint64 value in little endian order to the state h.
+func (g *seedGen) write(h, value string) string {
+	return fmt.Sprintf("%s(%s, %s)", g.GetFuncName(g.state, g.state), value, h)
+}
+
+// field returns the state after writing the field to the state h.
+func (g *seedGen) field(h, fieldName string, fieldType types.Type) (string, error) {
+	if typ, ok := fieldType.Underlying().(*types.Basic); ok {
+		switch typ.Kind() {
+		case types.Bool, types.UntypedBool:
+			return fmt.Sprintf("%s(bool(%s), %s)", g.GetFuncName(types.Typ[types.Bool], g.state), fieldName, h), nil
+		case types.String, types.UntypedString:
+			return fmt.Sprintf("%s(string(%s), %s)", g.GetFuncName(types.Typ[types.String], g.state), fieldName, h), nil
+		case types.Int, types.Int8, types.Int16, types.Int32, types.Int64,
+			types.Uint, types.Uint8, types.Uint16, types.Uint32, types.Uint64,
+			types.Uintptr, types.UntypedInt, types.UntypedRune:
+			return g.write(h, "uint64("+fieldName+")"), nil
+		case types.Float32, types.Float64, types.UntypedFloat:
+			return g.write(h, fmt.Sprintf("%s.Float64bits(float64(%s))", g.mathPkg(), fieldName)), nil
+		case types.Complex64, types.Complex128:
+			re := g.write(h, fmt.Sprintf("%s.Float64bits(real(complex128(%s)))", g.mathPkg(), fieldName))
+			return g.write(re, fmt.Sprintf("%s.Float64bits(imag(complex128(%s)))", g.mathPkg(), fieldName)), nil
+		}
+		return "", fmt.Errorf("unsupported type %s", g.TypeString(fieldType))
+	}
+	switch fieldType.Underlying().(type) {
+	case *types.Pointer, *types.Slice, *types.Array, *types.Map, *types.Struct, *types.Interface:
+		return fmt.Sprintf("%s(%s, %s)", g.GetFuncName(fieldType, g.state), fieldName, h), nil
+	}
+	// *Chan, *Tuple, *Signature
+	return "", fmt.Errorf("unsupported type %s", g.TypeString(fieldType))
+}
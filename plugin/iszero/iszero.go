@@ -0,0 +1,209 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package iszero contains the implementation of the iszero plugin, which generates the deriveIsZero function.
+//
+// The deriveIsZero function returns whether a value is the zero value of its type.
+//   deriveIsZero(T) bool
+//
+// Structs and arrays are zero, when all their fields or elements are zero,
+// while pointers, slices, maps, interfaces, channels and functions are zero, when they are nil.
+// Fields with the tag derive:"-" or derive:"iszero=-" are ignored.
+//
+// The deriveIsEmpty function is like deriveIsZero, except that empty slices and maps, which are not nil, are also zero.
+//   deriveIsEmpty(T) bool
+//
+// Supported types:
+//	- basic types
+//	- named structs
+//	- arrays
+//	- slices
+//	- maps
+//	- pointers
+//	- interfaces
+//	- chan
+//	- function
+//	- private fields of structs in external packages (using reflect and unsafe)
+//	- instantiated generic types
+// Unsupported types:
+//	- type parameters
+package iszero
+
+import (
+	"fmt"
+	"go/types"
+
+	"github.com/awalterschulze/goderive/derive"
+)
+
+// NewPlugin creates a new iszero plugin.
+// This function returns the plugin name, default prefix and a constructor for the iszero code generator.
+func NewPlugin() derive.Plugin {
+	return derive.NewPlugin("iszero", "deriveIsZero", New)
+}
+
+// NewEmptyPlugin creates a new isempty plugin.
+// This function returns the plugin name, default prefix and a constructor for the isempty code generator.
+func NewEmptyPlugin() derive.Plugin {
+	return derive.NewPlugin("isempty", "deriveIsEmpty", NewEmpty)
+}
+
+// NewEmpty is a constructor for the isempty code generator,
+// which also considers empty slices and maps to be zero.
+// This generator should be reconstructed for each package.
+func NewEmpty(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
+	g := New(typesMap, p, deps).(*gen)
+	g.empty = true
+	return g
+}
+
+// New is a constructor for the iszero code generator.
+// This generator should be reconstructed for each package.
+func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
+	return &gen{
+		TypesMap:   typesMap,
+		printer:    p,
+		reflectPkg: p.NewImport("reflect", "reflect"),
+		unsafePkg:  p.NewImport("unsafe", "unsafe"),
+	}
+}
+
+type gen struct {
+	derive.TypesMap
+	printer    derive.Printer
+	reflectPkg derive.Import
+	unsafePkg  derive.Import
+	// empty is whether empty slices and maps are also zero.
+	empty bool
+}
+
+func (g *gen) Add(name string, typs []types.Type) (string, error) {
+	if len(typs) != 1 {
+		return "", fmt.Errorf("%s does not have one argument", name)
+	}
+	return g.SetFuncName(name, typs[0])
+}
+
+func (g *gen) Generate(typs []types.Type) error {
+	return g.genFunc(typs[0])
+}
+
+func (g *gen) genFunc(typ types.Type) error {
+	p := g.printer
+	g.Generating(typ)
+	name := g.GetFuncName(typ)
+	p.P("")
+	if g.empty {
+		p.P("// %s returns whether this is the zero value of its type, where empty slices and maps are also zero.", name)
+	} else {
+		p.P("// %s returns whether this is the zero value of its type.", name)
+	}
+	p.P("func %s%s(this %s) bool {", name, g.TypeParams(typ), g.TypeString(typ))
+	p.In()
+	if err := g.genStatement(typ, "this"); err != nil {
+		return err
+	}
+	p.Out()
+	p.P("}")
+	return nil
+}
+
+func (g *gen) genStatement(typ types.Type, this string) error {
+	p := g.printer
+	switch ttyp := typ.Underlying().(type) {
+	case *types.Struct:
+		named, isNamed := typ.(*types.Named)
+		external := isNamed && g.TypesMap.IsExternal(named)
+		fields := derive.Fields(g.TypesMap, ttyp, external).For("iszero")
+		if len(fields.Fields) == 0 {
+			p.P("return true")
+			return nil
+		}
+		if fields.Reflect {
+			p.P(`thisv := `+g.reflectPkg()+`.Indirect(`+g.reflectPkg()+`.ValueOf(&%s))`, this)
+		}
+		for i, field := range fields.Fields {
+			var thisField string
+			if field.Private() && external {
+				thisField = field.Name("thisv", g.unsafePkg)
+			} else {
+				thisField = field.Name(this, nil)
+			}
+			fieldStr, err := g.field(field.Type, thisField)
+			if err != nil {
+				return err
+			}
+			if (i + 1) != len(fields.Fields) {
+				fieldStr += " &&"
+			}
+			if i == 0 {
+				p.P("return %s", fieldStr)
+				p.In()
+			} else {
+				p.P(fieldStr)
+			}
+		}
+		p.Out()
+		return nil
+	case *types.Array:
+		p.P("for _, v := range %s {", this)
+		p.In()
+		fieldStr, err := g.field(ttyp.Elem(), "v")
+		if err != nil {
+			return err
+		}
+		p.P("if !(%s) {", fieldStr)
+		p.In()
+		p.P("return false")
+		p.Out()
+		p.P("}")
+		p.Out()
+		p.P("}")
+		p.P("return true")
+		return nil
+	}
+	fieldStr, err := g.field(typ, this)
+	if err != nil {
+		return err
+	}
+	p.P("return %s", fieldStr)
+	return nil
+}
+
+// field returns the expression, which returns whether the field is zero.
+func (g *gen) field(fieldType types.Type, this string) (string, error) {
+	switch typ := fieldType.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case typ.Info()&types.IsBoolean != 0:
+			return "!" + this, nil
+		case typ.Info()&types.IsString != 0:
+			return this + ` == ""`, nil
+		case typ.Kind() == types.UntypedNil:
+			return "", fmt.Errorf("unsupported type %s", g.TypeString(fieldType))
+		}
+		return this + " == 0", nil
+	case *types.Struct, *types.Array:
+		return fmt.Sprintf("%s(%s)", g.GetFuncName(fieldType), this), nil
+	case *types.Slice, *types.Map:
+		if g.empty {
+			return fmt.Sprintf("len(%s) == 0", this), nil
+		}
+		return this + " == nil", nil
+	case *types.Pointer, *types.Interface, *types.Chan, *types.Signature:
+		return this + " == nil", nil
+	}
+	// *Tuple, *TypeParam
+	return "", fmt.Errorf("unsupported type %s", g.TypeString(fieldType))
+}
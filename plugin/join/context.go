@@ -0,0 +1,164 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package join
+
+import (
+	"fmt"
+	"go/types"
+	"strconv"
+	"strings"
+)
+
+// contextType returns the element type of the channels, which are joined after the context.
+func (g *gen) contextType(name string, typs []types.Type) (types.Type, error) {
+	args := typs[1:]
+	if len(args) == 0 {
+		return nil, fmt.Errorf("%s does not have any channels after the context", name)
+	}
+	if len(args) > 1 {
+		elemTyps, _, err := g.chanVariantTypes(name, args)
+		if err != nil {
+			return nil, err
+		}
+		return elemTyps[0], nil
+	}
+	switch t := args[0].(type) {
+	case *types.Chan:
+		elemTyp, _, err := g.chanType(name, args)
+		return elemTyp, err
+	case *types.Slice:
+		if _, ok := t.Elem().(*types.Chan); ok {
+			elemTyp, _, err := g.sliceOfChanType(name, args)
+			return elemTyp, err
+		}
+	}
+	return nil, fmt.Errorf("%s, the argument, %s, is not of type chan of chan or slice of chan", name, g.TypeString(args[0]))
+}
+
+func (g *gen) genContext(typs []types.Type) error {
+	p := g.printer
+	g.Generating(typs...)
+	name := g.GetFuncName(typs...)
+	elemTyp, err := g.contextType(name, typs)
+	if err != nil {
+		return err
+	}
+	typStr := g.TypeString(elemTyp)
+	args := typs[1:]
+	params := make([]string, len(args))
+	cs := make([]string, len(args))
+	for i := range args {
+		cs[i] = "c" + strconv.Itoa(i)
+		params[i] = cs[i] + " " + g.TypeString(args[i])
+	}
+	_, chanOfChan := args[0].(*types.Chan)
+	chanOfChan = chanOfChan && len(args) == 1
+	if len(args) == 1 {
+		cs[0] = "in"
+		params[0] = "in " + g.TypeString(args[0])
+	}
+	p.P("")
+	switch {
+	case chanOfChan:
+		p.P("// %s listens on all channels resulting from the input channel and sends all their results on the output channel,", name)
+	case len(args) == 1:
+		p.P("// %s listens on all channels in the input slice and sends all their results on the output channel,", name)
+	default:
+		p.P("// %s listens on all input channels %s and %s, and sends all their results onto the single output channel,", name, strings.Join(cs[:len(cs)-1], ", "), cs[len(cs)-1])
+	}
+	p.P("// until all channels are closed or the context is done.")
+	p.P("func %s(ctx %s.Context, %s) <-chan %s {", name, g.contextPkg(), strings.Join(params, ", "), typStr)
+	p.In()
+	p.P("out := make(chan %s)", typStr)
+	p.P("go func() {")
+	p.In()
+	p.P("wait := %s.WaitGroup{}", g.syncPkg())
+	p.P("forward := func(c <-chan %s) {", typStr)
+	p.In()
+	p.P("defer wait.Done()")
+	p.P("for {")
+	p.In()
+	p.P("select {")
+	p.P("case <-ctx.Done():")
+	p.In()
+	p.P("return")
+	p.Out()
+	p.P("case v, ok := <-c:")
+	p.In()
+	p.P("if !ok {")
+	p.In()
+	p.P("return")
+	p.Out()
+	p.P("}")
+	p.P("select {")
+	p.P("case out <- v:")
+	p.P("case <-ctx.Done():")
+	p.In()
+	p.P("return")
+	p.Out()
+	p.P("}")
+	p.Out()
+	p.P("}")
+	p.Out()
+	p.P("}")
+	p.Out()
+	p.P("}")
+	switch {
+	case chanOfChan:
+		p.Out()
+		p.P("loop:")
+		p.In()
+		p.P("for {")
+		p.In()
+		p.P("select {")
+		p.P("case <-ctx.Done():")
+		p.In()
+		p.P("break loop")
+		p.Out()
+		p.P("case c, ok := <-in:")
+		p.In()
+		p.P("if !ok {")
+		p.In()
+		p.P("break loop")
+		p.Out()
+		p.P("}")
+		p.P("wait.Add(1)")
+		p.P("go forward(c)")
+		p.Out()
+		p.P("}")
+		p.Out()
+		p.P("}")
+	case len(args) == 1:
+		p.P("for _, c := range in {")
+		p.In()
+		p.P("wait.Add(1)")
+		p.P("go forward(c)")
+		p.Out()
+		p.P("}")
+	default:
+		p.P("wait.Add(%d)", len(cs))
+		for _, c := range cs {
+			p.P("go forward(%s)", c)
+		}
+	}
+	p.P("wait.Wait()")
+	p.P("close(out)")
+	p.Out()
+	p.P("}()")
+	p.P("return out")
+	p.Out()
+	p.P("}")
+	return nil
+}
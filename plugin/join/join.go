@@ -32,6 +32,13 @@
 // It will then start up a go routine to listen on every new incoming channel and send those events to the outgoing channel.
 //    deriveJoin(chan T, chan T, ...) <-chan T
 // deriveJoin with a variable number of channels as parameter will do a select over those channels, until all are closed.
+//
+// The deriveJoin function also has context-cancellable forms for channels.
+//    deriveJoin(context.Context, <-chan <-chan T) <-chan T
+//    deriveJoin(context.Context, []<-chan T) <-chan T
+//    deriveJoin(context.Context, chan T, chan T, ...) <-chan T
+// These stop forwarding and close the output channel, when the context is done, even if the input channels are not closed,
+// so that no go routines are left behind.
 package join
 
 import (
@@ -57,6 +64,7 @@ func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Depe
 		printer:    p,
 		stringsPkg: p.NewImport("strings", "strings"),
 		syncPkg:    p.NewImport("sync", "sync"),
+		contextPkg: p.NewImport("context", "context"),
 	}
 }
 
@@ -65,12 +73,19 @@ type gen struct {
 	printer    derive.Printer
 	stringsPkg derive.Import
 	syncPkg    derive.Import
+	contextPkg derive.Import
 }
 
 func (g *gen) Add(name string, typs []types.Type) (string, error) {
 	if len(typs) == 0 {
 		return "", fmt.Errorf("%s does not have at least one argument", name)
 	}
+	if derive.IsContext(typs[0]) {
+		if _, err := g.contextType(name, typs); err != nil {
+			return "", err
+		}
+		return g.SetFuncName(name, typs...)
+	}
 	switch t := typs[0].(type) {
 	case *types.Slice:
 		switch t.Elem().(type) {
@@ -247,6 +262,9 @@ func (g *gen) stringType(name string, typs []types.Type) error {
 }
 
 func (g *gen) Generate(typs []types.Type) error {
+	if derive.IsContext(typs[0]) {
+		return g.genContext(typs)
+	}
 	switch t := typs[0].(type) {
 	case *types.Slice:
 		switch t.Elem().(type) {
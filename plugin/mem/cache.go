@@ -0,0 +1,427 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package mem
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/awalterschulze/goderive/derive"
+)
+
+// NewSyncPlugin creates a new memsync plugin.
+// This function returns the plugin name, default prefix and a constructor for the memsync code generator.
+func NewSyncPlugin() derive.Plugin {
+	return derive.NewPlugin("memsync", "deriveMemSync", NewSync)
+}
+
+// NewLRUPlugin creates a new memlru plugin.
+// This function returns the plugin name, default prefix and a constructor for the memlru code generator.
+func NewLRUPlugin() derive.Plugin {
+	return derive.NewPlugin("memlru", "deriveMemLRU", NewLRU)
+}
+
+// NewTTLPlugin creates a new memttl plugin.
+// This function returns the plugin name, default prefix and a constructor for the memttl code generator.
+func NewTTLPlugin() derive.Plugin {
+	return derive.NewPlugin("memttl", "deriveMemTTL", NewTTL)
+}
+
+// NewSingleflightPlugin creates a new singleflight plugin.
+// This function returns the plugin name, default prefix and a constructor for the singleflight code generator.
+func NewSingleflightPlugin() derive.Plugin {
+	return derive.NewPlugin("singleflight", "deriveSingleflight", NewSingleflight)
+}
+
+// NewSync is a constructor for the memsync code generator, which remembers all results.
+// This generator should be reconstructed for each package.
+func NewSync(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
+	return newCache(typesMap, p, deps, cacheSync)
+}
+
+// NewLRU is a constructor for the memlru code generator, which remembers the most recently used results.
+// This generator should be reconstructed for each package.
+func NewLRU(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
+	return newCache(typesMap, p, deps, cacheLRU)
+}
+
+// NewTTL is a constructor for the memttl code generator, which remembers results for a fixed duration.
+// This generator should be reconstructed for each package.
+func NewTTL(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
+	return newCache(typesMap, p, deps, cacheTTL)
+}
+
+// NewSingleflight is a constructor for the singleflight code generator, which only shares results between concurrent calls.
+// This generator should be reconstructed for each package.
+func NewSingleflight(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
+	return newCache(typesMap, p, deps, cacheSingleflight)
+}
+
+// cacheKind is the kind of cache, which is generated.
+type cacheKind int
+
+const (
+	// cacheSync remembers all results.
+	cacheSync cacheKind = iota
+	// cacheLRU remembers a fixed number of the most recently used results.
+	cacheLRU
+	// cacheTTL remembers results for a fixed duration.
+	cacheTTL
+	// cacheSingleflight only shares a result with the calls that arrive, while it is being computed.
+	cacheSingleflight
+)
+
+func newCache(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency, kind cacheKind) derive.Generator {
+	return &cacheGen{
+		TypesMap: typesMap,
+		printer:  p,
+		equal:    deps["equal"],
+		hash:     deps["hash"],
+		syncPkg:  p.NewImport("sync", "sync"),
+		listPkg:  p.NewImport("list", "container/list"),
+		timePkg:  p.NewImport("time", "time"),
+		kind:     kind,
+	}
+}
+
+type cacheGen struct {
+	derive.TypesMap
+	printer derive.Printer
+	equal   derive.Dependency
+	hash    derive.Dependency
+	syncPkg derive.Import
+	listPkg derive.Import
+	timePkg derive.Import
+	kind    cacheKind
+}
+
+func (g *cacheGen) Add(name string, typs []types.Type) (string, error) {
+	switch g.kind {
+	case cacheLRU:
+		if len(typs) != 2 {
+			return "", fmt.Errorf("%s does not have two arguments", name)
+		}
+		if capacity, ok := typs[0].(*types.Basic); !ok || (capacity.Kind() != types.Int && capacity.Kind() != types.UntypedInt) {
+			return "", fmt.Errorf("%s does not have an int capacity as its first argument, but %s", name, g.TypeString(typs[0]))
+		}
+		typs = typs[1:]
+	case cacheTTL:
+		if len(typs) != 2 {
+			return "", fmt.Errorf("%s does not have two arguments", name)
+		}
+		if !derive.IsDuration(typs[0]) {
+			return "", fmt.Errorf("%s does not have a time.Duration as its first argument, but %s", name, g.TypeString(typs[0]))
+		}
+		typs = typs[1:]
+	default:
+		if len(typs) != 1 {
+			return "", fmt.Errorf("%s does not have one argument", name)
+		}
+	}
+	if _, ok := typs[0].(*types.Signature); !ok {
+		return "", fmt.Errorf("%s, the argument, %s, is not of type func", name, g.TypeString(typs[0]))
+	}
+	return g.SetFuncName(name, typs[0])
+}
+
+func (g *cacheGen) Generate(typs []types.Type) error {
+	return g.genFunc(typs[0].(*types.Signature))
+}
+
+func (g *cacheGen) typeStrings(typs []types.Type) []string {
+	ss := make([]string, len(typs))
+	for i := range typs {
+		ss[i] = g.TypeString(typs[i])
+	}
+	return ss
+}
+
+// fields returns the fields of the struct, which holds the values of the tuple.
+func fields(tuple *types.Tuple, prefix string) []*types.Var {
+	fs := make([]*types.Var, tuple.Len())
+	for i := range fs {
+		fs[i] = types.NewField(token.NoPos, nil, prefix+strconv.Itoa(i), tuple.At(i).Type(), false)
+	}
+	return fs
+}
+
+func (g *cacheGen) genStruct(name string, fs []*types.Var) error {
+	p := g.printer
+	if len(fs) == 0 {
+		p.P("type %s struct{}", name)
+		return nil
+	}
+	p.P("type %s struct {", name)
+	p.In()
+	strs, err := g.FieldStrings(fs)
+	if err != nil {
+		return err
+	}
+	for _, s := range strs {
+		p.P("%s", s)
+	}
+	p.Out()
+	p.P("}")
+	return nil
+}
+
+func (g *cacheGen) genFunc(typ *types.Signature) error {
+	p := g.printer
+	g.Generating(typ)
+	name := g.GetFuncName(typ)
+	typeStr := g.TypeString(typ)
+
+	paramFields := fields(typ.Params(), "Param")
+	paramTypes := make([]types.Type, len(paramFields))
+	for i, f := range paramFields {
+		paramTypes[i] = f.Type()
+	}
+	paramVars := vars("param", len(paramFields))
+	params := zip(paramVars, g.typeStrings(paramTypes))
+	paramStruct := types.NewStruct(paramFields, nil)
+	hashed := !derive.IsComparable(paramStruct)
+
+	resFields := fields(typ.Results(), "Res")
+	resTypes := make([]types.Type, len(resFields))
+	for i, f := range resFields {
+		resTypes[i] = f.Type()
+	}
+	resTypeStrs := g.typeStrings(resTypes)
+	resVars := vars("res", len(resFields))
+	resStr := strings.Join(resTypeStrs, ", ")
+	if len(resTypeStrs) > 1 {
+		resStr = "(" + resStr + ")"
+	}
+	ret := "return"
+	if len(resVars) > 0 {
+		ret = "return " + strings.Join(vars("e.out.Res", len(resVars)), ", ")
+	}
+
+	p.P("")
+	switch g.kind {
+	case cacheSync:
+		p.P("// %s returns a memoized version of the input function, which is safe for concurrent use.", name)
+		p.P("// Concurrent calls with the same parameters wait for the first call, instead of calling the input function again.")
+		p.P("func %s(f %s) %s {", name, typeStr, typeStr)
+	case cacheLRU:
+		p.P("// %s returns a memoized version of the input function, which is safe for concurrent use", name)
+		p.P("// and only remembers the results of the capacity most recently used parameters.")
+		p.P("// Concurrent calls with the same parameters wait for the first call, instead of calling the input function again.")
+		p.P("func %s(capacity int, f %s) %s {", name, typeStr, typeStr)
+	case cacheTTL:
+		p.P("// %s returns a memoized version of the input function, which is safe for concurrent use", name)
+		p.P("// and only remembers a result for the ttl duration.")
+		p.P("// Concurrent calls with the same parameters wait for the first call, instead of calling the input function again.")
+		p.P("func %s(ttl %s.Duration, f %s) %s {", name, g.timePkg(), typeStr, typeStr)
+	case cacheSingleflight:
+		p.P("// %s returns a version of the input function, which is safe for concurrent use,", name)
+		p.P("// where concurrent calls with the same parameters wait for the first call and share its result,")
+		p.P("// instead of calling the input function again.")
+		p.P("func %s(f %s) %s {", name, typeStr, typeStr)
+	}
+	p.In()
+	if g.kind == cacheLRU {
+		p.P("if capacity < 1 {")
+		p.In()
+		p.P("capacity = 1")
+		p.Out()
+		p.P("}")
+	}
+	if err := g.genStruct("input", paramFields); err != nil {
+		return err
+	}
+	if err := g.genStruct("output", resFields); err != nil {
+		return err
+	}
+	p.P("type entry struct {")
+	p.In()
+	p.P("in input")
+	if hashed {
+		p.P("hash uint64")
+	}
+	p.P("out output")
+	p.P("done chan struct{}")
+	p.P("panicked bool")
+	switch g.kind {
+	case cacheLRU:
+		p.P("elem *%s.Element", g.listPkg())
+	case cacheTTL:
+		p.P("elem *%s.Element", g.listPkg())
+		p.P("expires %s.Time", g.timePkg())
+	}
+	p.Out()
+	p.P("}")
+	p.P("var mu %s.Mutex", g.syncPkg())
+	if hashed {
+		p.P("m := make(map[uint64][]*entry)")
+	} else {
+		p.P("m := make(map[input]*entry)")
+	}
+	switch g.kind {
+	case cacheLRU:
+		p.P("recent := %s.New()", g.listPkg())
+	case cacheTTL:
+		p.P("expiring := %s.New()", g.listPkg())
+	}
+	p.P("remove := func(e *entry) {")
+	p.In()
+	if hashed {
+		p.P("es := m[e.hash]")
+		p.P("for i := range es {")
+		p.In()
+		p.P("if es[i] == e {")
+		p.In()
+		p.P("es = append(es[:i], es[i+1:]...)")
+		p.P("break")
+		p.Out()
+		p.P("}")
+		p.Out()
+		p.P("}")
+		p.P("if len(es) == 0 {")
+		p.In()
+		p.P("delete(m, e.hash)")
+		p.Out()
+		p.P("} else {")
+		p.In()
+		p.P("m[e.hash] = es")
+		p.Out()
+		p.P("}")
+	} else {
+		p.P("if m[e.in] == e {")
+		p.In()
+		p.P("delete(m, e.in)")
+		p.Out()
+		p.P("}")
+	}
+	switch g.kind {
+	case cacheLRU:
+		p.P("recent.Remove(e.elem)")
+	case cacheTTL:
+		p.P("if e.elem != nil {")
+		p.In()
+		p.P("expiring.Remove(e.elem)")
+		p.Out()
+		p.P("}")
+	}
+	p.Out()
+	p.P("}")
+	p.P("return func(%s) %s {", strings.Join(params, ", "), resStr)
+	p.In()
+	p.P("in := input{%s}", strings.Join(paramVars, ", "))
+	if hashed {
+		p.P("h := %s(in)", g.hash.GetFuncName(paramStruct))
+	}
+	p.P("for {")
+	p.In()
+	p.P("mu.Lock()")
+	if g.kind == cacheTTL {
+		p.P("now := %s.Now()", g.timePkg())
+		p.P("for front := expiring.Front(); front != nil && now.After(front.Value.(*entry).expires); front = expiring.Front() {")
+		p.In()
+		p.P("remove(front.Value.(*entry))")
+		p.Out()
+		p.P("}")
+	}
+	if hashed {
+		p.P("var e *entry")
+		p.P("for _, v := range m[h] {")
+		p.In()
+		p.P("if %s(v.in, in) {", g.equal.GetFuncName(paramStruct, paramStruct))
+		p.In()
+		p.P("e = v")
+		p.P("break")
+		p.Out()
+		p.P("}")
+		p.Out()
+		p.P("}")
+	} else {
+		p.P("e := m[in]")
+	}
+	p.P("if e != nil {")
+	p.In()
+	if g.kind == cacheLRU {
+		p.P("recent.MoveToFront(e.elem)")
+	}
+	p.P("mu.Unlock()")
+	p.P("<-e.done")
+	p.P("if e.panicked {")
+	p.In()
+	p.P("continue")
+	p.Out()
+	p.P("}")
+	p.P(ret)
+	p.Out()
+	p.P("}")
+	if hashed {
+		p.P("e = &entry{in: in, hash: h, done: make(chan struct{})}")
+		p.P("m[h] = append(m[h], e)")
+	} else {
+		p.P("e = &entry{in: in, done: make(chan struct{})}")
+		p.P("m[in] = e")
+	}
+	if g.kind == cacheLRU {
+		p.P("e.elem = recent.PushFront(e)")
+		p.P("if recent.Len() > capacity {")
+		p.In()
+		p.P("remove(recent.Back().Value.(*entry))")
+		p.Out()
+		p.P("}")
+	}
+	p.P("mu.Unlock()")
+	p.P("returned := false")
+	p.P("defer func() {")
+	p.In()
+	p.P("if !returned {")
+	p.In()
+	p.P("mu.Lock()")
+	p.P("remove(e)")
+	p.P("mu.Unlock()")
+	p.P("e.panicked = true")
+	p.P("close(e.done)")
+	p.Out()
+	p.P("}")
+	p.Out()
+	p.P("}()")
+	if len(resVars) == 0 {
+		p.P("f(%s)", strings.Join(paramVars, ", "))
+	} else {
+		p.P("%s := f(%s)", strings.Join(resVars, ", "), strings.Join(paramVars, ", "))
+		p.P("e.out = output{%s}", strings.Join(resVars, ", "))
+	}
+	p.P("returned = true")
+	switch g.kind {
+	case cacheTTL:
+		p.P("mu.Lock()")
+		p.P("e.expires = %s.Now().Add(ttl)", g.timePkg())
+		p.P("e.elem = expiring.PushBack(e)")
+		p.P("mu.Unlock()")
+	case cacheSingleflight:
+		p.P("mu.Lock()")
+		p.P("remove(e)")
+		p.P("mu.Unlock()")
+	}
+	p.P("close(e.done)")
+	p.P(ret)
+	p.Out()
+	p.P("}")
+	p.Out()
+	p.P("}")
+	p.Out()
+	p.P("}")
+	return nil
+}
@@ -16,7 +16,27 @@
 //
 // The deriveMem function returns a memoized version of the input function.
 //   func deriveMem(func(A) B) func(A) B
+// The memoized function is not safe for concurrent use and remembers all results.
 //
+// The following variants are safe for concurrent use,
+// where concurrent calls with the same parameters wait for the first call and share its result,
+// instead of calling the input function again.
+//
+// The deriveMemSync function remembers all results.
+//   func deriveMemSync(func(A) B) func(A) B
+// The deriveMemLRU function only remembers the results of the capacity most recently used parameters,
+// where a capacity less than one is the same as a capacity of one.
+//   func deriveMemLRU(capacity int, func(A) B) func(A) B
+// The deriveMemTTL function only remembers a result for the ttl duration, after it was returned by the input function.
+//   func deriveMemTTL(ttl time.Duration, func(A) B) func(A) B
+// The deriveSingleflight function does not remember any results,
+// but only shares a result with the calls that arrive, while it is being computed.
+//   func deriveSingleflight(func(A) B) func(A) B
+// When the input function panics, its parameters are forgotten and the panic is passed on,
+// while the calls, which were waiting for it, call the input function again.
+// Expired results of deriveMemTTL are removed, whenever the memoized function is called.
+//
+// Parameters, which are not comparable, are hashed and compared with the derived hash and equal functions.
 package mem
 
 import (
@@ -0,0 +1,275 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package merge contains the implementation of the merge plugin, which generates the deriveMerge function.
+//
+// The deriveMerge function overlays a partially populated value onto another value,
+// for example to apply a partial update to stored state.
+//   deriveMerge(dst *T, src *T)
+//
+// The fields of src, which are not zero, are merged into dst:
+//	- basic types are overwritten, which means that false and 0 in src never overwrite dst
+//	- structs and pointers to structs are merged recursively, where a nil pointer in dst is allocated first
+//	- structs from external packages and types with an IsZero method, like time.Time, are replaced as a whole
+//	- slices are replaced by a copy, unless the field has the tag derive:"merge=append", in which case a copy is appended
+//	- maps are merged, where the entries of src overwrite the entries of dst with the same key
+//	- other pointers and interfaces are replaced by a copy
+//	- arrays are merged element by element
+// Copies are made with deriveDeepCopy, except for fields with the tag derive:"shallow", which are copied by reference.
+// Fields with the tag derive:"-" are skipped.
+//
+// Supported types:
+//	- basic types
+//	- named structs
+//	- slices
+//	- maps
+//	- pointers to these types
+//	- instantiated generic types
+//	- interfaces
+// Unsupported types:
+//	- chan
+//	- function
+//	- type parameters
+package merge
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+
+	"github.com/awalterschulze/goderive/derive"
+)
+
+// NewPlugin creates a new merge plugin.
+// This function returns the plugin name, default prefix and a constructor for the merge code generator.
+func NewPlugin() derive.Plugin {
+	return derive.NewPlugin("merge", "deriveMerge", New)
+}
+
+// New is a constructor for the merge code generator.
+// This generator should be reconstructed for each package.
+func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
+	return &gen{
+		TypesMap:   typesMap,
+		printer:    p,
+		reflectPkg: p.NewImport("reflect", "reflect"),
+		deepcopy:   deps["deepcopy"],
+	}
+}
+
+type gen struct {
+	derive.TypesMap
+	printer    derive.Printer
+	reflectPkg derive.Import
+	deepcopy   derive.Dependency
+}
+
+func (g *gen) Add(name string, typs []types.Type) (string, error) {
+	if len(typs) != 2 {
+		return "", fmt.Errorf("%s does not have two arguments", name)
+	}
+	if !types.Identical(typs[0], typs[1]) {
+		return "", fmt.Errorf("%s has two arguments, but they are of different types %s != %s",
+			name, g.TypeString(typs[0]), g.TypeString(typs[1]))
+	}
+	if _, ok := typs[0].Underlying().(*types.Pointer); !ok {
+		return "", fmt.Errorf("%s does not have pointer arguments, but %s", name, g.TypeString(typs[0]))
+	}
+	return g.SetFuncName(name, typs[0])
+}
+
+func (g *gen) Generate(typs []types.Type) error {
+	return g.genFunc(typs[0])
+}
+
+func (g *gen) genFunc(typ types.Type) error {
+	p := g.printer
+	g.Generating(typ)
+	name := g.GetFuncName(typ)
+	p.P("")
+	p.P("// %s merges the fields of src, which are not zero, into dst.", name)
+	p.P("func %s%s(dst, src %s) {", name, g.TypeParams(typ), g.TypeString(typ))
+	p.In()
+	if err := g.genStatement(typ.Underlying().(*types.Pointer).Elem(), "dst", "src"); err != nil {
+		return err
+	}
+	p.Out()
+	p.P("}")
+	return nil
+}
+
+func (g *gen) genStatement(typ types.Type, dst, src string) error {
+	p := g.printer
+	if g.atomic(typ) {
+		g.genAtomic(typ, "*"+dst, "*"+src)
+		return nil
+	}
+	switch ttyp := typ.Underlying().(type) {
+	case *types.Struct:
+		fields := derive.Fields(g.TypesMap, ttyp, false).For("merge")
+		for _, field := range fields.Fields {
+			dstField, srcField := field.Name(dst, nil), field.Name(src, nil)
+			if err := g.genField(field.Type, dstField, srcField, field.Tag); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *types.Array:
+		p.P("for i := range %s {", src)
+		p.In()
+		if err := g.genField(ttyp.Elem(), wrap("*"+dst)+"[i]", wrap("*"+src)+"[i]", nil); err != nil {
+			return err
+		}
+		p.Out()
+		p.P("}")
+		return nil
+	}
+	return g.genField(typ, "*"+dst, "*"+src, nil)
+}
+
+// genField prints the statements, which merge the src field into the dst field.
+func (g *gen) genField(fieldType types.Type, dstField, srcField string, tag derive.Tag) error {
+	p := g.printer
+	switch typ := fieldType.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case typ.Info()&types.IsBoolean != 0:
+			p.P("if %s {", srcField)
+		case typ.Info()&types.IsString != 0:
+			p.P("if %s != \"\" {", srcField)
+		case typ.Kind() == types.UntypedNil:
+			return fmt.Errorf("unsupported type %s", g.TypeString(fieldType))
+		default:
+			p.P("if %s != 0 {", srcField)
+		}
+		p.In()
+		p.P("%s = %s", dstField, srcField)
+		p.Out()
+		p.P("}")
+		return nil
+	case *types.Struct, *types.Array:
+		if g.atomic(fieldType) {
+			g.genAtomic(fieldType, dstField, srcField)
+			return nil
+		}
+		p.P("%s(&%s, &%s)", g.GetFuncName(types.NewPointer(fieldType)), dstField, srcField)
+		return nil
+	case *types.Pointer, *types.Slice, *types.Map, *types.Interface:
+		p.P("if %s != nil {", srcField)
+		p.In()
+		if tag.Shallow("merge") {
+			p.P("%s = %s", dstField, srcField)
+		} else if err := g.genCopy(fieldType, dstField, srcField, tag); err != nil {
+			return err
+		}
+		p.Out()
+		p.P("}")
+		return nil
+	}
+	// *Chan, *Tuple, *Signature, *TypeParam
+	return fmt.Errorf("unsupported type %s", g.TypeString(fieldType))
+}
+
+// atomic returns whether the struct is replaced as a whole, instead of being merged field by field.
+// This is the case for structs from external packages, since their fields are an implementation detail,
+// and for types with an IsZero method, like time.Time, since the method knows better when they are zero.
+func (g *gen) atomic(typ types.Type) bool {
+	if hasIsZeroMethod(typ) {
+		return true
+	}
+	if _, ok := typ.Underlying().(*types.Struct); !ok {
+		return false
+	}
+	named, isNamed := typ.(*types.Named)
+	return isNamed && g.TypesMap.IsExternal(named)
+}
+
+// genAtomic prints the statements, which replace the dst field with the src field, if the src field is not zero.
+func (g *gen) genAtomic(typ types.Type, dstField, srcField string) {
+	p := g.printer
+	switch {
+	case hasIsZeroMethod(typ):
+		p.P("if !%s.IsZero() {", wrap(srcField))
+	case types.Comparable(typ):
+		p.P("if %s != (%s{}) {", srcField, g.TypeString(typ))
+	default:
+		p.P("if !%s.ValueOf(%s).IsZero() {", g.reflectPkg(), srcField)
+	}
+	p.In()
+	p.P("%s = %s", dstField, srcField)
+	p.Out()
+	p.P("}")
+}
+
+// hasIsZeroMethod returns whether the method set of a pointer to the type contains an IsZero method, which returns a bool.
+func hasIsZeroMethod(typ types.Type) bool {
+	sel := types.NewMethodSet(types.NewPointer(typ)).Lookup(nil, "IsZero")
+	if sel == nil {
+		return false
+	}
+	sig, ok := sel.Type().(*types.Signature)
+	if !ok || sig.Params().Len() != 0 || sig.Results().Len() != 1 {
+		return false
+	}
+	return types.Identical(sig.Results().At(0).Type(), types.Typ[types.Bool])
+}
+
+// genCopy prints the statements, which merge the src field, which is not nil, into the dst field.
+func (g *gen) genCopy(fieldType types.Type, dstField, srcField string, tag derive.Tag) error {
+	p := g.printer
+	switch typ := fieldType.Underlying().(type) {
+	case *types.Pointer:
+		if _, ok := typ.Elem().Underlying().(*types.Struct); ok {
+			p.P("if %s == nil {", dstField)
+			p.In()
+			p.P("%s = new(%s)", dstField, g.TypeString(typ.Elem()))
+			p.Out()
+			p.P("}")
+			p.P("%s(%s, %s)", g.GetFuncName(fieldType), dstField, srcField)
+			return nil
+		}
+		p.P("%s = new(%s)", dstField, g.TypeString(typ.Elem()))
+		p.P("%s(%s, %s)", g.deepcopy.GetFuncName(fieldType), dstField, srcField)
+		return nil
+	case *types.Slice:
+		if tag.Append("merge") {
+			p.P("n := len(%s)", dstField)
+			p.P("%s = append(%s, make(%s, len(%s))...)", dstField, dstField, g.TypeString(fieldType), srcField)
+			p.P("%s(%s[n:], %s)", g.deepcopy.GetFuncName(fieldType), wrap(dstField), srcField)
+			return nil
+		}
+		p.P("%s = make(%s, len(%s))", dstField, g.TypeString(fieldType), srcField)
+		p.P("%s(%s, %s)", g.deepcopy.GetFuncName(fieldType), dstField, srcField)
+		return nil
+	case *types.Map:
+		p.P("if %s == nil {", dstField)
+		p.In()
+		p.P("%s = make(%s, len(%s))", dstField, g.TypeString(fieldType), srcField)
+		p.Out()
+		p.P("}")
+		p.P("%s(%s, %s)", g.deepcopy.GetFuncName(fieldType), dstField, srcField)
+		return nil
+	case *types.Interface:
+		p.P("%s = %s(%s)", dstField, g.deepcopy.GetFuncName(fieldType), srcField)
+		return nil
+	}
+	return fmt.Errorf("unsupported type %s", g.TypeString(fieldType))
+}
+
+func wrap(value string) string {
+	if strings.HasPrefix(value, "*") || strings.HasPrefix(value, "&") {
+		return "(" + value + ")"
+	}
+	return value
+}
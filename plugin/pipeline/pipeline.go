@@ -17,6 +17,20 @@
 // The derivePipeline starts up a concurrent pipeline of the given functions.
 //   derivePipeline(func(A) <-chan B, func(B) <-chan C) func(A) <-chan C
 //
+// The derivePipelineUnordered and derivePipelineOrdered functions compose stages, which can fail, into a concurrent pipeline,
+// where each stage is run by n workers.
+//   derivePipelineUnordered(n int, func(context.Context, A) (B, error), func(context.Context, B) (C, error), ...) func(context.Context, <-chan A) (<-chan C, func() error)
+//   derivePipelineOrdered(n int, func(context.Context, A) (B, error), func(context.Context, B) (C, error), ...) func(context.Context, <-chan A) (<-chan C, func() error)
+// The one number of workers applies to every stage, unless each stage is preceded by its own number of workers.
+//   derivePipelineUnordered(n0 int, func(context.Context, A) (B, error), n1 int, func(context.Context, B) (C, error), ...) func(context.Context, <-chan A) (<-chan C, func() error)
+//
+// The returned function starts the pipeline, given a context and an input channel,
+// and returns the output channel and a wait function, which returns the first error, after the output channel is closed.
+// The pipeline is cancelled on the first error, or when the context is cancelled, in which case wait returns the context's error.
+// derivePipelineOrdered sends the results of each stage in the order of their inputs,
+// where at most n inputs are processed concurrently, while results, which are finished early, wait for the results before them.
+// derivePipelineUnordered sends the results of each stage as soon as they are finished.
+//
 // Example output can be found here:
 // https://github.com/awalterschulze/goderive/tree/master/example/plugin/pipeline
 package pipeline
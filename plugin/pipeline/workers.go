@@ -0,0 +1,382 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package pipeline
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+
+	"github.com/awalterschulze/goderive/derive"
+)
+
+// NewUnorderedPlugin creates a new pipelineunordered plugin.
+// This function returns the plugin name, default prefix and a constructor for the pipelineunordered code generator.
+func NewUnorderedPlugin() derive.Plugin {
+	return derive.NewPlugin("pipelineunordered", "derivePipelineUnordered", NewUnordered)
+}
+
+// NewOrderedPlugin creates a new pipelineordered plugin.
+// This function returns the plugin name, default prefix and a constructor for the pipelineordered code generator.
+func NewOrderedPlugin() derive.Plugin {
+	return derive.NewPlugin("pipelineordered", "derivePipelineOrdered", NewOrdered)
+}
+
+// NewUnordered is a constructor for the pipelineunordered code generator,
+// which sends the results of each stage in the order in which they are finished.
+// This generator should be reconstructed for each package.
+func NewUnordered(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
+	return newWorkers(typesMap, p, false)
+}
+
+// NewOrdered is a constructor for the pipelineordered code generator,
+// which sends the results of each stage in the order of their inputs.
+// This generator should be reconstructed for each package.
+func NewOrdered(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
+	return newWorkers(typesMap, p, true)
+}
+
+func newWorkers(typesMap derive.TypesMap, p derive.Printer, ordered bool) derive.Generator {
+	return &workersGen{
+		TypesMap:   typesMap,
+		printer:    p,
+		contextPkg: p.NewImport("context", "context"),
+		syncPkg:    p.NewImport("sync", "sync"),
+		ordered:    ordered,
+	}
+}
+
+type workersGen struct {
+	derive.TypesMap
+	printer    derive.Printer
+	contextPkg derive.Import
+	syncPkg    derive.Import
+	// ordered is whether the results of each stage are sent in the order of their inputs.
+	ordered bool
+}
+
+func (g *workersGen) Add(name string, typs []types.Type) (string, error) {
+	if len(typs) < 2 {
+		return "", fmt.Errorf("%s does not have a number of workers and at least one stage", name)
+	}
+	// The types are copied, so that defaulting the number of workers does not change the types of the caller.
+	typs = append([]types.Type(nil), typs...)
+	// The numbers of workers are either only the first argument or every other argument, starting with the first.
+	step := len(typs)
+	if perStage(typs) {
+		if len(typs)%2 != 0 {
+			return "", fmt.Errorf("%s does not have a stage after each number of workers", name)
+		}
+		step = 2
+	}
+	for i := 0; i < len(typs); i += step {
+		typs[i] = types.Default(typs[i])
+		if !types.Identical(typs[i], types.Typ[types.Int]) {
+			return "", fmt.Errorf("%s, the number of workers, %s, is not an int", name, g.TypeString(typs[i]))
+		}
+	}
+	if _, err := g.stages(name, stageTypes(typs)); err != nil {
+		return "", err
+	}
+	return g.SetFuncName(name, typs...)
+}
+
+// perStage returns whether the arguments are a number of workers followed by a stage, for each stage,
+// instead of one number of workers for all the stages, followed by the stages.
+func perStage(typs []types.Type) bool {
+	if len(typs) < 3 {
+		return false
+	}
+	basic, ok := typs[2].Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsInteger != 0
+}
+
+// stageTypes returns the types of the stages, without the numbers of workers.
+func stageTypes(typs []types.Type) []types.Type {
+	if !perStage(typs) {
+		return typs[1:]
+	}
+	stages := make([]types.Type, 0, len(typs)/2)
+	for i := 1; i < len(typs); i += 2 {
+		stages = append(stages, typs[i])
+	}
+	return stages
+}
+
+// workers returns the name of the parameter, which is the number of workers of the i-th stage.
+func workers(typs []types.Type, i int) string {
+	if !perStage(typs) {
+		return "n"
+	}
+	return fmt.Sprintf("n%d", i)
+}
+
+// stages returns the types of the values, which flow between the stages,
+// starting with the input of the first stage and ending with the output of the last stage.
+func (g *workersGen) stages(name string, typs []types.Type) ([]types.Type, error) {
+	var flow []types.Type
+	for i, typ := range typs {
+		in, out, err := g.stage(typ)
+		if err != nil {
+			return nil, fmt.Errorf("%s, stage %d, %s, %v", name, i, g.TypeString(typ), err)
+		}
+		if i == 0 {
+			flow = append(flow, in)
+		} else if !types.Identical(flow[i], in) {
+			return nil, fmt.Errorf("%s, stage %d's output %s is not the same as stage %d's input %s", name, i-1, g.TypeString(flow[i]), i, g.TypeString(in))
+		}
+		flow = append(flow, out)
+	}
+	return flow, nil
+}
+
+// stage returns the input and output types of a stage of the form: func(context.Context, A) (B, error)
+func (g *workersGen) stage(typ types.Type) (inTyp, outTyp types.Type, err error) {
+	sig, ok := typ.(*types.Signature)
+	if !ok {
+		return nil, nil, fmt.Errorf("is not a function")
+	}
+	params := sig.Params()
+	results := sig.Results()
+	if params.Len() != 2 || !derive.IsContext(params.At(0).Type()) {
+		return nil, nil, fmt.Errorf("does not have a context and one other parameter")
+	}
+	if results.Len() != 2 || !derive.IsError(results.At(1).Type()) {
+		return nil, nil, fmt.Errorf("does not have one result and an error")
+	}
+	return params.At(1).Type(), results.At(0).Type(), nil
+}
+
+func (g *workersGen) Generate(typs []types.Type) error {
+	name := g.GetFuncName(typs...)
+	stages := stageTypes(typs)
+	flow, err := g.stages(name, stages)
+	if err != nil {
+		return err
+	}
+	g.Generating(typs...)
+	p := g.printer
+	contextStr := g.contextPkg()
+	syncStr := g.syncPkg()
+	var params []string
+	if !perStage(typs) {
+		params = append(params, "n int")
+	}
+	for i, stage := range stages {
+		if perStage(typs) {
+			params = append(params, workers(typs, i)+" int")
+		}
+		params = append(params, fmt.Sprintf("f%d %s", i, g.TypeString(stage)))
+	}
+	inStr := g.TypeString(flow[0])
+	outStr := g.TypeString(flow[len(flow)-1])
+	resStr := fmt.Sprintf("(<-chan %s, func() error)", outStr)
+
+	p.P("")
+	if perStage(typs) {
+		p.P("// %s composes the stages into a concurrent pipeline, where each stage is run by the number of workers before it.", name)
+	} else {
+		p.P("// %s composes the stages into a concurrent pipeline, where each stage is run by n workers.", name)
+	}
+	if g.ordered {
+		p.P("// The results of each stage are sent in the order of their inputs.")
+	} else {
+		p.P("// The results of each stage are sent in the order in which they are finished.")
+	}
+	p.P("// The returned function starts the pipeline and returns its output and a wait function.")
+	p.P("// The pipeline is cancelled on the first error, which is returned by wait,")
+	p.P("// after the output has been read until it is closed.")
+	p.P("func %s(%s) func(%s.Context, <-chan %s) %s {", name, strings.Join(params, ", "), contextStr, inStr, resStr)
+	p.In()
+	for i := range stages {
+		if i > 0 && !perStage(typs) {
+			break
+		}
+		n := workers(typs, i)
+		p.P("if %s < 1 {", n)
+		p.In()
+		p.P("%s = 1", n)
+		p.Out()
+		p.P("}")
+	}
+	p.P("return func(parent %s.Context, in <-chan %s) %s {", contextStr, inStr, resStr)
+	p.In()
+	p.P("ctx, cancel := %s.WithCancel(parent)", contextStr)
+	p.P("var once %s.Once", syncStr)
+	p.P("var err error")
+	p.P("fail := func(e error) {")
+	p.In()
+	p.P("once.Do(func() {")
+	p.In()
+	p.P("err = e")
+	p.P("cancel()")
+	p.Out()
+	p.P("})")
+	p.Out()
+	p.P("}")
+	p.P("var wg %s.WaitGroup", syncStr)
+	for i := range stages {
+		in := "in"
+		if i > 0 {
+			in = fmt.Sprintf("out%d", i-1)
+		}
+		out := fmt.Sprintf("out%d", i)
+		p.P("%s := make(chan %s)", out, g.TypeString(flow[i+1]))
+		if g.ordered {
+			g.genOrdered(i, workers(typs, i), in, out, flow[i+1])
+		} else {
+			g.genUnordered(i, workers(typs, i), in, out)
+		}
+	}
+	p.P("return out%d, func() error {", len(stages)-1)
+	p.In()
+	p.P("wg.Wait()")
+	p.P("cancel()")
+	p.P("return err")
+	p.Out()
+	p.P("}")
+	p.Out()
+	p.P("}")
+	p.Out()
+	p.P("}")
+	return nil
+}
+
+// genReceive prints a loop, which receives values from the input channel, until it is closed or the pipeline is cancelled.
+// The body is printed by the given function and is called with the received value.
+func (g *workersGen) genReceive(in string, body func(v string)) {
+	p := g.printer
+	p.P("for {")
+	p.In()
+	p.P("select {")
+	p.P("case <-ctx.Done():")
+	p.In()
+	p.P("fail(ctx.Err())")
+	p.P("return")
+	p.Out()
+	p.P("case a, ok := <-%s:", in)
+	p.In()
+	p.P("if !ok {")
+	p.In()
+	p.P("return")
+	p.Out()
+	p.P("}")
+	body("a")
+	p.Out()
+	p.P("}")
+	p.Out()
+	p.P("}")
+}
+
+// genSend prints a send of the value to the output channel, which returns if the pipeline is cancelled.
+func (g *workersGen) genSend(out, v string) {
+	p := g.printer
+	p.P("select {")
+	p.P("case %s <- %s:", out, v)
+	p.P("case <-ctx.Done():")
+	p.In()
+	p.P("fail(ctx.Err())")
+	p.P("return")
+	p.Out()
+	p.P("}")
+}
+
+// genUnordered prints a stage, where n workers receive from the input channel and send to the output channel.
+func (g *workersGen) genUnordered(i int, n, in, out string) {
+	p := g.printer
+	p.P("var wg%d %s.WaitGroup", i, g.syncPkg())
+	p.P("wg%d.Add(%s)", i, n)
+	p.P("for w := 0; w < %s; w++ {", n)
+	p.In()
+	p.P("go func() {")
+	p.In()
+	p.P("defer wg%d.Done()", i)
+	g.genReceive(in, func(a string) {
+		p.P("b, e := f%d(ctx, %s)", i, a)
+		p.P("if e != nil {")
+		p.In()
+		p.P("fail(e)")
+		p.P("return")
+		p.Out()
+		p.P("}")
+		g.genSend(out, "b")
+	})
+	p.Out()
+	p.P("}()")
+	p.Out()
+	p.P("}")
+	p.P("wg.Add(1)")
+	p.P("go func() {")
+	p.In()
+	p.P("defer wg.Done()")
+	p.P("wg%d.Wait()", i)
+	p.P("close(%s)", out)
+	p.Out()
+	p.P("}()")
+}
+
+// genOrdered prints a stage, where each input is given a slot in a queue, before it is processed by one of at most n workers,
+// so that the results can be sent to the output channel in the order of the queue.
+func (g *workersGen) genOrdered(i int, n, in, out string, outTyp types.Type) {
+	p := g.printer
+	outStr := g.TypeString(outTyp)
+	p.P("slots%d := make(chan chan %s, %s-1)", i, outStr, n)
+	p.P("wg.Add(2)")
+	p.P("go func() {")
+	p.In()
+	p.P("defer wg.Done()")
+	p.P("defer close(slots%d)", i)
+	g.genReceive(in, func(a string) {
+		p.P("slot := make(chan %s, 1)", outStr)
+		g.genSend(fmt.Sprintf("slots%d", i), "slot")
+		p.P("wg.Add(1)")
+		p.P("go func() {")
+		p.In()
+		p.P("defer wg.Done()")
+		p.P("b, e := f%d(ctx, %s)", i, a)
+		p.P("if e != nil {")
+		p.In()
+		p.P("fail(e)")
+		p.P("return")
+		p.Out()
+		p.P("}")
+		p.P("slot <- b")
+		p.Out()
+		p.P("}()")
+	})
+	p.Out()
+	p.P("}()")
+	p.P("go func() {")
+	p.In()
+	p.P("defer wg.Done()")
+	p.P("defer close(%s)", out)
+	p.P("for slot := range slots%d {", i)
+	p.In()
+	p.P("select {")
+	p.P("case b := <-slot:")
+	p.In()
+	g.genSend(out, "b")
+	p.Out()
+	p.P("case <-ctx.Done():")
+	p.In()
+	p.P("fail(ctx.Err())")
+	p.P("return")
+	p.Out()
+	p.P("}")
+	p.Out()
+	p.P("}")
+	p.Out()
+	p.P("}()")
+}
@@ -0,0 +1,116 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package plugin lists the plugins that are included in the goderive binary.
+package plugin
+
+import (
+	"github.com/awalterschulze/goderive/derive"
+	"github.com/awalterschulze/goderive/plugin/all"
+	"github.com/awalterschulze/goderive/plugin/any"
+	"github.com/awalterschulze/goderive/plugin/batch"
+	"github.com/awalterschulze/goderive/plugin/clone"
+	"github.com/awalterschulze/goderive/plugin/compare"
+	"github.com/awalterschulze/goderive/plugin/compose"
+	"github.com/awalterschulze/goderive/plugin/contains"
+	"github.com/awalterschulze/goderive/plugin/curry"
+	"github.com/awalterschulze/goderive/plugin/deepcopy"
+	"github.com/awalterschulze/goderive/plugin/diff"
+	"github.com/awalterschulze/goderive/plugin/do"
+	"github.com/awalterschulze/goderive/plugin/dup"
+	"github.com/awalterschulze/goderive/plugin/equal"
+	"github.com/awalterschulze/goderive/plugin/fanout"
+	"github.com/awalterschulze/goderive/plugin/filter"
+	"github.com/awalterschulze/goderive/plugin/flip"
+	"github.com/awalterschulze/goderive/plugin/fmap"
+	"github.com/awalterschulze/goderive/plugin/gostring"
+	"github.com/awalterschulze/goderive/plugin/hash"
+	"github.com/awalterschulze/goderive/plugin/intersect"
+	"github.com/awalterschulze/goderive/plugin/iszero"
+	"github.com/awalterschulze/goderive/plugin/join"
+	"github.com/awalterschulze/goderive/plugin/keys"
+	"github.com/awalterschulze/goderive/plugin/max"
+	"github.com/awalterschulze/goderive/plugin/mem"
+	"github.com/awalterschulze/goderive/plugin/merge"
+	"github.com/awalterschulze/goderive/plugin/min"
+	"github.com/awalterschulze/goderive/plugin/pipeline"
+	"github.com/awalterschulze/goderive/plugin/reset"
+	"github.com/awalterschulze/goderive/plugin/set"
+	"github.com/awalterschulze/goderive/plugin/sort"
+	"github.com/awalterschulze/goderive/plugin/stringer"
+	"github.com/awalterschulze/goderive/plugin/takewhile"
+	"github.com/awalterschulze/goderive/plugin/traverse"
+	"github.com/awalterschulze/goderive/plugin/tuple"
+	"github.com/awalterschulze/goderive/plugin/uncurry"
+	"github.com/awalterschulze/goderive/plugin/union"
+	"github.com/awalterschulze/goderive/plugin/unique"
+	"github.com/awalterschulze/goderive/plugin/walk"
+)
+
+// Default returns new instances of all the plugins, which are included in the goderive binary, with their default prefixes.
+func Default() []derive.Plugin {
+	return []derive.Plugin{
+		equal.NewPlugin(),
+		equal.NewGraphPlugin(),
+		compare.NewPlugin(),
+		fmap.NewPlugin(),
+		join.NewPlugin(),
+		keys.NewPlugin(),
+		sort.NewPlugin(),
+		deepcopy.NewPlugin(),
+		deepcopy.NewGraphPlugin(),
+		diff.NewPlugin(),
+		merge.NewPlugin(),
+		iszero.NewPlugin(),
+		iszero.NewEmptyPlugin(),
+		reset.NewPlugin(),
+		walk.NewPlugin(),
+		set.NewPlugin(),
+		min.NewPlugin(),
+		max.NewPlugin(),
+		contains.NewPlugin(),
+		intersect.NewPlugin(),
+		union.NewPlugin(),
+		filter.NewPlugin(),
+		takewhile.NewPlugin(),
+		unique.NewPlugin(),
+		flip.NewPlugin(),
+		curry.NewPlugin(),
+		uncurry.NewPlugin(),
+		all.NewPlugin(),
+		any.NewPlugin(),
+		tuple.NewPlugin(),
+		gostring.NewPlugin(),
+		stringer.NewPlugin(),
+		compose.NewPlugin(),
+		do.NewPlugin(),
+		pipeline.NewPlugin(),
+		pipeline.NewUnorderedPlugin(),
+		pipeline.NewOrderedPlugin(),
+		dup.NewPlugin(),
+		dup.NewTeePlugin(),
+		fanout.NewPlugin(),
+		batch.NewPlugin(),
+		clone.NewPlugin(),
+		hash.NewPlugin(),
+		hash.NewSeedPlugin(),
+		mem.NewPlugin(),
+		mem.NewSyncPlugin(),
+		mem.NewLRUPlugin(),
+		mem.NewTTLPlugin(),
+		mem.NewSingleflightPlugin(),
+		traverse.NewPlugin(),
+		traverse.NewParallelPlugin(),
+	}
+}
@@ -0,0 +1,180 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package reset contains the implementation of the reset plugin, which generates the deriveReset function.
+//
+// The deriveReset function sets a value to the zero value of its type,
+// while it keeps the memory that is allocated for slices and maps, so that the value can be reused without allocating.
+//   deriveReset(*T)
+//
+// The fields are reset as follows:
+//	- structs and arrays are reset field by field and element by element
+//	- slices are truncated to a length of zero, after their elements are zeroed, which keeps their capacity
+//	- maps are cleared, which keeps their allocated memory
+//	- all other types, including pointers, are set to their zero value
+// Fields with the tag derive:"-" or derive:"reset=-" are not reset.
+//
+// Supported types:
+//	- basic types
+//	- named structs
+//	- arrays
+//	- slices
+//	- maps
+//	- pointers
+//	- interfaces
+//	- chan
+//	- function
+//	- private fields of structs in external packages (using reflect and unsafe)
+//	- instantiated generic types
+// Unsupported types:
+//	- type parameters
+package reset
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+
+	"github.com/awalterschulze/goderive/derive"
+)
+
+// NewPlugin creates a new reset plugin.
+// This function returns the plugin name, default prefix and a constructor for the reset code generator.
+func NewPlugin() derive.Plugin {
+	return derive.NewPlugin("reset", "deriveReset", New)
+}
+
+// New is a constructor for the reset code generator.
+// This generator should be reconstructed for each package.
+func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
+	return &gen{
+		TypesMap:   typesMap,
+		printer:    p,
+		reflectPkg: p.NewImport("reflect", "reflect"),
+		unsafePkg:  p.NewImport("unsafe", "unsafe"),
+	}
+}
+
+type gen struct {
+	derive.TypesMap
+	printer    derive.Printer
+	reflectPkg derive.Import
+	unsafePkg  derive.Import
+}
+
+func (g *gen) Add(name string, typs []types.Type) (string, error) {
+	if len(typs) != 1 {
+		return "", fmt.Errorf("%s does not have one argument", name)
+	}
+	if _, ok := typs[0].Underlying().(*types.Pointer); !ok {
+		return "", fmt.Errorf("%s does not have a pointer argument, but %s", name, g.TypeString(typs[0]))
+	}
+	return g.SetFuncName(name, typs[0])
+}
+
+func (g *gen) Generate(typs []types.Type) error {
+	return g.genFunc(typs[0])
+}
+
+func (g *gen) genFunc(typ types.Type) error {
+	p := g.printer
+	g.Generating(typ)
+	name := g.GetFuncName(typ)
+	p.P("")
+	p.P("// %s sets this to the zero value of its type, while keeping the capacity of slices and maps.", name)
+	p.P("func %s%s(this %s) {", name, g.TypeParams(typ), g.TypeString(typ))
+	p.In()
+	if err := g.genStatement(typ.Underlying().(*types.Pointer).Elem(), "this"); err != nil {
+		return err
+	}
+	p.Out()
+	p.P("}")
+	return nil
+}
+
+func (g *gen) genStatement(typ types.Type, this string) error {
+	p := g.printer
+	switch ttyp := typ.Underlying().(type) {
+	case *types.Struct:
+		named, isNamed := typ.(*types.Named)
+		external := isNamed && g.TypesMap.IsExternal(named)
+		fields := derive.Fields(g.TypesMap, ttyp, external).For("reset")
+		if fields.Reflect {
+			p.P(`thisv := `+g.reflectPkg()+`.Indirect(`+g.reflectPkg()+`.ValueOf(%s))`, this)
+		}
+		for _, field := range fields.Fields {
+			var thisField string
+			if field.Private() && external {
+				thisField = field.Name("thisv", g.unsafePkg)
+			} else {
+				thisField = field.Name(this, nil)
+			}
+			if err := g.genField(field.Type, thisField); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *types.Array:
+		p.P("for i := range %s {", this)
+		p.In()
+		if err := g.genField(ttyp.Elem(), wrap("*"+this)+"[i]"); err != nil {
+			return err
+		}
+		p.Out()
+		p.P("}")
+		return nil
+	}
+	return g.genField(typ, "*"+this)
+}
+
+// genField prints the statements, which reset the field.
+func (g *gen) genField(fieldType types.Type, thisField string) error {
+	p := g.printer
+	switch typ := fieldType.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case typ.Info()&types.IsBoolean != 0:
+			p.P("%s = false", thisField)
+		case typ.Info()&types.IsString != 0:
+			p.P("%s = \"\"", thisField)
+		case typ.Kind() == types.UntypedNil:
+			return fmt.Errorf("unsupported type %s", g.TypeString(fieldType))
+		default:
+			p.P("%s = 0", thisField)
+		}
+		return nil
+	case *types.Struct, *types.Array:
+		p.P("%s(&%s)", g.GetFuncName(types.NewPointer(fieldType)), thisField)
+		return nil
+	case *types.Slice:
+		p.P("clear(%s)", thisField)
+		p.P("%s = %s[:0]", thisField, wrap(thisField))
+		return nil
+	case *types.Map:
+		p.P("clear(%s)", thisField)
+		return nil
+	case *types.Pointer, *types.Interface, *types.Chan, *types.Signature:
+		p.P("%s = nil", thisField)
+		return nil
+	}
+	// *Tuple, *TypeParam
+	return fmt.Errorf("unsupported type %s", g.TypeString(fieldType))
+}
+
+func wrap(value string) string {
+	if strings.HasPrefix(value, "*") || strings.HasPrefix(value, "&") {
+		return "(" + value + ")"
+	}
+	return value
+}
@@ -0,0 +1,401 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package stringer contains the implementation of the string plugin, which generates the deriveString function.
+//
+// The deriveString function returns a compact and human readable representation of the argument's value,
+// which is meant for log lines and error messages, unlike deriveGoString, which returns valid go syntax.
+//   deriveString(T) string
+//
+// For example:
+//	Person{Name: "Ann", Password: <redacted>, Tags: ["a", "b"], Parent: &Person{Name: "Bob"}}
+//
+// The representation is printed as follows:
+//	- structs print their type name and their fields, where fields that are zero or empty are left out
+//	- pointers print & followed by the value they point to, or nil
+//	- slices, arrays and maps only print their first 10 elements, followed by the number of elements that are left out, for example [1, 2, ..., 10, ... 5 more]
+//	- map entries are printed in the order of their sorted keys
+//	- interfaces and type parameters are printed with fmt and %v
+//	- channels and functions print <chan> and <func>, unless they are nil
+//	- fields and elements, of which the type has a String method, print the result of the String method
+// Fields with the tag derive:"sensitive" or derive:"string=sensitive" are printed as <redacted> and
+// fields with the tag derive:"-" or derive:"string=-" are left out.
+//
+// A String method can return the result of deriveString for its receiver,
+// since the String method of the type, which is passed to deriveString, is never called.
+//
+// Supported types:
+//	- basic types
+//	- named structs
+//	- slices
+//	- maps, where the keys are sortable
+//	- pointers to these types
+//	- private fields of structs in the same package
+//	- interfaces
+//	- chan
+//	- function
+//	- instantiated generic types
+//	- type parameters
+// Unsupported types:
+//	- private fields of structs in external packages, unless the struct has a String method
+package stringer
+
+import (
+	"fmt"
+	"go/types"
+
+	"github.com/awalterschulze/goderive/derive"
+)
+
+// maxElems is the number of elements, which are printed for slices, arrays and maps.
+const maxElems = 10
+
+// NewPlugin creates a new string plugin.
+// This function returns the plugin name, default prefix and a constructor for the string code generator.
+func NewPlugin() derive.Plugin {
+	return derive.NewPlugin("string", "deriveString", New)
+}
+
+// New is a constructor for the string code generator.
+// This generator should be reconstructed for each package.
+func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
+	return &gen{
+		TypesMap:   typesMap,
+		printer:    p,
+		strconvPkg: p.NewImport("strconv", "strconv"),
+		fmtPkg:     p.NewImport("fmt", "fmt"),
+		isempty:    deps["isempty"],
+		keys:       deps["keys"],
+		sort:       deps["sort"],
+		buf:        types.NewSlice(types.Typ[types.Byte]),
+	}
+}
+
+type gen struct {
+	derive.TypesMap
+	printer    derive.Printer
+	strconvPkg derive.Import
+	fmtPkg     derive.Import
+	isempty    derive.Dependency
+	keys       derive.Dependency
+	sort       derive.Dependency
+	// buf is the type of the buffer, which the representation is appended to.
+	buf types.Type
+}
+
+func (g *gen) Add(name string, typs []types.Type) (string, error) {
+	if len(typs) != 1 {
+		return "", fmt.Errorf("%s does not have one argument", name)
+	}
+	return g.SetFuncName(name, types.Default(typs[0]))
+}
+
+func (g *gen) Generate(typs []types.Type) error {
+	if len(typs) == 2 {
+		return g.genAppendFunc(typs[0])
+	}
+	return g.genFunc(typs[0])
+}
+
+// appendFunc returns the name of the function, which appends the representation of the type to the buffer.
+func (g *gen) appendFunc(typ types.Type) string {
+	return g.GetFuncName(typ, g.buf)
+}
+
+func (g *gen) genFunc(typ types.Type) error {
+	p := g.printer
+	g.Generating(typ)
+	name := g.GetFuncName(typ)
+	p.P("")
+	p.P("// %s returns a compact and human readable representation of this.", name)
+	p.P("func %s%s(this %s) string {", name, g.TypeParams(typ), g.TypeString(typ))
+	p.In()
+	p.P("return string(%s(this, nil))", g.appendFunc(typ))
+	p.Out()
+	p.P("}")
+	return nil
+}
+
+func (g *gen) genAppendFunc(typ types.Type) error {
+	p := g.printer
+	g.Generating(typ, g.buf)
+	name := g.appendFunc(typ)
+	p.P("")
+	p.P("// %s appends a compact and human readable representation of this to buf.", name)
+	p.P("func %s%s(this %s, buf []byte) []byte {", name, g.TypeParams(typ), g.TypeString(typ))
+	p.In()
+	if err := g.genStatement(typ); err != nil {
+		return err
+	}
+	p.P("return buf")
+	p.Out()
+	p.P("}")
+	return nil
+}
+
+func (g *gen) genStatement(typ types.Type) error {
+	p := g.printer
+	if derive.IsTypeParam(typ) {
+		return g.genValue(typ, "this", false)
+	}
+	switch ttyp := typ.Underlying().(type) {
+	case *types.Pointer:
+		p.P("if this == nil {")
+		p.In()
+		p.P("return append(buf, \"nil\"...)")
+		p.Out()
+		p.P("}")
+		p.P("buf = append(buf, '&')")
+		return g.genValue(ttyp.Elem(), "*this", true)
+	case *types.Struct:
+		named, isNamed := typ.(*types.Named)
+		external := isNamed && g.TypesMap.IsExternal(named)
+		fields := derive.Fields(g.TypesMap, ttyp, external).For("string")
+		typeName := ""
+		if isNamed {
+			typeName = types.TypeString(named, func(*types.Package) string { return "" })
+		}
+		p.P("buf = append(buf, %q...)", typeName+"{")
+		if len(fields.Fields) > 0 {
+			p.P("n := len(buf)")
+		}
+		for _, field := range fields.Fields {
+			if field.Private() && external {
+				return fmt.Errorf("private fields of external structs not supported, found %s in %v", field.DebugName(), g.TypeString(typ))
+			}
+			if err := g.genField(field); err != nil {
+				return err
+			}
+		}
+		p.P("buf = append(buf, '}')")
+		return nil
+	case *types.Slice, *types.Array:
+		elem := ttyp.(interface{ Elem() types.Type }).Elem()
+		p.P("buf = append(buf, '[')")
+		p.P("for i, v := range this {")
+		p.In()
+		g.genElided("i", "len(this)")
+		p.P("if i > 0 {")
+		p.In()
+		p.P("buf = append(buf, \", \"...)")
+		p.Out()
+		p.P("}")
+		if err := g.genValue(elem, "v", true); err != nil {
+			return err
+		}
+		p.Out()
+		p.P("}")
+		p.P("buf = append(buf, ']')")
+		return nil
+	case *types.Map:
+		p.P("buf = append(buf, \"map[\"...)")
+		p.P("for i, k := range %s(%s(this)) {", g.sort.GetFuncName(types.NewSlice(ttyp.Key())), g.keys.GetFuncName(typ))
+		p.In()
+		g.genElided("i", "len(this)")
+		p.P("if i > 0 {")
+		p.In()
+		p.P("buf = append(buf, \", \"...)")
+		p.Out()
+		p.P("}")
+		if err := g.genValue(ttyp.Key(), "k", true); err != nil {
+			return err
+		}
+		p.P("buf = append(buf, \": \"...)")
+		if err := g.genValue(ttyp.Elem(), "this[k]", true); err != nil {
+			return err
+		}
+		p.Out()
+		p.P("}")
+		p.P("buf = append(buf, ']')")
+		return nil
+	}
+	return g.genValue(typ, "this", false)
+}
+
+// genElided prints the statements, which print the number of elements that are left out and break out of the loop,
+// when the maximum number of elements have been printed.
+func (g *gen) genElided(i, length string) {
+	p := g.printer
+	p.P("if %s == %d {", i, maxElems)
+	p.In()
+	p.P("buf = append(buf, \", ... \"...)")
+	p.P("buf = %s.AppendInt(buf, int64(%s-%s), 10)", g.strconvPkg(), length, i)
+	p.P("buf = append(buf, \" more\"...)")
+	p.P("break")
+	p.Out()
+	p.P("}")
+}
+
+// genField prints the statements, which print the name and value of the field, unless it is empty.
+func (g *gen) genField(field *derive.Field) error {
+	p := g.printer
+	this := field.Name("this", nil)
+	sensitive := field.Tag.Sensitive("string")
+	notEmpty, ok := g.notEmpty(field.Type, this)
+	if ok && !sensitive {
+		p.P("if %s {", notEmpty)
+		p.In()
+	}
+	p.P("if len(buf) > n {")
+	p.In()
+	p.P("buf = append(buf, \", \"...)")
+	p.Out()
+	p.P("}")
+	p.P("buf = append(buf, %q...)", field.DebugName()+": ")
+	if sensitive {
+		p.P("buf = append(buf, \"<redacted>\"...)")
+	} else if err := g.genValue(field.Type, this, true); err != nil {
+		return err
+	}
+	if ok && !sensitive {
+		p.Out()
+		p.P("}")
+	}
+	return nil
+}
+
+// notEmpty returns the expression, which returns whether the value is not zero or empty,
+// and whether the emptiness of the type can be checked.
+func (g *gen) notEmpty(typ types.Type, this string) (string, bool) {
+	if derive.IsTypeParam(typ) {
+		return "", false
+	}
+	switch ttyp := typ.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case ttyp.Info()&types.IsBoolean != 0:
+			return this, true
+		case ttyp.Info()&types.IsString != 0:
+			return this + ` != ""`, true
+		}
+		return this + " != 0", true
+	case *types.Slice, *types.Map:
+		return fmt.Sprintf("len(%s) != 0", this), true
+	case *types.Pointer, *types.Interface, *types.Chan, *types.Signature:
+		return this + " != nil", true
+	case *types.Struct, *types.Array:
+		return fmt.Sprintf("!%s(%s)", g.isempty.GetFuncName(typ), this), true
+	}
+	return "", false
+}
+
+// genValue prints the statements, which append the value to the buffer,
+// where the String method of the type is called, if it has one and stringer is true.
+func (g *gen) genValue(typ types.Type, this string, stringer bool) error {
+	p := g.printer
+	if derive.IsTypeParam(typ) {
+		p.P("buf = %s.Appendf(buf, \"%%v\", %s)", g.fmtPkg(), this)
+		return nil
+	}
+	if _, isInterface := typ.Underlying().(*types.Interface); stringer && !isInterface && hasStringMethod(typ) {
+		if _, isPointer := typ.Underlying().(*types.Pointer); isPointer {
+			p.P("if %s == nil {", this)
+			p.In()
+			p.P("buf = append(buf, \"nil\"...)")
+			p.Out()
+			p.P("} else {")
+			p.In()
+			p.P("buf = append(buf, %s.String()...)", this)
+			p.Out()
+			p.P("}")
+			return nil
+		}
+		p.P("buf = append(buf, %s.String()...)", wrap(this))
+		return nil
+	}
+	switch ttyp := typ.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case ttyp.Info()&types.IsBoolean != 0:
+			p.P("buf = %s.AppendBool(buf, %s)", g.strconvPkg(), convert(typ, types.Bool, this))
+		case ttyp.Info()&types.IsString != 0:
+			p.P("buf = %s.AppendQuote(buf, %s)", g.strconvPkg(), convert(typ, types.String, this))
+		case ttyp.Info()&types.IsUnsigned != 0:
+			p.P("buf = %s.AppendUint(buf, %s, 10)", g.strconvPkg(), convert(typ, types.Uint64, this))
+		case ttyp.Info()&types.IsInteger != 0:
+			p.P("buf = %s.AppendInt(buf, %s, 10)", g.strconvPkg(), convert(typ, types.Int64, this))
+		case ttyp.Info()&types.IsFloat != 0:
+			bitSize := 64
+			if ttyp.Kind() == types.Float32 {
+				bitSize = 32
+			}
+			p.P("buf = %s.AppendFloat(buf, %s, 'g', -1, %d)", g.strconvPkg(), convert(typ, types.Float64, this), bitSize)
+		case ttyp.Kind() == types.UntypedNil:
+			return fmt.Errorf("unsupported type %s", g.TypeString(typ))
+		default:
+			// complex numbers and unsafe.Pointer
+			p.P("buf = %s.Appendf(buf, \"%%v\", %s)", g.fmtPkg(), this)
+		}
+		return nil
+	case *types.Interface:
+		p.P("if %s == nil {", this)
+		p.In()
+		p.P("buf = append(buf, \"nil\"...)")
+		p.Out()
+		p.P("} else {")
+		p.In()
+		p.P("buf = %s.Appendf(buf, \"%%v\", %s)", g.fmtPkg(), this)
+		p.Out()
+		p.P("}")
+		return nil
+	case *types.Chan, *types.Signature:
+		placeholder := "<chan>"
+		if _, ok := ttyp.(*types.Signature); ok {
+			placeholder = "<func>"
+		}
+		p.P("if %s == nil {", this)
+		p.In()
+		p.P("buf = append(buf, \"nil\"...)")
+		p.Out()
+		p.P("} else {")
+		p.In()
+		p.P("buf = append(buf, %q...)", placeholder)
+		p.Out()
+		p.P("}")
+		return nil
+	case *types.Pointer, *types.Struct, *types.Slice, *types.Array, *types.Map:
+		p.P("buf = %s(%s, buf)", g.appendFunc(typ), this)
+		return nil
+	}
+	// *Tuple
+	return fmt.Errorf("unsupported type %s", g.TypeString(typ))
+}
+
+// hasStringMethod returns whether the method set of the type contains a String method, which returns a string.
+func hasStringMethod(typ types.Type) bool {
+	sel := types.NewMethodSet(typ).Lookup(nil, "String")
+	if sel == nil {
+		return false
+	}
+	sig, ok := sel.Type().(*types.Signature)
+	if !ok || sig.Params().Len() != 0 || sig.Results().Len() != 1 {
+		return false
+	}
+	return types.Identical(sig.Results().At(0).Type(), types.Typ[types.String])
+}
+
+// convert returns the value converted to the basic type, unless it already is of that type.
+func convert(typ types.Type, kind types.BasicKind, this string) string {
+	if types.Identical(typ, types.Typ[kind]) {
+		return this
+	}
+	return fmt.Sprintf("%s(%s)", types.Typ[kind].Name(), this)
+}
+
+func wrap(value string) string {
+	if len(value) > 0 && (value[0] == '*' || value[0] == '&') {
+		return "(" + value + ")"
+	}
+	return value
+}
@@ -16,6 +16,25 @@
 //
 // The deriveTraverse function applies a given function to each element of a list, returning a list of results in the same order or an error.
 //   deriveTraverse(func(A) (B, error), []A) ([]B, error)
+//
+// deriveTraverse can also be applied to the values of a map, returning a map of results with the same keys or an error.
+// Since the values are visited in the order of the map iteration, it is not specified which error is returned, when more than one value fails.
+//   deriveTraverse(func(A) (B, error), map[K]A) (map[K]B, error)
+//
+// deriveTraverse can also be applied to a function that returns a value and an error,
+// in which case the error is returned, or otherwise the given function is applied to the value.
+//   deriveTraverse(func(A) (B, error), func() (A, error)) (B, error)
+//
+// The deriveTraverseParallel function applies the given function to the elements of a list concurrently,
+// where at most n elements are processed at the same time, and returns the results in the same order as the list.
+// When the function returns an error, no more elements are processed and the first error that occurred is returned,
+// after the elements, which are being processed, are finished.
+// A bound less than one is the same as processing all the elements at the same time.
+//   deriveTraverseParallel(n int, func(A) (B, error), []A) ([]B, error)
+// The context aware deriveTraverseParallel function passes a child context of ctx to the function,
+// which is canceled as soon as an error is returned.
+// When ctx is canceled, no more elements are processed and the error of the context is returned.
+//   deriveTraverseParallel(ctx context.Context, n int, func(context.Context, A) (B, error), []A) ([]B, error)
 package traverse
 
 import (
@@ -31,54 +50,117 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("traverse", "deriveTraverse", New)
 }
 
+// NewParallelPlugin creates a new traverseparallel plugin.
+// This function returns the plugin name, default prefix and a constructor for the traverseparallel code generator.
+func NewParallelPlugin() derive.Plugin {
+	return derive.NewPlugin("traverseparallel", "deriveTraverseParallel", NewParallel)
+}
+
 // New is a constructor for the traverse code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
 	return &gen{
-		TypesMap: typesMap,
-		printer:  p,
-		tuple:    deps["tuple"],
+		TypesMap:   typesMap,
+		printer:    p,
+		tuple:      deps["tuple"],
+		contextPkg: p.NewImport("context", "context"),
+		syncPkg:    p.NewImport("sync", "sync"),
 	}
 }
 
+// NewParallel is a constructor for the traverseparallel code generator,
+// which applies the function to the elements of a list concurrently.
+// This generator should be reconstructed for each package.
+func NewParallel(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
+	g := New(typesMap, p, deps).(*gen)
+	g.parallel = true
+	return g
+}
+
 type gen struct {
 	derive.TypesMap
-	printer derive.Printer
-	tuple   derive.Dependency
+	printer    derive.Printer
+	tuple      derive.Dependency
+	contextPkg derive.Import
+	syncPkg    derive.Import
+	// parallel is whether the elements of a list are processed concurrently.
+	parallel bool
 }
 
 func (g *gen) Add(name string, typs []types.Type) (string, error) {
+	if g.parallel {
+		return g.addParallel(name, typs)
+	}
 	if len(typs) != 2 {
 		return "", fmt.Errorf("%s does not have two arguments", name)
 	}
 	switch typs[1].(type) {
-	case *types.Slice:
-		_, _, err := g.sliceInOut(name, typs)
+	case *types.Slice, *types.Map, *types.Signature:
+		_, _, err := g.inOut(name, typs[0], typs[1], false)
 		if err != nil {
 			return "", err
 		}
 		return g.SetFuncName(name, typs...)
 	}
-	return "", fmt.Errorf("unsupported type %s, not a slice", typs[1])
+	return "", fmt.Errorf("unsupported type %s, not a slice, map or function", typs[1])
 }
 
-func (g *gen) sliceInOut(name string, typs []types.Type) (inTyp types.Type, outTyp types.Type, err error) {
-	sliceTyp, ok := typs[1].(*types.Slice)
-	if !ok {
-		return nil, nil, fmt.Errorf("%s, the second argument, %s, is not of type slice", name, g.TypeString(typs[1]))
+func (g *gen) addParallel(name string, typs []types.Type) (string, error) {
+	// The types are copied, so that defaulting the bound does not change the types of the caller.
+	typs = append([]types.Type(nil), typs...)
+	ctx := len(typs) > 0 && derive.IsContext(typs[0])
+	args := typs
+	if ctx {
+		args = typs[1:]
+	}
+	if len(args) != 3 {
+		return "", fmt.Errorf("%s does not have three arguments, after the optional context", name)
+	}
+	if basic, ok := args[0].Underlying().(*types.Basic); !ok || basic.Info()&types.IsInteger == 0 {
+		return "", fmt.Errorf("%s, the bound, %s, is not an integer", name, g.TypeString(args[0]))
+	}
+	args[0] = types.Default(args[0])
+	if _, ok := args[2].(*types.Slice); !ok {
+		return "", fmt.Errorf("unsupported type %s, not a slice", args[2])
+	}
+	if _, _, err := g.inOut(name, args[1], args[2], ctx); err != nil {
+		return "", err
 	}
-	sig, ok := typs[0].(*types.Signature)
+	return g.SetFuncName(name, typs...)
+}
+
+// inOut returns the input and output types of the function, which is applied to the elements of the given type.
+func (g *gen) inOut(name string, fTyp, elemsTyp types.Type, ctx bool) (inTyp types.Type, outTyp types.Type, err error) {
+	var elemTyp types.Type
+	switch ttyp := elemsTyp.(type) {
+	case *types.Slice:
+		elemTyp = ttyp.Elem()
+	case *types.Map:
+		elemTyp = ttyp.Elem()
+	case *types.Signature:
+		res := ttyp.Results()
+		if ttyp.Params().Len() != 0 || res.Len() != 2 || !derive.IsError(res.At(1).Type()) {
+			return nil, nil, fmt.Errorf("%s, the second argument, %s, is not a function, which takes no parameters and returns a value and an error", name, g.TypeString(elemsTyp))
+		}
+		elemTyp = res.At(0).Type()
+	default:
+		return nil, nil, fmt.Errorf("%s, the second argument, %s, is not of type slice, map or function", name, g.TypeString(elemsTyp))
+	}
+	sig, ok := fTyp.(*types.Signature)
 	if !ok {
-		return nil, nil, fmt.Errorf("%s, the first argument, %s, is not of type function", name, g.TypeString(typs[0]))
+		return nil, nil, fmt.Errorf("%s, the first argument, %s, is not of type function", name, g.TypeString(fTyp))
 	}
 	params := sig.Params()
-	if params.Len() != 1 {
+	if ctx {
+		if params.Len() != 2 || !derive.IsContext(params.At(0).Type()) {
+			return nil, nil, fmt.Errorf("%s, the function argument does not take a context and an element as parameters", name)
+		}
+	} else if params.Len() != 1 {
 		return nil, nil, fmt.Errorf("%s, the first argument is a function, but wanted a function with one argument", name)
 	}
-	elemTyp := sliceTyp.Elem()
-	inTyp = params.At(0).Type()
+	inTyp = params.At(params.Len() - 1).Type()
 	if !types.Identical(inTyp, elemTyp) {
-		return nil, nil, fmt.Errorf("%s the function input type and slice element type are different %s != %s",
+		return nil, nil, fmt.Errorf("%s the function input type and element type are different %s != %s",
 			name, inTyp, elemTyp)
 	}
 	res := sig.Results()
@@ -93,16 +175,23 @@ func (g *gen) sliceInOut(name string, typs []types.Type) (inTyp types.Type, outT
 }
 
 func (g *gen) Generate(typs []types.Type) error {
+	if g.parallel {
+		return g.genParallel(typs)
+	}
 	switch typs[1].(type) {
 	case *types.Slice:
 		return g.genSlice(typs)
+	case *types.Map:
+		return g.genMap(typs)
+	case *types.Signature:
+		return g.genError(typs)
 	}
-	return fmt.Errorf("unsupported type %s, not a slice or a string", typs[1])
+	return fmt.Errorf("unsupported type %s, not a slice, map or function", typs[1])
 }
 
 func (g *gen) genSlice(typs []types.Type) error {
 	name := g.GetFuncName(typs...)
-	in, out, err := g.sliceInOut(name, typs)
+	in, out, err := g.inOut(name, typs[0], typs[1], false)
 	if err != nil {
 		return err
 	}
@@ -131,3 +220,178 @@ func (g *gen) genSlice(typs []types.Type) error {
 	p.P("}")
 	return nil
 }
+
+func (g *gen) genMap(typs []types.Type) error {
+	name := g.GetFuncName(typs...)
+	in, out, err := g.inOut(name, typs[0], typs[1], false)
+	if err != nil {
+		return err
+	}
+	g.Generating(typs...)
+	p := g.printer
+	inStr := g.TypeString(in)
+	outStr := g.TypeString(out)
+	keyStr := g.TypeString(typs[1].(*types.Map).Key())
+	p.P("")
+	p.P("// %s returns a map where each value of the input map has been morphed by the input function or an error.", name)
+	p.P("func %s(f func(%s) (%s, error), m map[%s]%s) (map[%s]%s, error) {", name, inStr, outStr, keyStr, inStr, keyStr, outStr)
+	p.In()
+	p.P("out := make(map[%s]%s, len(m))", keyStr, outStr)
+	p.P("for key, elem := range m {")
+	p.In()
+	p.P("v, err := f(elem)")
+	p.P("if err != nil {")
+	p.In()
+	p.P("return nil, err")
+	p.Out()
+	p.P("}")
+	p.P("out[key] = v")
+	p.Out()
+	p.P("}")
+	p.P("return out, nil")
+	p.Out()
+	p.P("}")
+	return nil
+}
+
+func (g *gen) genError(typs []types.Type) error {
+	name := g.GetFuncName(typs...)
+	in, out, err := g.inOut(name, typs[0], typs[1], false)
+	if err != nil {
+		return err
+	}
+	g.Generating(typs...)
+	p := g.printer
+	inStr := g.TypeString(in)
+	outStr := g.TypeString(out)
+	p.P("")
+	p.P("// %s returns an error if g returns one, otherwise it returns the result of applying f to g's result.", name)
+	p.P("func %s(f func(%s) (%s, error), g func() (%s, error)) (%s, error) {", name, inStr, outStr, inStr, outStr)
+	p.In()
+	p.P("v, err := g()")
+	p.P("if err != nil {")
+	p.In()
+	p.P("var zero %s", outStr)
+	p.P("return zero, err")
+	p.Out()
+	p.P("}")
+	p.P("return f(v)")
+	p.Out()
+	p.P("}")
+	return nil
+}
+
+func (g *gen) genParallel(typs []types.Type) error {
+	name := g.GetFuncName(typs...)
+	ctx := derive.IsContext(typs[0])
+	args := typs
+	if ctx {
+		args = typs[1:]
+	}
+	in, out, err := g.inOut(name, args[1], args[2], ctx)
+	if err != nil {
+		return err
+	}
+	g.Generating(typs...)
+	p := g.printer
+	inStr := g.TypeString(in)
+	outStr := g.TypeString(out)
+	nStr := g.TypeString(args[0])
+	p.P("")
+	if ctx {
+		ctxStr := g.TypeString(typs[0])
+		p.P("// %s returns a list where each element of the input list has been morphed by the input function or an error,", name)
+		p.P("// where at most n elements are morphed concurrently, with a context that is canceled as soon as an error is returned.")
+		p.P("func %s(ctx %s, n %s, f func(%s, %s) (%s, error), list []%s) ([]%s, error) {", name, ctxStr, nStr, ctxStr, inStr, outStr, inStr, outStr)
+		p.In()
+		p.P("ctx, cancel := %s.WithCancel(ctx)", g.contextPkg())
+		p.P("defer cancel()")
+	} else {
+		p.P("// %s returns a list where each element of the input list has been morphed by the input function or an error,", name)
+		p.P("// where at most n elements are morphed concurrently.")
+		p.P("func %s(n %s, f func(%s) (%s, error), list []%s) ([]%s, error) {", name, nStr, inStr, outStr, inStr, outStr)
+		p.In()
+	}
+	// n is converted to an int once, since len(list) might not fit in a narrower integer type.
+	p.P("workers := %s", convert(args[0], "int", "n"))
+	p.P("if workers < 1 || workers > len(list) {")
+	p.In()
+	p.P("workers = len(list)")
+	p.Out()
+	p.P("}")
+	p.P("out := make([]%s, len(list))", outStr)
+	p.P("var mu %s.Mutex", g.syncPkg())
+	p.P("var err error")
+	p.P("next := 0")
+	p.P("var wg %s.WaitGroup", g.syncPkg())
+	p.P("for w := 0; w < workers; w++ {")
+	p.In()
+	p.P("wg.Add(1)")
+	p.P("go func() {")
+	p.In()
+	p.P("defer wg.Done()")
+	p.P("for {")
+	p.In()
+	p.P("mu.Lock()")
+	if ctx {
+		p.P("if err == nil && next < len(list) && ctx.Err() != nil {")
+		p.In()
+		p.P("err = ctx.Err()")
+		p.Out()
+		p.P("}")
+	}
+	p.P("if err != nil || next == len(list) {")
+	p.In()
+	p.P("mu.Unlock()")
+	p.P("return")
+	p.Out()
+	p.P("}")
+	p.P("i := next")
+	p.P("next++")
+	p.P("mu.Unlock()")
+	if ctx {
+		p.P("v, ferr := f(ctx, list[i])")
+	} else {
+		p.P("v, ferr := f(list[i])")
+	}
+	p.P("if ferr != nil {")
+	p.In()
+	p.P("mu.Lock()")
+	p.P("if err == nil {")
+	p.In()
+	p.P("err = ferr")
+	p.Out()
+	p.P("}")
+	p.P("mu.Unlock()")
+	if ctx {
+		p.P("cancel()")
+	}
+	p.P("return")
+	p.Out()
+	p.P("}")
+	p.P("out[i] = v")
+	p.Out()
+	p.P("}")
+	p.Out()
+	p.P("}()")
+	p.Out()
+	p.P("}")
+	p.P("wg.Wait()")
+	p.P("if err != nil {")
+	p.In()
+	p.P("return nil, err")
+	p.Out()
+	p.P("}")
+	p.P("return out, nil")
+	p.Out()
+	p.P("}")
+	return nil
+}
+
+// convert returns the value, which is of the given type, converted to the type with the given name, unless it is already of that type.
+func convert(typ types.Type, to string, value string) string {
+	if basic, ok := typ.(*types.Basic); ok && basic.Name() == to {
+		return value
+	}
+	return fmt.Sprintf("%s(%s)", to, value)
+}
@@ -0,0 +1,280 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package walk contains the implementation of the walk plugin, which generates the deriveWalk function.
+//
+// The deriveWalk function calls the visit function with a pointer to every value of type X, which is nested inside the root.
+//   deriveWalk(root *T, visit func(*X) error) error
+//
+// The paths from T to X are found by goderive, given the types, so that only the fields, elements and map values,
+// which can contain a value of type X, are walked.
+// The values are visited in a deterministic order:
+//	- a value of type X is visited before the values of type X that are nested inside it
+//	- fields are walked in the order in which they are declared
+//	- slices and arrays are walked in the order of their indexes
+//	- maps are walked in the order of their sorted keys, where a map value is copied, walked and then stored again
+// The walk stops as soon as visit returns an error, which is then returned by deriveWalk.
+// Map keys, interfaces, channels and functions are not walked and
+// fields with the tag derive:"-" or derive:"walk=-" are skipped.
+// Pointer graphs with cycles are not supported, since the walk would never end.
+//
+// Supported types:
+//	- named structs
+//	- arrays
+//	- slices
+//	- maps, where the keys are sortable
+//	- pointers to these types
+//	- private fields of structs in external packages (using reflect and unsafe)
+//	- instantiated generic types
+// Unsupported types:
+//	- type parameters
+package walk
+
+import (
+	"fmt"
+	"go/types"
+
+	"github.com/awalterschulze/goderive/derive"
+)
+
+// NewPlugin creates a new walk plugin.
+// This function returns the plugin name, default prefix and a constructor for the walk code generator.
+func NewPlugin() derive.Plugin {
+	return derive.NewPlugin("walk", "deriveWalk", New)
+}
+
+// New is a constructor for the walk code generator.
+// This generator should be reconstructed for each package.
+func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
+	return &gen{
+		TypesMap:   typesMap,
+		printer:    p,
+		reflectPkg: p.NewImport("reflect", "reflect"),
+		unsafePkg:  p.NewImport("unsafe", "unsafe"),
+		keys:       deps["keys"],
+		sort:       deps["sort"],
+	}
+}
+
+type gen struct {
+	derive.TypesMap
+	printer    derive.Printer
+	reflectPkg derive.Import
+	unsafePkg  derive.Import
+	keys       derive.Dependency
+	sort       derive.Dependency
+}
+
+func (g *gen) Add(name string, typs []types.Type) (string, error) {
+	if len(typs) != 2 {
+		return "", fmt.Errorf("%s does not have two arguments", name)
+	}
+	ptr, ok := typs[0].Underlying().(*types.Pointer)
+	if !ok {
+		return "", fmt.Errorf("%s does not have a pointer as its first argument, but %s", name, g.TypeString(typs[0]))
+	}
+	x, err := visited(typs[1])
+	if err != nil {
+		return "", fmt.Errorf("%s has %s as its second argument, but %v", name, g.TypeString(typs[1]), err)
+	}
+	if !g.reaches(ptr.Elem(), x) {
+		return "", fmt.Errorf("%s cannot find values of type %s inside %s", name, g.TypeString(x), g.TypeString(ptr.Elem()))
+	}
+	return g.SetFuncName(name, typs...)
+}
+
+// visited returns the type of the values, which are visited by the visit function.
+func visited(typ types.Type) (types.Type, error) {
+	sig, ok := typ.(*types.Signature)
+	if !ok {
+		return nil, fmt.Errorf("it is not a function")
+	}
+	if sig.Params().Len() != 1 || sig.Results().Len() != 1 {
+		return nil, fmt.Errorf("it does not have one parameter and one result")
+	}
+	ptr, ok := sig.Params().At(0).Type().(*types.Pointer)
+	if !ok {
+		return nil, fmt.Errorf("its parameter is not a pointer")
+	}
+	if !types.Identical(sig.Results().At(0).Type(), types.Universe.Lookup("error").Type()) {
+		return nil, fmt.Errorf("its result is not an error")
+	}
+	return ptr.Elem(), nil
+}
+
+func (g *gen) Generate(typs []types.Type) error {
+	return g.genFunc(typs[0], typs[1])
+}
+
+// call prints the call to the function, which walks the value at the address, and returns its error.
+func (g *gen) call(typ types.Type, addr string, visit types.Type) {
+	p := g.printer
+	p.P("if err := %s(%s, visit); err != nil {", g.GetFuncName(types.NewPointer(typ), visit), addr)
+	p.In()
+	p.P("return err")
+	p.Out()
+	p.P("}")
+}
+
+func (g *gen) genFunc(typ, visit types.Type) error {
+	p := g.printer
+	g.Generating(typ, visit)
+	name := g.GetFuncName(typ, visit)
+	elem := typ.Underlying().(*types.Pointer).Elem()
+	x, _ := visited(visit)
+	p.P("")
+	p.P("// %s calls visit with every value of type %s, which is nested inside this, until visit returns an error.", name, g.TypeString(x))
+	p.P("func %s%s(this %s, visit %s) error {", name, g.TypeParams(typ), g.TypeString(typ), g.TypeString(visit))
+	p.In()
+	p.P("if this == nil {")
+	p.In()
+	p.P("return nil")
+	p.Out()
+	p.P("}")
+	if types.Identical(elem, x) {
+		p.P("if err := visit(this); err != nil {")
+		p.In()
+		p.P("return err")
+		p.Out()
+		p.P("}")
+	}
+	switch ttyp := elem.Underlying().(type) {
+	case *types.Struct:
+		named, isNamed := elem.(*types.Named)
+		external := isNamed && g.TypesMap.IsExternal(named)
+		fields := derive.Fields(g.TypesMap, ttyp, external).For("walk")
+		var walked []*derive.Field
+		reflect := false
+		for _, field := range fields.Fields {
+			if g.reaches(field.Type, x) {
+				walked = append(walked, field)
+				reflect = reflect || (field.Private() && external)
+			}
+		}
+		if reflect {
+			p.P(`thisv := ` + g.reflectPkg() + `.Indirect(` + g.reflectPkg() + `.ValueOf(this))`)
+		}
+		for _, field := range walked {
+			if field.Private() && external {
+				g.call(field.Type, "&"+field.Name("thisv", g.unsafePkg), visit)
+			} else {
+				g.call(field.Type, "&"+field.Name("this", nil), visit)
+			}
+		}
+	case *types.Pointer:
+		if g.reaches(ttyp.Elem(), x) {
+			g.call(ttyp.Elem(), "*this", visit)
+		}
+	case *types.Slice:
+		if g.reaches(ttyp.Elem(), x) {
+			p.P("for i := range *this {")
+			p.In()
+			g.call(ttyp.Elem(), "&(*this)[i]", visit)
+			p.Out()
+			p.P("}")
+		}
+	case *types.Array:
+		if g.reaches(ttyp.Elem(), x) {
+			p.P("for i := range this {")
+			p.In()
+			g.call(ttyp.Elem(), "&this[i]", visit)
+			p.Out()
+			p.P("}")
+		}
+	case *types.Map:
+		if g.reaches(ttyp.Elem(), x) {
+			p.P("for _, k := range %s(%s(*this)) {", g.sort.GetFuncName(types.NewSlice(ttyp.Key())), g.keys.GetFuncName(elem))
+			p.In()
+			p.P("v := (*this)[k]")
+			g.call(ttyp.Elem(), "&v", visit)
+			p.P("(*this)[k] = v")
+			p.Out()
+			p.P("}")
+		}
+	case *types.Basic, *types.Interface, *types.Chan, *types.Signature:
+	default:
+		// *Tuple, *TypeParam
+		return fmt.Errorf("unsupported type %s", g.TypeString(elem))
+	}
+	p.P("return nil")
+	p.Out()
+	p.P("}")
+	return nil
+}
+
+// reaches returns whether a value of the type can contain a value of type x.
+// It finds all the types, which are nested inside the type, and
+// then repeatedly marks the types, which contain a marked type, starting with x, until no more types are marked.
+func (g *gen) reaches(typ, x types.Type) bool {
+	var nested []types.Type
+	var children [][]int
+	var index func(typ types.Type) int
+	index = func(typ types.Type) int {
+		for i, t := range nested {
+			if types.Identical(t, typ) {
+				return i
+			}
+		}
+		i := len(nested)
+		nested = append(nested, typ)
+		children = append(children, nil)
+		for _, child := range g.children(typ) {
+			c := index(child)
+			children[i] = append(children[i], c)
+		}
+		return i
+	}
+	index(typ)
+	marked := make([]bool, len(nested))
+	for i, t := range nested {
+		marked[i] = types.Identical(t, x)
+	}
+	for changed := true; changed; {
+		changed = false
+		for i := range nested {
+			if marked[i] {
+				continue
+			}
+			for _, c := range children[i] {
+				if marked[c] {
+					marked[i] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	return marked[0]
+}
+
+// children returns the types of the fields, elements or map values, which are walked inside a value of the type.
+func (g *gen) children(typ types.Type) []types.Type {
+	switch ttyp := typ.Underlying().(type) {
+	case *types.Struct:
+		var typs []types.Type
+		for _, field := range derive.Fields(g.TypesMap, ttyp, false).For("walk").Fields {
+			typs = append(typs, field.Type)
+		}
+		return typs
+	case *types.Pointer:
+		return []types.Type{ttyp.Elem()}
+	case *types.Slice:
+		return []types.Type{ttyp.Elem()}
+	case *types.Array:
+		return []types.Type{ttyp.Elem()}
+	case *types.Map:
+		return []types.Type{ttyp.Elem()}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package analysis
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	goderive "github.com/awalterschulze/goderive/derive/analysis"
+)
+
+// The stale derived.gen.go in the testdata does not start with the generated code header,
+// since drivers do not apply fixes to generated files, in which case derived.gen.go.golden would not be checked.
+func TestAnalyzerStaleAndMissing(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), goderive.Analyzer, "stale")
+}
+
+// The test variant only reports the functions, which are only called from its test files,
+// where the fix creates derived.gen_test.go, which cannot be expressed with a golden file.
+func TestAnalyzerTestVariant(t *testing.T) {
+	created := false
+	for _, result := range analysistest.Run(t, analysistest.TestData(), goderive.Analyzer, "tests") {
+		for _, d := range result.Action.Diagnostics {
+			for _, fix := range d.SuggestedFixes {
+				if len(fix.TextEdits) != 1 {
+					t.Fatalf("expected one edit, but got %v", fix.TextEdits)
+				}
+				edit := fix.TextEdits[0]
+				tokFile := result.Action.Package.Fset.File(edit.Pos)
+				if tokFile == nil || filepath.Base(tokFile.Name()) != "derived.gen_test.go" || tokFile.Size() != 0 || edit.End != edit.Pos {
+					t.Fatalf("expected the fix to insert the code into an empty derived.gen_test.go")
+				}
+				if !strings.Contains(string(edit.NewText), "func deriveKeys(") {
+					t.Fatalf("expected the generated derived.gen_test.go, but got:\n%s", edit.NewText)
+				}
+				created = true
+			}
+		}
+	}
+	if !created {
+		t.Fatal("expected a fix, which creates derived.gen_test.go")
+	}
+}
@@ -0,0 +1,8 @@
+package stale
+
+// deriveEqual returns whether this and that are equal.
+func deriveEqual(this, that *A) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Name == that.Name
+}
@@ -0,0 +1,14 @@
+package stale
+
+type A struct {
+	Name string
+	Age  int
+}
+
+func (this *A) Equal(that *A) bool {
+	return deriveEqual(this, that) // want "derived function deriveEqual is out of date"
+}
+
+func (this *A) Hash() uint64 {
+	return deriveHash(this) // want "derived function deriveHash is missing"
+}
@@ -0,0 +1,9 @@
+package tests
+
+type A struct {
+	Name string
+}
+
+func (this *A) Equal(that *A) bool {
+	return deriveEqual(this, that)
+}
@@ -0,0 +1,12 @@
+package tests
+
+import "testing"
+
+func TestKeys(t *testing.T) {
+	if !deriveEqual(&A{}, &A{}) {
+		t.Fatal("expected equal")
+	}
+	if len(deriveKeys(map[string]int{"a": 1})) != 1 { // want "derived function deriveKeys is missing"
+		t.Fatal("expected one key")
+	}
+}
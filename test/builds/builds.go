@@ -0,0 +1,37 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package builds tests that calls in files with build constraints are generated into files with the same constraints.
+package builds
+
+type Shared struct {
+	Name string
+}
+
+func (this *Shared) Equal(that *Shared) bool {
+	return deriveEqual(this, that)
+}
+
+// Directive and OtherDirective both get an Equal method from a directive,
+// which is the same for all builds.
+//
+//goderive:equal(method)
+type Directive struct {
+	Name string
+}
+
+//goderive:equal(method)
+type OtherDirective struct {
+	Count int
+}
@@ -0,0 +1,32 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package builds
+
+import "testing"
+
+func TestBuilds(t *testing.T) {
+	if !(&Shared{Name: "a"}).Equal(&Shared{Name: "a"}) {
+		t.Fatalf("expected equal")
+	}
+	if !(&Platform{}).Equal(&Platform{}) {
+		t.Fatalf("expected equal")
+	}
+	if !(&Directive{Name: "a"}).Equal(&Directive{Name: "a"}) {
+		t.Fatalf("expected equal")
+	}
+	if (&OtherDirective{Count: 1}).Equal(&OtherDirective{Count: 2}) {
+		t.Fatalf("expected not equal")
+	}
+}
@@ -12,11 +12,12 @@
 //  See the License for the specific language governing permissions and
 //  limitations under the License.
 
-package derive
+package builds
 
-import "github.com/kisielk/gotool"
+type Platform struct {
+	Handle uintptr
+}
 
-// ImportPaths returns the import paths to use for the given command line.
-func ImportPaths(args []string) []string {
-	return gotool.ImportPaths(args)
+func (this *Platform) Equal(that *Platform) bool {
+	return deriveEqualPlatform(this, that)
 }
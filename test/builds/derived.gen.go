@@ -0,0 +1,34 @@
+// Code generated by goderive DO NOT EDIT.
+
+package builds
+
+// Equal returns whether this and that are equal.
+func (this *Directive) Equal(that *Directive) bool {
+	return deriveEqualDirective(this, that)
+}
+
+// Equal returns whether this and that are equal.
+func (this *OtherDirective) Equal(that *OtherDirective) bool {
+	return deriveEqualOtherDirective(this, that)
+}
+
+// deriveEqual returns whether this and that are equal.
+func deriveEqual(this, that *Shared) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Name == that.Name
+}
+
+// deriveEqualDirective returns whether this and that are equal.
+func deriveEqualDirective(this, that *Directive) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Name == that.Name
+}
+
+// deriveEqualOtherDirective returns whether this and that are equal.
+func deriveEqualOtherDirective(this, that *OtherDirective) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Count == that.Count
+}
@@ -0,0 +1,21 @@
+// Code generated by goderive DO NOT EDIT.
+
+//go:build linux
+
+package builds
+
+// deriveEqualPlatform returns whether this and that are equal.
+func deriveEqualPlatform(this, that *Platform) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Fd == that.Fd
+}
+
+// deriveKeys returns the keys of the input map as a slice.
+func deriveKeys(m map[int]*Platform) []int {
+	keys := make([]int, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,12 @@
+// Code generated by goderive DO NOT EDIT.
+
+//go:build windows
+
+package builds
+
+// deriveEqualPlatform returns whether this and that are equal.
+func deriveEqualPlatform(this, that *Platform) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Handle == that.Handle
+}
@@ -0,0 +1,23 @@
+# Functions, which are the same for all builds, are generated into derived.gen.go,
+# while the others are generated into a file for each build, with its build constraint.
+if ! grep -q "func deriveEqual(" derived.gen.go || grep -q "go:build" derived.gen.go ; then
+    echo "expected deriveEqual to be generated into derived.gen.go without a build constraint"
+    exit 1
+fi
+if ! grep -q "^//go:build linux$" derived_linux.gen.go || ! grep -q "func deriveKeys(" derived_linux.gen.go ; then
+    echo "expected deriveKeys to be generated into derived_linux.gen.go with a build constraint"
+    exit 1
+fi
+if ! grep -q "^//go:build windows$" derived_windows.gen.go || ! grep -q "this.Handle == that.Handle" derived_windows.gen.go ; then
+    echo "expected deriveEqualPlatform to be generated into derived_windows.gen.go with a build constraint"
+    exit 1
+fi
+if grep -q "deriveKeys" derived_windows.gen.go ; then
+    echo "expected deriveKeys not to be generated for windows"
+    exit 1
+fi
+if ! grep -q "func (this \*Directive) Equal(" derived.gen.go || ! grep -q "func (this \*OtherDirective) Equal(" derived.gen.go ; then
+    echo "expected the Equal methods of Directive and OtherDirective to be generated into derived.gen.go"
+    exit 1
+fi
+exit 0
@@ -0,0 +1,44 @@
+cp check.gold check.go
+if goderive -check -autoname . >./stdout ; then
+    echo "expected missing derived functions to be reported"
+    rm ./stdout
+    rm ./check.go
+    exit 1
+fi
+if ! grep -q 'derived.gen.go' ./stdout || ! grep -q '^+func derive' ./stdout ; then
+    echo "expected -check to print a diff, which adds the derived functions to derived.gen.go"
+    cat ./stdout
+    rm ./stdout
+    rm ./check.go
+    exit 1
+fi
+rm ./stdout
+if [ -f ./derived.gen.go ] || ! cmp -s check.gold check.go ; then
+    echo "expected -check not to write any files"
+    rm ./derived.gen.go || true
+    rm ./check.go
+    exit 1
+fi
+if goderive -check -json -autoname . >./stdout 2>./stderr ; then
+    echo "expected missing derived functions to be reported with -json"
+    rm ./stdout ./stderr
+    rm ./check.go
+    exit 1
+fi
+if grep -v '^{' ./stdout || ! grep -q 'derived.gen.go' ./stderr || ! grep -q '^+func derive' ./stderr ; then
+    echo "expected -check -json to print only JSON lines to stdout and the diffs to stderr"
+    rm ./stdout ./stderr
+    rm ./check.go
+    exit 1
+fi
+rm ./stdout ./stderr
+goderive -autoname .
+if ! goderive -check -autoname . ; then
+    echo "expected generated files to be up to date"
+    rm ./derived.gen.go
+    rm ./check.go
+    exit 1
+fi
+rm ./derived.gen.go
+rm ./check.go
+exit 0
@@ -0,0 +1,46 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+package config
+
+import (
+	"os"
+	"sort"
+	"testing"
+)
+
+type Person struct {
+	Name string
+	Age  int
+}
+
+func TestConfigPrefix(t *testing.T) {
+	this := &Person{Name: "a", Age: 1}
+	if !genEqual(this, &Person{Name: "a", Age: 1}) {
+		t.Fatalf("expected equal")
+	}
+	keys := genKeys(map[string]int{"b": 2, "a": 1})
+	sort.Strings(keys)
+	if !genEqualStrings(keys, []string{"a", "b"}) {
+		t.Fatalf("expected keys a and b, but got %v", keys)
+	}
+}
+
+func TestConfigOutput(t *testing.T) {
+	if _, err := os.Stat("generated.gen_test.go"); err != nil {
+		t.Fatalf("expected the configured output file: %v", err)
+	}
+	if _, err := os.Stat("derived.gen_test.go"); err == nil {
+		t.Fatalf("expected no derived.gen_test.go")
+	}
+}
@@ -0,0 +1,36 @@
+// Code generated by goderive DO NOT EDIT.
+
+package config
+
+// genEqual returns whether this and that are equal.
+func genEqual(this, that *Person) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Name == that.Name &&
+			this.Age == that.Age
+}
+
+// genEqualStrings returns whether this and that are equal.
+func genEqualStrings(this, that []string) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if len(this) != len(that) {
+		return false
+	}
+	for i := 0; i < len(this); i++ {
+		if !(this[i] == that[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// genKeys returns the keys of the input map as a slice.
+func genKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,25 @@
+// Code generated by goderive DO NOT EDIT.
+
+package sub
+
+// deriveMin returns the minimum value from the list, or the default value if the list is empty.
+func deriveMin(list []int, def int) int {
+	if len(list) == 0 {
+		return def
+	}
+	m := list[0]
+	list = list[1:]
+	for i, v := range list {
+		if v < m {
+			m = list[i]
+		}
+	}
+	return m
+}
+
+// eq returns whether this and that are equal.
+func eq(this, that *Person) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Name == that.Name
+}
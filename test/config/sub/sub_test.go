@@ -0,0 +1,35 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+package sub
+
+import (
+	"os"
+	"testing"
+)
+
+type Person struct {
+	Name string
+}
+
+func TestNearestConfig(t *testing.T) {
+	if !eq(&Person{Name: "a"}, &Person{Name: "a"}) {
+		t.Fatalf("expected equal")
+	}
+	if deriveMin([]int{3, 1, 2}, 0) != 1 {
+		t.Fatalf("expected min 1")
+	}
+	if _, err := os.Stat("derived.gen_test.go"); err != nil {
+		t.Fatalf("expected the default output file: %v", err)
+	}
+}
@@ -0,0 +1,222 @@
+// Code generated by goderive DO NOT EDIT.
+
+package directives
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Equal returns whether this and that are equal.
+func (this *Person) Equal(that *Person) bool {
+	return deriveEqualPerson(this, that)
+}
+
+// Hash returns the hash of this.
+func (this *Person) Hash() uint64 {
+	return deriveHashPerson(this)
+}
+
+// DeepCopy copies this into that.
+func (this *Person) DeepCopy(that *Person) {
+	deriveDeepCopyPerson(that, this)
+}
+
+// Same returns whether this and that are equal.
+func (this *Animal) Same(that *Animal) bool {
+	return deriveEqual(this, that)
+}
+
+// GoString returns a string that reproduces this in valid go syntax.
+func (this *Plant) GoString() string {
+	return deriveGoStringPlant(this)
+}
+
+// deriveGoStringPlant returns a recursive representation of this as a valid go string.
+func deriveGoStringPlant(this *Plant) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() *directives.Plant {\n")
+	if this == nil {
+		fmt.Fprintf(buf, "return nil\n")
+	} else {
+		fmt.Fprintf(buf, "this := &directives.Plant{}\n")
+		if this.Leaves != nil {
+			fmt.Fprintf(buf, "this.Leaves = %#v\n", this.Leaves)
+		}
+		fmt.Fprintf(buf, "return this\n")
+	}
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
+// deriveDeepCopyPerson recursively copies the contents of src into dst.
+func deriveDeepCopyPerson(dst, src *Person) {
+	dst.Name = src.Name
+	dst.Age = src.Age
+	if src.Tags == nil {
+		dst.Tags = nil
+	} else {
+		if dst.Tags != nil {
+			if len(src.Tags) > len(dst.Tags) {
+				if cap(dst.Tags) >= len(src.Tags) {
+					dst.Tags = (dst.Tags)[:len(src.Tags)]
+				} else {
+					dst.Tags = make([]string, len(src.Tags))
+				}
+			} else if len(src.Tags) < len(dst.Tags) {
+				dst.Tags = (dst.Tags)[:len(src.Tags)]
+			}
+		} else {
+			dst.Tags = make([]string, len(src.Tags))
+		}
+		copy(dst.Tags, src.Tags)
+	}
+	if src.Friend == nil {
+		dst.Friend = nil
+	} else {
+		dst.Friend = new(Person)
+		src.Friend.DeepCopy(dst.Friend)
+	}
+}
+
+// deriveCompare returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare(this, that Ints) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if len(this) != len(that) {
+		if len(this) < len(that) {
+			return -1
+		}
+		return 1
+	}
+	for i := 0; i < len(this); i++ {
+		if c := deriveCompare_(this[i], that[i]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// deriveEqual returns whether this and that are equal.
+func deriveEqual(this, that *Animal) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Legs == that.Legs
+}
+
+// deriveEqualPerson returns whether this and that are equal.
+func deriveEqualPerson(this, that *Person) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Name == that.Name &&
+			this.Age == that.Age &&
+			deriveEqual_(this.Tags, that.Tags) &&
+			this.Friend.Equal(that.Friend)
+}
+
+// deriveClone returns a clone of the src parameter.
+func deriveClone(src *Plant) *Plant {
+	if src == nil {
+		return nil
+	}
+	dst := new(Plant)
+	deriveDeepCopy(dst, src)
+	return dst
+}
+
+// deriveHashPerson returns the hash of the object.
+func deriveHashPerson(object *Person) uint64 {
+	if object == nil {
+		return 0
+	}
+	h := uint64(17)
+	h = 31*h + deriveHash(object.Name)
+	h = 31*h + uint64(object.Age)
+	h = 31*h + deriveHash_(object.Tags)
+	h = 31*h + deriveHashPerson(object.Friend)
+	return h
+}
+
+// deriveDeepCopy recursively copies the contents of src into dst.
+func deriveDeepCopy(dst, src *Plant) {
+	if src.Leaves == nil {
+		dst.Leaves = nil
+	} else {
+		if dst.Leaves != nil {
+			if len(src.Leaves) > len(dst.Leaves) {
+				if cap(dst.Leaves) >= len(src.Leaves) {
+					dst.Leaves = (dst.Leaves)[:len(src.Leaves)]
+				} else {
+					dst.Leaves = make([]string, len(src.Leaves))
+				}
+			} else if len(src.Leaves) < len(dst.Leaves) {
+				dst.Leaves = (dst.Leaves)[:len(src.Leaves)]
+			}
+		} else {
+			dst.Leaves = make([]string, len(src.Leaves))
+		}
+		copy(dst.Leaves, src.Leaves)
+	}
+}
+
+// deriveCompare_ returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_(this, that int) int {
+	if this != that {
+		if this < that {
+			return -1
+		} else {
+			return 1
+		}
+	}
+	return 0
+}
+
+// deriveEqual_ returns whether this and that are equal.
+func deriveEqual_(this, that []string) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if len(this) != len(that) {
+		return false
+	}
+	for i := 0; i < len(this); i++ {
+		if !(this[i] == that[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveHash returns the hash of the object.
+func deriveHash(object string) uint64 {
+	var h uint64
+	for _, c := range object {
+		h = 31*h + uint64(c)
+	}
+	return h
+}
+
+// deriveHash_ returns the hash of the object.
+func deriveHash_(object []string) uint64 {
+	if object == nil {
+		return 0
+	}
+	h := uint64(17)
+	for i := 0; i < len(object); i++ {
+		h = 31*h + deriveHash(object[i])
+	}
+	return h
+}
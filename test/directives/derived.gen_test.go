@@ -0,0 +1,78 @@
+// Code generated by goderive DO NOT EDIT.
+
+package directives
+
+// deriveCompareInts returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompareInts(this, that Ints) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if len(this) != len(that) {
+		if len(this) < len(that) {
+			return -1
+		}
+		return 1
+	}
+	for i := 0; i < len(this); i++ {
+		if c := deriveCompare_i(this[i], that[i]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// deriveClonePlant returns a clone of the src parameter.
+func deriveClonePlant(src *Plant) *Plant {
+	if src == nil {
+		return nil
+	}
+	dst := new(Plant)
+	deriveDeepCopy_(dst, src)
+	return dst
+}
+
+// deriveDeepCopy_ recursively copies the contents of src into dst.
+func deriveDeepCopy_(dst, src *Plant) {
+	if src.Leaves == nil {
+		dst.Leaves = nil
+	} else {
+		if dst.Leaves != nil {
+			if len(src.Leaves) > len(dst.Leaves) {
+				if cap(dst.Leaves) >= len(src.Leaves) {
+					dst.Leaves = (dst.Leaves)[:len(src.Leaves)]
+				} else {
+					dst.Leaves = make([]string, len(src.Leaves))
+				}
+			} else if len(src.Leaves) < len(dst.Leaves) {
+				dst.Leaves = (dst.Leaves)[:len(src.Leaves)]
+			}
+		} else {
+			dst.Leaves = make([]string, len(src.Leaves))
+		}
+		copy(dst.Leaves, src.Leaves)
+	}
+}
+
+// deriveCompare_i returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_i(this, that int) int {
+	if this != that {
+		if this < that {
+			return -1
+		} else {
+			return 1
+		}
+	}
+	return 0
+}
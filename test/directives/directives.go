@@ -0,0 +1,31 @@
+package directives
+
+// Person is compared, hashed and copied with methods, that are derived by goderive.
+//
+//goderive:equal(method),hash(method),deepcopy(method)
+type Person struct {
+	Name   string
+	Age    int
+	Tags   []string
+	Friend *Person
+}
+
+//goderive:compare
+type Ints []int
+
+type (
+	//goderive:equal(method=Same)
+	Animal struct {
+		Legs int
+	}
+
+	//goderive:clone,gostring(method)
+	Plant struct {
+		Leaves []string
+	}
+)
+
+// sameLegs calls the same function, that the Same method of Animal calls.
+func sameLegs(this, that *Animal) bool {
+	return deriveEqual(this, that)
+}
@@ -0,0 +1,49 @@
+package directives
+
+import (
+	"fmt"
+	"testing"
+)
+
+type equaler interface {
+	Equal(that *Person) bool
+}
+
+func TestDirectiveMethods(t *testing.T) {
+	var _ equaler = &Person{}
+	this := &Person{Name: "Ana", Age: 32, Tags: []string{"a"}, Friend: &Person{Name: "Bo"}}
+	that := &Person{}
+	this.DeepCopy(that)
+	if !this.Equal(that) {
+		t.Fatalf("expected the copy %#v to be equal to %#v", that, this)
+	}
+	if this.Hash() != that.Hash() {
+		t.Fatalf("expected equal values to have the same hash")
+	}
+	that.Friend.Name = "Cy"
+	if this.Equal(that) || this.Friend.Name != "Bo" {
+		t.Fatalf("expected a deep copy")
+	}
+}
+
+func TestDirectiveFunctions(t *testing.T) {
+	if deriveCompareInts(Ints{1, 2}, Ints{1, 3}) >= 0 {
+		t.Fatalf("expected 1, 2 to be less than 1, 3")
+	}
+	plant := &Plant{Leaves: []string{"green"}}
+	if got := fmt.Sprintf("%#v", plant); got != plant.GoString() {
+		t.Fatalf("expected %%#v to use the derived GoString method, but got %s", got)
+	}
+	clone := deriveClonePlant(plant)
+	clone.Leaves[0] = "brown"
+	if plant.Leaves[0] != "green" {
+		t.Fatalf("expected a clone")
+	}
+}
+
+func TestDirectiveReusesCalledFunction(t *testing.T) {
+	this, that := &Animal{Legs: 4}, &Animal{Legs: 4}
+	if !this.Same(that) || !sameLegs(this, that) {
+		t.Fatalf("expected the animals to be the same")
+	}
+}
@@ -0,0 +1,26 @@
+# The method of a directive calls the function, which is named after the type.
+if ! grep -q "return deriveEqualPerson(this, that)" derived.gen.go ; then
+    echo "expected the Equal method of Person to call deriveEqualPerson"
+    exit 1
+fi
+# A directive reuses the function, which is already called for the same type.
+if grep -q "deriveEqualAnimal" derived.gen.go ; then
+    echo "expected the Same method of Animal to reuse deriveEqual"
+    exit 1
+fi
+# An unknown plugin is an error.
+cp invalid/invalid.gold invalid/invalid.go
+if goderive -json ./invalid > invalid.out 2>&1 ; then
+    echo "expected an invalid directive error"
+    rm -f invalid/derived.gen.go invalid/invalid.go invalid.out
+    exit 1
+fi
+rm -f invalid/invalid.go
+if ! grep -q "invalid-directive" invalid.out ; then
+    echo "expected an invalid-directive diagnostic, but got:"
+    cat invalid.out
+    rm invalid.out
+    exit 1
+fi
+rm invalid.out
+exit 0
@@ -0,0 +1,156 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Command goderive-plugin-changed is an external plugin, which generates the deriveChanged function.
+//
+// The deriveChanged function returns the comma separated names of the fields of a struct, which are not equal.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/awalterschulze/goderive/derive"
+)
+
+type plugin struct {
+	in    *bufio.Scanner
+	out   *json.Encoder
+	types map[int]derive.PluginType
+}
+
+func main() {
+	p := &plugin{
+		in:    bufio.NewScanner(os.Stdin),
+		out:   json.NewEncoder(os.Stdout),
+		types: make(map[int]derive.PluginType),
+	}
+	p.in.Buffer(nil, 64*1024*1024)
+	for {
+		req, ok := p.receive()
+		if !ok {
+			return
+		}
+		resp, err := p.handle(req)
+		if err != nil {
+			resp = derive.PluginMessage{Error: err.Error()}
+		}
+		if err := p.out.Encode(resp); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
+
+func (p *plugin) receive() (derive.PluginMessage, bool) {
+	var msg derive.PluginMessage
+	if !p.in.Scan() {
+		return msg, false
+	}
+	if err := json.Unmarshal(p.in.Bytes(), &msg); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	for _, t := range msg.TypeTable {
+		p.types[t.ID] = t
+	}
+	return msg, true
+}
+
+// call sends a request to goderive and returns its result.
+func (p *plugin) call(req derive.PluginMessage) (string, error) {
+	if err := p.out.Encode(req); err != nil {
+		return "", err
+	}
+	resp, ok := p.receive()
+	if !ok {
+		return "", fmt.Errorf("goderive did not respond to %s", req.Method)
+	}
+	if len(resp.Error) > 0 {
+		return "", fmt.Errorf("%s", resp.Error)
+	}
+	return resp.Result, nil
+}
+
+// structOf returns the struct, that the pointer type points to.
+func (p *plugin) structOf(id int) (derive.PluginType, error) {
+	ptr := p.types[id]
+	if ptr.Kind != "pointer" {
+		return derive.PluginType{}, fmt.Errorf("%s is not a pointer", ptr.String)
+	}
+	s := p.types[ptr.Elem]
+	if s.Kind == "named" {
+		s = p.types[s.Underlying]
+	}
+	if s.Kind != "struct" {
+		return derive.PluginType{}, fmt.Errorf("%s is not a pointer to a struct", ptr.String)
+	}
+	return s, nil
+}
+
+func (p *plugin) handle(req derive.PluginMessage) (derive.PluginMessage, error) {
+	switch req.Method {
+	case "describe":
+		return derive.PluginMessage{Name: "changed", Prefix: "deriveChanged"}, nil
+	case "add":
+		if len(req.Types) != 2 || req.Types[0] != req.Types[1] {
+			return derive.PluginMessage{}, fmt.Errorf("%s does not have two arguments of the same type", req.Name)
+		}
+		if _, err := p.structOf(req.Types[0]); err != nil {
+			return derive.PluginMessage{}, err
+		}
+		name, err := p.call(derive.PluginMessage{Method: "setFuncName", Name: req.Name, Types: req.Types[:1]})
+		return derive.PluginMessage{Result: name}, err
+	case "generate":
+		code, err := p.generate(req.Types[0])
+		return derive.PluginMessage{Code: code}, err
+	}
+	return derive.PluginMessage{}, fmt.Errorf("unknown method %q", req.Method)
+}
+
+func (p *plugin) generate(typ int) (string, error) {
+	s, err := p.structOf(typ)
+	if err != nil {
+		return "", err
+	}
+	name, err := p.call(derive.PluginMessage{Method: "getFuncName", Types: []int{typ}})
+	if err != nil {
+		return "", err
+	}
+	typeStr, err := p.call(derive.PluginMessage{Method: "typeString", Types: []int{typ}})
+	if err != nil {
+		return "", err
+	}
+	stringsPkg, err := p.call(derive.PluginMessage{Method: "import", Name: "strings", Path: "strings"})
+	if err != nil {
+		return "", err
+	}
+	code := fmt.Sprintf("// %s returns the comma separated names of the fields, which are not equal.\n", name)
+	code += fmt.Sprintf("func %s(this, that %s) string {\n", name, typeStr)
+	code += "\tvar changed []string\n"
+	for _, field := range s.Fields {
+		equal, err := p.call(derive.PluginMessage{Method: "dependency", Plugin: "equal", Types: []int{field.Type, field.Type}})
+		if err != nil {
+			return "", err
+		}
+		code += fmt.Sprintf("\tif !%s(this.%s, that.%s) {\n", equal, field.Name, field.Name)
+		code += fmt.Sprintf("\t\tchanged = append(changed, %q)\n", field.Name)
+		code += "\t}\n"
+	}
+	code += fmt.Sprintf("\treturn %s.Join(changed, \",\")\n", stringsPkg)
+	code += "}\n"
+	return code, nil
+}
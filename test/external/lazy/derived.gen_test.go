@@ -0,0 +1,11 @@
+// Code generated by goderive DO NOT EDIT.
+
+package lazy
+
+// deriveEqual returns whether this and that are equal.
+func deriveEqual(this, that *Person) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Name == that.Name &&
+			this.Age == that.Age
+}
@@ -0,0 +1,29 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+// Package lazy only calls the plugins that are included in goderive,
+// so the broken plugin on the PATH is never started.
+package lazy
+
+import "testing"
+
+type Person struct {
+	Name string
+	Age  int
+}
+
+func TestEqual(t *testing.T) {
+	if !deriveEqual(&Person{Name: "a", Age: 1}, &Person{Name: "a", Age: 1}) {
+		t.Fatal("expected the same people to be equal")
+	}
+}
@@ -0,0 +1,48 @@
+// Code generated by goderive DO NOT EDIT.
+
+package path
+
+import (
+	"strings"
+)
+
+// deriveChanged returns the comma separated names of the fields, which are not equal.
+func deriveChanged(this, that *Person) string {
+	var changed []string
+	if !deriveEqual(this.Name, that.Name) {
+		changed = append(changed, "Name")
+	}
+	if !deriveEqual_(this.Age, that.Age) {
+		changed = append(changed, "Age")
+	}
+	if !deriveEqual_1(this.Friends, that.Friends) {
+		changed = append(changed, "Friends")
+	}
+	return strings.Join(changed, ",")
+}
+
+// deriveEqual returns whether this and that are equal.
+func deriveEqual(this, that string) bool {
+	return this == that
+}
+
+// deriveEqual_ returns whether this and that are equal.
+func deriveEqual_(this, that int) bool {
+	return this == that
+}
+
+// deriveEqual_1 returns whether this and that are equal.
+func deriveEqual_1(this, that []string) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if len(this) != len(that) {
+		return false
+	}
+	for i := 0; i < len(this); i++ {
+		if !(this[i] == that[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,31 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package path
+
+import "testing"
+
+type Person struct {
+	Name    string
+	Age     int
+	Friends []string
+}
+
+func TestChanged(t *testing.T) {
+	this := &Person{Name: "a", Age: 1, Friends: []string{"b"}}
+	that := &Person{Name: "a", Age: 2, Friends: []string{"c"}}
+	if changed := deriveChanged(this, that); changed != "Age,Friends" {
+		t.Fatalf("expected Age and Friends to have changed, but got %q", changed)
+	}
+}
@@ -0,0 +1,15 @@
+cp jsonerror.gold jsonerror.go
+if out=$(goderive -json .) ; then
+    echo "expected conflicting function name error"
+    rm ./derived.gen.go
+    rm ./jsonerror.go
+    exit 1
+fi
+rm ./jsonerror.go
+case "$out" in
+    *'jsonerror.go","line":16,"column":9,"plugin":"equal","code":"name-conflict","severity":"error"'*)
+        exit 0
+        ;;
+esac
+echo "expected a json diagnostic with the position of the conflicting call, but got: $out"
+exit 1
@@ -0,0 +1,57 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package test
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBatchSize(t *testing.T) {
+	c := make(chan int)
+	go func() {
+		for i := 0; i < 7; i++ {
+			c <- i
+		}
+		close(c)
+	}()
+	var got [][]int
+	for batch := range deriveBatch(3, time.Duration(0), c) {
+		got = append(got, batch)
+	}
+	want := [][]int{{0, 1, 2}, {3, 4, 5}, {6}}
+	if !deriveEqualFanOut(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestBatchTimeout(t *testing.T) {
+	c := make(chan int)
+	batches := deriveBatch(100, 10*time.Millisecond, c)
+	c <- 1
+	c <- 2
+	select {
+	case got := <-batches:
+		if want := []int{1, 2}; !deriveEqualSliceOfint(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout did not send the batch")
+	}
+	close(c)
+	if got, ok := <-batches; ok {
+		t.Fatalf("got %v, want closed channel", got)
+	}
+}
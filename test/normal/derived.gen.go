@@ -10,67 +10,22 @@ import (
 	"math"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
-	"sync"
 	"time"
 	"unsafe"
-	"vendortest"
 )
 
-// deriveTakeWhile returns the prefix of the list, where each item matches the predicate.
-func deriveTakeWhile(predicate func(int) bool, list []int) []int {
-	out := make([]int, 0, len(list))
-	for i, elem := range list {
-		if !predicate(elem) {
-			break
-		}
-		out = append(out, list[i])
-	}
-	return out
-}
-
-// deriveIntersectSetOfInt64s returns the intersection of the two maps' keys.
-func deriveIntersectSetOfInt64s(this, that map[int64]struct{}) map[int64]struct{} {
-	intersect := make(map[int64]struct{}, deriveMinInt(len(this), len(that)))
-	for k := range this {
-		if _, ok := that[k]; ok {
-			intersect[k] = struct{}{}
-		}
-	}
-	return intersect
-}
-
-// deriveIntersectOfInt64s returns the intersection of the two lists' values
-// It assumes that the first list only contains unique items.
-func deriveIntersectOfInt64s(this, that []int64) []int64 {
-	intersect := make([]int64, 0, deriveMinInt(len(this), len(that)))
-	for i, v := range this {
-		if deriveContainsInt64s(that, v) {
-			intersect = append(intersect, this[i])
-		}
-	}
-	return intersect
-}
-
-// deriveTraverse returns a list where each element of the input list has been morphed by the input function or an error.
-func deriveTraverse(f func(string) (int, error), list []string) ([]int, error) {
-	out := make([]int, len(list))
-	var err error
-	for i, elem := range list {
-		out[i], err = f(elem)
-		if err != nil {
-			return nil, err
-		}
-	}
-	return out, nil
+// deriveDeepCopyGraphPtrToDoublyLinked recursively copies the contents of src into dst,
+// where pointers, which are shared or cyclic in src, are also shared or cyclic in dst.
+func deriveDeepCopyGraphPtrToDoublyLinked(dst, src *DoublyLinked) {
+	deriveDeepCopyGraph_(dst, src, map[interface{}]interface{}{src: dst})
 }
 
-// derivePipeline composes f and g into a concurrent pipeline.
-func derivePipeline(f func(lines []string) <-chan string, g func(line string) <-chan int) func([]string) <-chan int {
-	return func(a []string) <-chan int {
-		b := f(a)
-		return deriveJoinChannels(deriveFmapChanChan(g, b))
-	}
+// deriveEqualGraphPtrToDoublyLinked returns whether this and that are equal,
+// where pointers, which are compared again on a cycle, are assumed to be equal.
+func deriveEqualGraphPtrToDoublyLinked(this, that *DoublyLinked) bool {
+	return deriveEqualGraph_(this, that, make(map[[2]interface{}]bool))
 }
 
 // deriveGoStringEmpty returns a recursive representation of this as a valid go string.
@@ -484,13 +439,13 @@ func deriveGoStringPtrTo(this *PtrTo) string {
 			fmt.Fprintf(buf, "this.Basic = func (v int) *int { return &v }(%#v)\n", *this.Basic)
 		}
 		if this.Slice != nil {
-			fmt.Fprintf(buf, "this.Slice = %s\n", deriveGoStringIntPtrSlice(this.Slice))
+			fmt.Fprintf(buf, "this.Slice = %s\n", deriveGoString_39(this.Slice))
 		}
 		if this.Array != nil {
-			fmt.Fprintf(buf, "this.Array = %s\n", deriveGoString_39(this.Array))
+			fmt.Fprintf(buf, "this.Array = %s\n", deriveGoString_40(this.Array))
 		}
 		if this.Map != nil {
-			fmt.Fprintf(buf, "this.Map = %s\n", deriveGoStringIntPtrMap(this.Map))
+			fmt.Fprintf(buf, "this.Map = %s\n", deriveGoString_41(this.Map))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -526,10 +481,10 @@ func deriveGoStringStructs(this *Structs) string {
 			fmt.Fprintf(buf, "this.PtrToStruct = %s\n", deriveGoStringName(this.PtrToStruct))
 		}
 		if this.SliceOfStructs != nil {
-			fmt.Fprintf(buf, "this.SliceOfStructs = %s\n", deriveGoString_40(this.SliceOfStructs))
+			fmt.Fprintf(buf, "this.SliceOfStructs = %s\n", deriveGoString_42(this.SliceOfStructs))
 		}
 		if this.SliceToPtrOfStruct != nil {
-			fmt.Fprintf(buf, "this.SliceToPtrOfStruct = %s\n", deriveGoString_41(this.SliceToPtrOfStruct))
+			fmt.Fprintf(buf, "this.SliceToPtrOfStruct = %s\n", deriveGoString_43(this.SliceToPtrOfStruct))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -546,19 +501,19 @@ func deriveGoStringMapWithStructs(this *MapWithStructs) string {
 	} else {
 		fmt.Fprintf(buf, "this := &test.MapWithStructs{}\n")
 		if this.NameToString != nil {
-			fmt.Fprintf(buf, "this.NameToString = %s\n", deriveGoString_42(this.NameToString))
+			fmt.Fprintf(buf, "this.NameToString = %s\n", deriveGoString_44(this.NameToString))
 		}
 		if this.StringToName != nil {
-			fmt.Fprintf(buf, "this.StringToName = %s\n", deriveGoString_43(this.StringToName))
+			fmt.Fprintf(buf, "this.StringToName = %s\n", deriveGoString_45(this.StringToName))
 		}
 		if this.StringToPtrToName != nil {
-			fmt.Fprintf(buf, "this.StringToPtrToName = %s\n", deriveGoString_44(this.StringToPtrToName))
+			fmt.Fprintf(buf, "this.StringToPtrToName = %s\n", deriveGoString_46(this.StringToPtrToName))
 		}
 		if this.StringToSliceOfName != nil {
-			fmt.Fprintf(buf, "this.StringToSliceOfName = %s\n", deriveGoString_45(this.StringToSliceOfName))
+			fmt.Fprintf(buf, "this.StringToSliceOfName = %s\n", deriveGoString_47(this.StringToSliceOfName))
 		}
 		if this.StringToSliceOfPtrToName != nil {
-			fmt.Fprintf(buf, "this.StringToSliceOfPtrToName = %s\n", deriveGoString_46(this.StringToSliceOfPtrToName))
+			fmt.Fprintf(buf, "this.StringToSliceOfPtrToName = %s\n", deriveGoString_48(this.StringToSliceOfPtrToName))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -578,7 +533,7 @@ func deriveGoStringRecursiveType(this *RecursiveType) string {
 			fmt.Fprintf(buf, "this.Bytes = %#v\n", this.Bytes)
 		}
 		if this.N != nil {
-			fmt.Fprintf(buf, "this.N = %s\n", deriveGoString_47(this.N))
+			fmt.Fprintf(buf, "this.N = %s\n", deriveGoString_49(this.N))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -631,7 +586,7 @@ func deriveGoStringStructWithStructFieldWithoutEqualMethod(this *StructWithStruc
 	} else {
 		fmt.Fprintf(buf, "this := &test.StructWithStructFieldWithoutEqualMethod{}\n")
 		if this.A != nil {
-			fmt.Fprintf(buf, "this.A = %s\n", deriveGoString_48(this.A))
+			fmt.Fprintf(buf, "this.A = %s\n", deriveGoString_50(this.A))
 		}
 		fmt.Fprintf(buf, "this.B = %s\n", deriveGoString_St(this.B))
 		fmt.Fprintf(buf, "return this\n")
@@ -649,7 +604,7 @@ func deriveGoStringStructWithStructWithFromAnotherPackage(this *StructWithStruct
 	} else {
 		fmt.Fprintf(buf, "this := &test.StructWithStructWithFromAnotherPackage{}\n")
 		if this.A != nil {
-			fmt.Fprintf(buf, "this.A = %s\n", deriveGoString_49(this.A))
+			fmt.Fprintf(buf, "this.A = %s\n", deriveGoString_51(this.A))
 		}
 		fmt.Fprintf(buf, "this.B = %s\n", deriveGoString_Str(this.B))
 		fmt.Fprintf(buf, "return this\n")
@@ -668,21 +623,21 @@ func deriveGoStringEnums(this *Enums) string {
 		fmt.Fprintf(buf, "this := &test.Enums{}\n")
 		fmt.Fprintf(buf, "this.Enum = %#v\n", this.Enum)
 		if this.PtrToEnum != nil {
-			fmt.Fprintf(buf, "this.PtrToEnum = %s\n", deriveGoString_50(this.PtrToEnum))
+			fmt.Fprintf(buf, "this.PtrToEnum = %s\n", deriveGoString_52(this.PtrToEnum))
 		}
 		if this.SliceToEnum != nil {
-			fmt.Fprintf(buf, "this.SliceToEnum = %s\n", deriveGoString_51(this.SliceToEnum))
+			fmt.Fprintf(buf, "this.SliceToEnum = %s\n", deriveGoString_53(this.SliceToEnum))
 		}
 		if this.SliceToPtrToEnum != nil {
-			fmt.Fprintf(buf, "this.SliceToPtrToEnum = %s\n", deriveGoString_52(this.SliceToPtrToEnum))
+			fmt.Fprintf(buf, "this.SliceToPtrToEnum = %s\n", deriveGoString_54(this.SliceToPtrToEnum))
 		}
 		if this.MapToEnum != nil {
-			fmt.Fprintf(buf, "this.MapToEnum = %s\n", deriveGoString_53(this.MapToEnum))
+			fmt.Fprintf(buf, "this.MapToEnum = %s\n", deriveGoString_55(this.MapToEnum))
 		}
 		if this.EnumToMap != nil {
-			fmt.Fprintf(buf, "this.EnumToMap = %s\n", deriveGoString_54(this.EnumToMap))
+			fmt.Fprintf(buf, "this.EnumToMap = %s\n", deriveGoString_56(this.EnumToMap))
 		}
-		fmt.Fprintf(buf, "this.ArrayEnum = %s\n", deriveGoString_55(this.ArrayEnum))
+		fmt.Fprintf(buf, "this.ArrayEnum = %s\n", deriveGoString_57(this.ArrayEnum))
 		fmt.Fprintf(buf, "return this\n")
 	}
 	fmt.Fprintf(buf, "}()\n")
@@ -701,10 +656,10 @@ func deriveGoStringNamedTypes(this *NamedTypes) string {
 			fmt.Fprintf(buf, "this.Slice = %#v\n", this.Slice)
 		}
 		if this.PtrToSlice != nil {
-			fmt.Fprintf(buf, "this.PtrToSlice = %s\n", deriveGoString_56(this.PtrToSlice))
+			fmt.Fprintf(buf, "this.PtrToSlice = %s\n", deriveGoString_58(this.PtrToSlice))
 		}
 		if this.SliceToSlice != nil {
-			fmt.Fprintf(buf, "this.SliceToSlice = %s\n", deriveGoString_57(this.SliceToSlice))
+			fmt.Fprintf(buf, "this.SliceToSlice = %s\n", deriveGoString_59(this.SliceToSlice))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -722,16 +677,16 @@ func deriveGoStringDuration(this *Duration) string {
 		fmt.Fprintf(buf, "this := &test.Duration{}\n")
 		fmt.Fprintf(buf, "this.D = %#v\n", this.D)
 		if this.P != nil {
-			fmt.Fprintf(buf, "this.P = %s\n", deriveGoString_58(this.P))
+			fmt.Fprintf(buf, "this.P = %s\n", deriveGoString_60(this.P))
 		}
 		if this.Ds != nil {
-			fmt.Fprintf(buf, "this.Ds = %s\n", deriveGoString_59(this.Ds))
+			fmt.Fprintf(buf, "this.Ds = %s\n", deriveGoString_61(this.Ds))
 		}
 		if this.DPs != nil {
-			fmt.Fprintf(buf, "this.DPs = %s\n", deriveGoString_60(this.DPs))
+			fmt.Fprintf(buf, "this.DPs = %s\n", deriveGoString_62(this.DPs))
 		}
 		if this.MD != nil {
-			fmt.Fprintf(buf, "this.MD = %s\n", deriveGoString_61(this.MD))
+			fmt.Fprintf(buf, "this.MD = %s\n", deriveGoString_63(this.MD))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -748,7 +703,7 @@ func deriveGoStringNickname(this *Nickname) string {
 	} else {
 		fmt.Fprintf(buf, "this := &test.Nickname{}\n")
 		if this.Alias != nil {
-			fmt.Fprintf(buf, "this.Alias = %s\n", deriveGoString_62(this.Alias))
+			fmt.Fprintf(buf, "this.Alias = %s\n", deriveGoString_64(this.Alias))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -771,98 +726,19 @@ func deriveGoStringPrivateEmbedded(this *PrivateEmbedded) string {
 	return buf.String()
 }
 
-// deriveGoStringIntSlices returns a recursive representation of this as a valid go string.
-func deriveGoStringIntSlices(this []int) string {
-	buf := bytes.NewBuffer(nil)
-	fmt.Fprintf(buf, "func() []int {\n")
-	if this == nil {
-		fmt.Fprintf(buf, "return nil\n")
-	} else {
-		fmt.Fprintf(buf, "return %#v\n", this)
-	}
-	fmt.Fprintf(buf, "}()\n")
-	return buf.String()
-}
-
-// deriveGoStringIntArray returns a recursive representation of this as a valid go string.
-func deriveGoStringIntArray(this [10]int) string {
-	buf := bytes.NewBuffer(nil)
-	fmt.Fprintf(buf, "func() [10]int {\n")
-	fmt.Fprintf(buf, "return %#v\n", this)
-	fmt.Fprintf(buf, "}()\n")
-	return buf.String()
-}
-
-// deriveGoStringMapOfIntToInt returns a recursive representation of this as a valid go string.
-func deriveGoStringMapOfIntToInt(this map[int]int) string {
-	buf := bytes.NewBuffer(nil)
-	fmt.Fprintf(buf, "func() map[int]int {\n")
-	if this == nil {
-		fmt.Fprintf(buf, "return nil\n")
-	} else {
-		fmt.Fprintf(buf, "return %#v\n", this)
-	}
-	fmt.Fprintf(buf, "}()\n")
-	return buf.String()
-}
-
-// deriveGoStringIntPtr returns a recursive representation of this as a valid go string.
-func deriveGoStringIntPtr(this *int) string {
-	buf := bytes.NewBuffer(nil)
-	fmt.Fprintf(buf, "func() *int {\n")
-	if this == nil {
-		fmt.Fprintf(buf, "return nil\n")
-	} else {
-		fmt.Fprintf(buf, "this := new(int)\n")
-		fmt.Fprintf(buf, "*this = %#v\n", *this)
-		fmt.Fprintf(buf, "return this\n")
-	}
-	fmt.Fprintf(buf, "}()\n")
-	return buf.String()
-}
-
-// deriveGoStringIntPtrSlice returns a recursive representation of this as a valid go string.
-func deriveGoStringIntPtrSlice(this *[]int) string {
-	buf := bytes.NewBuffer(nil)
-	fmt.Fprintf(buf, "func() *[]int {\n")
-	if this == nil {
-		fmt.Fprintf(buf, "return nil\n")
-	} else {
-		fmt.Fprintf(buf, "this := new([]int)\n")
-		if *this != nil {
-			fmt.Fprintf(buf, "*this = %#v\n", *this)
-		}
-		fmt.Fprintf(buf, "return this\n")
-	}
-	fmt.Fprintf(buf, "}()\n")
-	return buf.String()
-}
-
-// deriveGoStringIntPtrArray returns a recursive representation of this as a valid go string.
-func deriveGoStringIntPtrArray(this *[10]int) string {
-	buf := bytes.NewBuffer(nil)
-	fmt.Fprintf(buf, "func() *[10]int {\n")
-	if this == nil {
-		fmt.Fprintf(buf, "return nil\n")
-	} else {
-		fmt.Fprintf(buf, "this := new([10]int)\n")
-		fmt.Fprintf(buf, "*this = %#v\n", *this)
-		fmt.Fprintf(buf, "return this\n")
-	}
-	fmt.Fprintf(buf, "}()\n")
-	return buf.String()
-}
-
-// deriveGoStringIntPtrMap returns a recursive representation of this as a valid go string.
-func deriveGoStringIntPtrMap(this *map[int]int) string {
+// deriveGoStringTaggedFields returns a recursive representation of this as a valid go string.
+func deriveGoStringTaggedFields(this *TaggedFields) string {
 	buf := bytes.NewBuffer(nil)
-	fmt.Fprintf(buf, "func() *map[int]int {\n")
+	fmt.Fprintf(buf, "func() *test.TaggedFields {\n")
 	if this == nil {
 		fmt.Fprintf(buf, "return nil\n")
 	} else {
-		fmt.Fprintf(buf, "this := new(map[int]int)\n")
-		if *this != nil {
-			fmt.Fprintf(buf, "*this = %#v\n", *this)
+		fmt.Fprintf(buf, "this := &test.TaggedFields{}\n")
+		fmt.Fprintf(buf, "this.Name = %#v\n", this.Name)
+		fmt.Fprintf(buf, "this.Version = %s\n", deriveGoString_T(this.Version))
+		fmt.Fprintf(buf, "this.Updated = %#v\n", this.Updated)
+		if this.Parent != nil {
+			fmt.Fprintf(buf, "this.Parent = %s\n", deriveGoStringTaggedFields(this.Parent))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -870,35 +746,6 @@ func deriveGoStringIntPtrMap(this *map[int]int) string {
 	return buf.String()
 }
 
-// deriveGoStringNoPointerStruct returns a recursive representation of this as a valid go string.
-func deriveGoStringNoPointerStruct(this BuiltInTypes) string {
-	buf := bytes.NewBuffer(nil)
-	fmt.Fprintf(buf, "func() test.BuiltInTypes {\n")
-	fmt.Fprintf(buf, "this := &test.BuiltInTypes{}\n")
-	fmt.Fprintf(buf, "this.Bool = %#v\n", this.Bool)
-	fmt.Fprintf(buf, "this.Byte = %#v\n", this.Byte)
-	fmt.Fprintf(buf, "this.Complex128 = %#v\n", this.Complex128)
-	fmt.Fprintf(buf, "this.Complex64 = %#v\n", this.Complex64)
-	fmt.Fprintf(buf, "this.Float64 = %#v\n", this.Float64)
-	fmt.Fprintf(buf, "this.Float32 = %#v\n", this.Float32)
-	fmt.Fprintf(buf, "this.Int = %#v\n", this.Int)
-	fmt.Fprintf(buf, "this.Int16 = %#v\n", this.Int16)
-	fmt.Fprintf(buf, "this.Int32 = %#v\n", this.Int32)
-	fmt.Fprintf(buf, "this.Int64 = %#v\n", this.Int64)
-	fmt.Fprintf(buf, "this.Int8 = %#v\n", this.Int8)
-	fmt.Fprintf(buf, "this.Rune = %#v\n", this.Rune)
-	fmt.Fprintf(buf, "this.String = %#v\n", this.String)
-	fmt.Fprintf(buf, "this.Uint = %#v\n", this.Uint)
-	fmt.Fprintf(buf, "this.Uint16 = %#v\n", this.Uint16)
-	fmt.Fprintf(buf, "this.Uint32 = %#v\n", this.Uint32)
-	fmt.Fprintf(buf, "this.Uint64 = %#v\n", this.Uint64)
-	fmt.Fprintf(buf, "this.Uint8 = %#v\n", this.Uint8)
-	fmt.Fprintf(buf, "this.UintPtr = %#v\n", this.UintPtr)
-	fmt.Fprintf(buf, "return *this\n")
-	fmt.Fprintf(buf, "}()\n")
-	return buf.String()
-}
-
 // deriveDeepCopyPtrToEmpty recursively copies the contents of src into dst.
 func deriveDeepCopyPtrToEmpty(dst, src *Empty) {
 }
@@ -2434,109 +2281,39 @@ func deriveDeepCopyPtrToPrivateEmbedded(dst, src *PrivateEmbedded) {
 	dst.privateStruct = *field
 }
 
-// deriveContainsInt64s returns whether the item is contained in the list.
-func deriveContainsInt64s(list []int64, item int64) bool {
-	for _, v := range list {
-		if v == item {
-			return true
-		}
-	}
-	return false
-}
-
-// deriveContainsStruct returns whether the item is contained in the list.
-func deriveContainsStruct(list []*BuiltInTypes, item *BuiltInTypes) bool {
-	for _, v := range list {
-		if deriveEqualPtrToBuiltInTypes(v, item) {
-			return true
-		}
-	}
-	return false
-}
-
-// deriveUncurryMarshal combines a function that returns a function, into one function.
-func deriveUncurryMarshal(f func(data []byte) func(v interface{}) error) func(data []byte, v interface{}) error {
-	return func(data []byte, v interface{}) error {
-		return f(data)(v)
-	}
-}
-
-// deriveUncurry3 combines a function that returns a function, into one function.
-func deriveUncurry3(f func(a int) func(b string, c bool) string) func(a int, b string, c bool) string {
-	return func(a int, b string, c bool) string {
-		return f(a)(b, c)
-	}
-}
-
-// deriveUncurryCurried combines a function that returns a function, into one function.
-func deriveUncurryCurried(f func(b string) func(c bool) string) func(b string, c bool) string {
-	return func(b string, c bool) string {
-		return f(b)(c)
-	}
-}
-
-// deriveCompose composes functions f0 and f1 into one function, that takes the parameters from f0 and returns the results from f1.
-func deriveCompose(f0 func() (string, error), f1 func(string) (float64, error)) func() (float64, error) {
-	return func() (float64, error) {
-		v_1_0, err0 := f0()
-		if err0 != nil {
-			return 0, err0
-		}
-		v_2_0, err1 := f1(v_1_0)
-		if err1 != nil {
-			return 0, err1
-		}
-		return v_2_0, nil
-	}
-}
-
-// deriveComposeA composes functions f0 and f1 into one function, that takes the parameters from f0 and returns the results from f1.
-func deriveComposeA(f0 func(string) (string, error), f1 func(string) (float64, error)) func(string) (float64, error) {
-	return func(v_0_0 string) (float64, error) {
-		v_1_0, err0 := f0(v_0_0)
-		if err0 != nil {
-			return 0, err0
-		}
-		v_2_0, err1 := f1(v_1_0)
-		if err1 != nil {
-			return 0, err1
-		}
-		return v_2_0, nil
-	}
-}
-
-// deriveCompose2 composes functions f0 and f1 into one function, that takes the parameters from f0 and returns the results from f1.
-func deriveCompose2(f0 func(string, string) ([]string, string, error), f1 func([]string, string) (float64, error)) func(string, string) (float64, error) {
-	return func(v_0_0 string, v_0_1 string) (float64, error) {
-		v_1_0, v_1_1, err0 := f0(v_0_0, v_0_1)
-		if err0 != nil {
-			return 0, err0
-		}
-		v_2_0, err1 := f1(v_1_0, v_1_1)
-		if err1 != nil {
-			return 0, err1
-		}
-		return v_2_0, nil
-	}
-}
-
-// deriveComposeVariadic composes functions f0, f1 and f2 into one function, that takes the parameters from f0 and returns the results from f2.
-func deriveComposeVariadic(f0 func(string) (string, error), f1 func(string) (float64, error), f2 func(float64) (int, error)) func(string) (int, error) {
-	return func(v_0_0 string) (int, error) {
-		v_1_0, err0 := f0(v_0_0)
-		if err0 != nil {
-			return 0, err0
-		}
-		v_2_0, err1 := f1(v_1_0)
-		if err1 != nil {
-			return 0, err1
-		}
-		v_3_0, err2 := f2(v_2_0)
-		if err2 != nil {
-			return 0, err2
+// deriveDeepCopyPtrToTaggedFields recursively copies the contents of src into dst.
+func deriveDeepCopyPtrToTaggedFields(dst, src *TaggedFields) {
+	dst.Name = src.Name
+	field := new(TaggedVersion)
+	deriveDeepCopy_45(field, &src.Version)
+	dst.Version = *field
+	dst.Updated = src.Updated
+	dst.Parent = src.Parent
+}
+
+// deriveDeepCopyPtrToWithInterfaces recursively copies the contents of src into dst.
+func deriveDeepCopyPtrToWithInterfaces(dst, src *WithInterfaces) {
+	dst.Shape = deriveDeepCopy_S(src.Shape)
+	if src.Shapes == nil {
+		dst.Shapes = nil
+	} else {
+		if dst.Shapes != nil {
+			if len(src.Shapes) > len(dst.Shapes) {
+				if cap(dst.Shapes) >= len(src.Shapes) {
+					dst.Shapes = (dst.Shapes)[:len(src.Shapes)]
+				} else {
+					dst.Shapes = make([]Shape, len(src.Shapes))
+				}
+			} else if len(src.Shapes) < len(dst.Shapes) {
+				dst.Shapes = (dst.Shapes)[:len(src.Shapes)]
+			}
+		} else {
+			dst.Shapes = make([]Shape, len(src.Shapes))
 		}
-		return v_3_0, nil
+		deriveDeepCopy_46(dst.Shapes, src.Shapes)
 	}
+	dst.Err = deriveDeepCopy_e(src.Err)
+	dst.Any = deriveDeepCopy_47(src.Any)
 }
 
 // deriveComparePtrToEmpty returns:
@@ -2576,10 +2353,10 @@ func deriveComparePtrToBuiltInTypes(this, that *BuiltInTypes) int {
 	if c := deriveCompare_(this.Byte, that.Byte); c != 0 {
 		return c
 	}
-	if c := deriveCompareComplex64(this.Complex128, that.Complex128); c != 0 {
+	if c := deriveCompare_1(this.Complex128, that.Complex128); c != 0 {
 		return c
 	}
-	if c := deriveCompareComplex32(this.Complex64, that.Complex64); c != 0 {
+	if c := deriveCompare_2(this.Complex64, that.Complex64); c != 0 {
 		return c
 	}
 	if c := deriveCompare_f(this.Float64, that.Float64); c != 0 {
@@ -2624,7 +2401,7 @@ func deriveComparePtrToBuiltInTypes(this, that *BuiltInTypes) int {
 	if c := deriveCompare_(this.Uint8, that.Uint8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_1(this.UintPtr, that.UintPtr); c != 0 {
+	if c := deriveCompare_3(this.UintPtr, that.UintPtr); c != 0 {
 		return c
 	}
 	return 0
@@ -2650,10 +2427,10 @@ func deriveComparePtrToPrivateBuiltInTypes(this, that *PrivateBuiltInTypes) int
 	if c := deriveCompare_(this.privateByte, that.privateByte); c != 0 {
 		return c
 	}
-	if c := deriveCompareComplex64(this.privateComplex128, that.privateComplex128); c != 0 {
+	if c := deriveCompare_1(this.privateComplex128, that.privateComplex128); c != 0 {
 		return c
 	}
-	if c := deriveCompareComplex32(this.privateComplex64, that.privateComplex64); c != 0 {
+	if c := deriveCompare_2(this.privateComplex64, that.privateComplex64); c != 0 {
 		return c
 	}
 	if c := deriveCompare_f(this.privateFloat64, that.privateFloat64); c != 0 {
@@ -2698,7 +2475,7 @@ func deriveComparePtrToPrivateBuiltInTypes(this, that *PrivateBuiltInTypes) int
 	if c := deriveCompare_(this.privateUint8, that.privateUint8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_1(this.privateUintPtr, that.privateUintPtr); c != 0 {
+	if c := deriveCompare_3(this.privateUintPtr, that.privateUintPtr); c != 0 {
 		return c
 	}
 	return 0
@@ -2718,61 +2495,61 @@ func deriveComparePtrToPtrToBuiltInTypes(this, that *PtrToBuiltInTypes) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_2(this.Bool, that.Bool); c != 0 {
+	if c := deriveCompare_4(this.Bool, that.Bool); c != 0 {
 		return c
 	}
-	if c := deriveCompare_3(this.Byte, that.Byte); c != 0 {
+	if c := deriveCompare_5(this.Byte, that.Byte); c != 0 {
 		return c
 	}
-	if c := deriveCompare_4(this.Complex128, that.Complex128); c != 0 {
+	if c := deriveCompare_6(this.Complex128, that.Complex128); c != 0 {
 		return c
 	}
-	if c := deriveCompare_5(this.Complex64, that.Complex64); c != 0 {
+	if c := deriveCompare_7(this.Complex64, that.Complex64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_6(this.Float64, that.Float64); c != 0 {
+	if c := deriveCompare_8(this.Float64, that.Float64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_7(this.Float32, that.Float32); c != 0 {
+	if c := deriveCompare_9(this.Float32, that.Float32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_8(this.Int, that.Int); c != 0 {
+	if c := deriveCompare_10(this.Int, that.Int); c != 0 {
 		return c
 	}
-	if c := deriveCompare_9(this.Int16, that.Int16); c != 0 {
+	if c := deriveCompare_11(this.Int16, that.Int16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_10(this.Int32, that.Int32); c != 0 {
+	if c := deriveCompare_12(this.Int32, that.Int32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_11(this.Int64, that.Int64); c != 0 {
+	if c := deriveCompare_13(this.Int64, that.Int64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_12(this.Int8, that.Int8); c != 0 {
+	if c := deriveCompare_14(this.Int8, that.Int8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_10(this.Rune, that.Rune); c != 0 {
+	if c := deriveCompare_12(this.Rune, that.Rune); c != 0 {
 		return c
 	}
-	if c := deriveCompare_13(this.String, that.String); c != 0 {
+	if c := deriveCompare_15(this.String, that.String); c != 0 {
 		return c
 	}
-	if c := deriveCompare_14(this.Uint, that.Uint); c != 0 {
+	if c := deriveCompare_16(this.Uint, that.Uint); c != 0 {
 		return c
 	}
-	if c := deriveCompare_15(this.Uint16, that.Uint16); c != 0 {
+	if c := deriveCompare_17(this.Uint16, that.Uint16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_16(this.Uint32, that.Uint32); c != 0 {
+	if c := deriveCompare_18(this.Uint32, that.Uint32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_17(this.Uint64, that.Uint64); c != 0 {
+	if c := deriveCompare_19(this.Uint64, that.Uint64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_3(this.Uint8, that.Uint8); c != 0 {
+	if c := deriveCompare_5(this.Uint8, that.Uint8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_18(this.UintPtr, that.UintPtr); c != 0 {
+	if c := deriveCompare_20(this.UintPtr, that.UintPtr); c != 0 {
 		return c
 	}
 	return 0
@@ -2792,61 +2569,61 @@ func deriveComparePtrToSliceOfBuiltInTypes(this, that *SliceOfBuiltInTypes) int
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_19(this.Bool, that.Bool); c != 0 {
+	if c := deriveCompare_21(this.Bool, that.Bool); c != 0 {
 		return c
 	}
 	if c := bytes.Compare(this.Byte, that.Byte); c != 0 {
 		return c
 	}
-	if c := deriveCompare_20(this.Complex128, that.Complex128); c != 0 {
+	if c := deriveCompare_22(this.Complex128, that.Complex128); c != 0 {
 		return c
 	}
-	if c := deriveCompare_21(this.Complex64, that.Complex64); c != 0 {
+	if c := deriveCompare_23(this.Complex64, that.Complex64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_22(this.Float64, that.Float64); c != 0 {
+	if c := deriveCompare_24(this.Float64, that.Float64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_23(this.Float32, that.Float32); c != 0 {
+	if c := deriveCompare_25(this.Float32, that.Float32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_24(this.Int, that.Int); c != 0 {
+	if c := deriveCompare_26(this.Int, that.Int); c != 0 {
 		return c
 	}
-	if c := deriveCompare_25(this.Int16, that.Int16); c != 0 {
+	if c := deriveCompare_27(this.Int16, that.Int16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_26(this.Int32, that.Int32); c != 0 {
+	if c := deriveCompare_28(this.Int32, that.Int32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_27(this.Int64, that.Int64); c != 0 {
+	if c := deriveCompare_29(this.Int64, that.Int64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_28(this.Int8, that.Int8); c != 0 {
+	if c := deriveCompare_30(this.Int8, that.Int8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_26(this.Rune, that.Rune); c != 0 {
+	if c := deriveCompare_28(this.Rune, that.Rune); c != 0 {
 		return c
 	}
-	if c := deriveCompare_29(this.String, that.String); c != 0 {
+	if c := deriveCompare_31(this.String, that.String); c != 0 {
 		return c
 	}
-	if c := deriveCompare_30(this.Uint, that.Uint); c != 0 {
+	if c := deriveCompare_32(this.Uint, that.Uint); c != 0 {
 		return c
 	}
-	if c := deriveCompare_31(this.Uint16, that.Uint16); c != 0 {
+	if c := deriveCompare_33(this.Uint16, that.Uint16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_32(this.Uint32, that.Uint32); c != 0 {
+	if c := deriveCompare_34(this.Uint32, that.Uint32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_33(this.Uint64, that.Uint64); c != 0 {
+	if c := deriveCompare_35(this.Uint64, that.Uint64); c != 0 {
 		return c
 	}
 	if c := bytes.Compare(this.Uint8, that.Uint8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_34(this.UintPtr, that.UintPtr); c != 0 {
+	if c := deriveCompare_36(this.UintPtr, that.UintPtr); c != 0 {
 		return c
 	}
 	return 0
@@ -2866,61 +2643,61 @@ func deriveComparePtrToSliceOfPtrToBuiltInTypes(this, that *SliceOfPtrToBuiltInT
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_35(this.Bool, that.Bool); c != 0 {
+	if c := deriveCompare_37(this.Bool, that.Bool); c != 0 {
 		return c
 	}
-	if c := deriveCompare_36(this.Byte, that.Byte); c != 0 {
+	if c := deriveCompare_38(this.Byte, that.Byte); c != 0 {
 		return c
 	}
-	if c := deriveCompare_37(this.Complex128, that.Complex128); c != 0 {
+	if c := deriveCompare_39(this.Complex128, that.Complex128); c != 0 {
 		return c
 	}
-	if c := deriveCompare_38(this.Complex64, that.Complex64); c != 0 {
+	if c := deriveCompare_40(this.Complex64, that.Complex64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_39(this.Float64, that.Float64); c != 0 {
+	if c := deriveCompare_41(this.Float64, that.Float64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_40(this.Float32, that.Float32); c != 0 {
+	if c := deriveCompare_42(this.Float32, that.Float32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_41(this.Int, that.Int); c != 0 {
+	if c := deriveCompare_43(this.Int, that.Int); c != 0 {
 		return c
 	}
-	if c := deriveCompare_42(this.Int16, that.Int16); c != 0 {
+	if c := deriveCompare_44(this.Int16, that.Int16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_43(this.Int32, that.Int32); c != 0 {
+	if c := deriveCompare_45(this.Int32, that.Int32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_44(this.Int64, that.Int64); c != 0 {
+	if c := deriveCompare_46(this.Int64, that.Int64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_45(this.Int8, that.Int8); c != 0 {
+	if c := deriveCompare_47(this.Int8, that.Int8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_43(this.Rune, that.Rune); c != 0 {
+	if c := deriveCompare_45(this.Rune, that.Rune); c != 0 {
 		return c
 	}
-	if c := deriveCompare_46(this.String, that.String); c != 0 {
+	if c := deriveCompare_48(this.String, that.String); c != 0 {
 		return c
 	}
-	if c := deriveCompare_47(this.Uint, that.Uint); c != 0 {
+	if c := deriveCompare_49(this.Uint, that.Uint); c != 0 {
 		return c
 	}
-	if c := deriveCompare_48(this.Uint16, that.Uint16); c != 0 {
+	if c := deriveCompare_50(this.Uint16, that.Uint16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_49(this.Uint32, that.Uint32); c != 0 {
+	if c := deriveCompare_51(this.Uint32, that.Uint32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_50(this.Uint64, that.Uint64); c != 0 {
+	if c := deriveCompare_52(this.Uint64, that.Uint64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_36(this.Uint8, that.Uint8); c != 0 {
+	if c := deriveCompare_38(this.Uint8, that.Uint8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_51(this.UintPtr, that.UintPtr); c != 0 {
+	if c := deriveCompare_53(this.UintPtr, that.UintPtr); c != 0 {
 		return c
 	}
 	return 0
@@ -2940,64 +2717,64 @@ func deriveComparePtrToArrayOfBuiltInTypes(this, that *ArrayOfBuiltInTypes) int
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_52(this.Bool, that.Bool); c != 0 {
+	if c := deriveCompare_54(this.Bool, that.Bool); c != 0 {
 		return c
 	}
-	if c := deriveCompare_53(this.Byte, that.Byte); c != 0 {
+	if c := deriveCompare_55(this.Byte, that.Byte); c != 0 {
 		return c
 	}
-	if c := deriveCompare_54(this.Complex128, that.Complex128); c != 0 {
+	if c := deriveCompare_56(this.Complex128, that.Complex128); c != 0 {
 		return c
 	}
-	if c := deriveCompare_55(this.Complex64, that.Complex64); c != 0 {
+	if c := deriveCompare_57(this.Complex64, that.Complex64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_56(this.Float64, that.Float64); c != 0 {
+	if c := deriveCompare_58(this.Float64, that.Float64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_57(this.Float32, that.Float32); c != 0 {
+	if c := deriveCompare_59(this.Float32, that.Float32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_58(this.Int, that.Int); c != 0 {
+	if c := deriveCompare_60(this.Int, that.Int); c != 0 {
 		return c
 	}
-	if c := deriveCompare_59(this.Int16, that.Int16); c != 0 {
+	if c := deriveCompare_61(this.Int16, that.Int16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_60(this.Int32, that.Int32); c != 0 {
+	if c := deriveCompare_62(this.Int32, that.Int32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_61(this.Int64, that.Int64); c != 0 {
+	if c := deriveCompare_63(this.Int64, that.Int64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_62(this.Int8, that.Int8); c != 0 {
+	if c := deriveCompare_64(this.Int8, that.Int8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_63(this.Rune, that.Rune); c != 0 {
+	if c := deriveCompare_65(this.Rune, that.Rune); c != 0 {
 		return c
 	}
-	if c := deriveCompare_64(this.String, that.String); c != 0 {
+	if c := deriveCompare_66(this.String, that.String); c != 0 {
 		return c
 	}
-	if c := deriveCompare_65(this.Uint, that.Uint); c != 0 {
+	if c := deriveCompare_67(this.Uint, that.Uint); c != 0 {
 		return c
 	}
-	if c := deriveCompare_66(this.Uint16, that.Uint16); c != 0 {
+	if c := deriveCompare_68(this.Uint16, that.Uint16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_67(this.Uint32, that.Uint32); c != 0 {
+	if c := deriveCompare_69(this.Uint32, that.Uint32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_68(this.Uint64, that.Uint64); c != 0 {
+	if c := deriveCompare_70(this.Uint64, that.Uint64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_69(this.Uint8, that.Uint8); c != 0 {
+	if c := deriveCompare_71(this.Uint8, that.Uint8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_70(this.UintPtr, that.UintPtr); c != 0 {
+	if c := deriveCompare_72(this.UintPtr, that.UintPtr); c != 0 {
 		return c
 	}
-	if c := deriveCompare_71(this.AnotherBoolOfDifferentSize, that.AnotherBoolOfDifferentSize); c != 0 {
+	if c := deriveCompare_73(this.AnotherBoolOfDifferentSize, that.AnotherBoolOfDifferentSize); c != 0 {
 		return c
 	}
 	return 0
@@ -3017,64 +2794,64 @@ func deriveComparePtrToArrayOfPtrToBuiltInTypes(this, that *ArrayOfPtrToBuiltInT
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_72(this.Bool, that.Bool); c != 0 {
+	if c := deriveCompare_74(this.Bool, that.Bool); c != 0 {
 		return c
 	}
-	if c := deriveCompare_73(this.Byte, that.Byte); c != 0 {
+	if c := deriveCompare_75(this.Byte, that.Byte); c != 0 {
 		return c
 	}
-	if c := deriveCompare_74(this.Complex128, that.Complex128); c != 0 {
+	if c := deriveCompare_76(this.Complex128, that.Complex128); c != 0 {
 		return c
 	}
-	if c := deriveCompare_75(this.Complex64, that.Complex64); c != 0 {
+	if c := deriveCompare_77(this.Complex64, that.Complex64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_76(this.Float64, that.Float64); c != 0 {
+	if c := deriveCompare_78(this.Float64, that.Float64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_77(this.Float32, that.Float32); c != 0 {
+	if c := deriveCompare_79(this.Float32, that.Float32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_78(this.Int, that.Int); c != 0 {
+	if c := deriveCompare_80(this.Int, that.Int); c != 0 {
 		return c
 	}
-	if c := deriveCompare_79(this.Int16, that.Int16); c != 0 {
+	if c := deriveCompare_81(this.Int16, that.Int16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_80(this.Int32, that.Int32); c != 0 {
+	if c := deriveCompare_82(this.Int32, that.Int32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_81(this.Int64, that.Int64); c != 0 {
+	if c := deriveCompare_83(this.Int64, that.Int64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_82(this.Int8, that.Int8); c != 0 {
+	if c := deriveCompare_84(this.Int8, that.Int8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_83(this.Rune, that.Rune); c != 0 {
+	if c := deriveCompare_85(this.Rune, that.Rune); c != 0 {
 		return c
 	}
-	if c := deriveCompare_84(this.String, that.String); c != 0 {
+	if c := deriveCompare_86(this.String, that.String); c != 0 {
 		return c
 	}
-	if c := deriveCompare_85(this.Uint, that.Uint); c != 0 {
+	if c := deriveCompare_87(this.Uint, that.Uint); c != 0 {
 		return c
 	}
-	if c := deriveCompare_86(this.Uint16, that.Uint16); c != 0 {
+	if c := deriveCompare_88(this.Uint16, that.Uint16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_87(this.Uint32, that.Uint32); c != 0 {
+	if c := deriveCompare_89(this.Uint32, that.Uint32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_88(this.Uint64, that.Uint64); c != 0 {
+	if c := deriveCompare_90(this.Uint64, that.Uint64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_89(this.Uint8, that.Uint8); c != 0 {
+	if c := deriveCompare_91(this.Uint8, that.Uint8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_90(this.UintPtr, that.UintPtr); c != 0 {
+	if c := deriveCompare_92(this.UintPtr, that.UintPtr); c != 0 {
 		return c
 	}
-	if c := deriveCompare_91(this.AnotherBoolOfDifferentSize, that.AnotherBoolOfDifferentSize); c != 0 {
+	if c := deriveCompare_93(this.AnotherBoolOfDifferentSize, that.AnotherBoolOfDifferentSize); c != 0 {
 		return c
 	}
 	return 0
@@ -3094,10 +2871,10 @@ func deriveComparePtrToMapsOfSimplerBuiltInTypes(this, that *MapsOfSimplerBuiltI
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_92(this.StringToUint32, that.StringToUint32); c != 0 {
+	if c := deriveCompare_94(this.StringToUint32, that.StringToUint32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_93(this.Uint64ToInt64, that.Uint64ToInt64); c != 0 {
+	if c := deriveCompare_95(this.Uint64ToInt64, that.Uint64ToInt64); c != 0 {
 		return c
 	}
 	return 0
@@ -3117,19 +2894,19 @@ func deriveComparePtrToMapsOfBuiltInTypes(this, that *MapsOfBuiltInTypes) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_94(this.BoolToString, that.BoolToString); c != 0 {
+	if c := deriveCompare_96(this.BoolToString, that.BoolToString); c != 0 {
 		return c
 	}
-	if c := deriveCompare_95(this.StringToBool, that.StringToBool); c != 0 {
+	if c := deriveCompare_97(this.StringToBool, that.StringToBool); c != 0 {
 		return c
 	}
-	if c := deriveCompare_96(this.Complex128ToComplex64, that.Complex128ToComplex64); c != 0 {
+	if c := deriveCompare_98(this.Complex128ToComplex64, that.Complex128ToComplex64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_97(this.Float64ToUint32, that.Float64ToUint32); c != 0 {
+	if c := deriveCompare_99(this.Float64ToUint32, that.Float64ToUint32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_98(this.Uint16ToUint8, that.Uint16ToUint8); c != 0 {
+	if c := deriveCompare_100(this.Uint16ToUint8, that.Uint16ToUint8); c != 0 {
 		return c
 	}
 	return 0
@@ -3149,13 +2926,13 @@ func deriveComparePtrToSliceToSlice(this, that *SliceToSlice) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_99(this.Ints, that.Ints); c != 0 {
+	if c := deriveCompare_101(this.Ints, that.Ints); c != 0 {
 		return c
 	}
-	if c := deriveCompare_100(this.Strings, that.Strings); c != 0 {
+	if c := deriveCompare_102(this.Strings, that.Strings); c != 0 {
 		return c
 	}
-	if c := deriveCompare_101(this.IntPtrs, that.IntPtrs); c != 0 {
+	if c := deriveCompare_103(this.IntPtrs, that.IntPtrs); c != 0 {
 		return c
 	}
 	return 0
@@ -3175,16 +2952,16 @@ func deriveComparePtrToPtrTo(this, that *PtrTo) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_8(this.Basic, that.Basic); c != 0 {
+	if c := deriveCompare_10(this.Basic, that.Basic); c != 0 {
 		return c
 	}
-	if c := deriveCompare_102(this.Slice, that.Slice); c != 0 {
+	if c := deriveCompare_104(this.Slice, that.Slice); c != 0 {
 		return c
 	}
-	if c := deriveCompare_103(this.Array, that.Array); c != 0 {
+	if c := deriveCompare_105(this.Array, that.Array); c != 0 {
 		return c
 	}
-	if c := deriveCompare_104(this.Map, that.Map); c != 0 {
+	if c := deriveCompare_106(this.Map, that.Map); c != 0 {
 		return c
 	}
 	return 0
@@ -3230,10 +3007,10 @@ func deriveComparePtrToStructs(this, that *Structs) int {
 	if c := this.PtrToStruct.Compare(that.PtrToStruct); c != 0 {
 		return c
 	}
-	if c := deriveCompare_105(this.SliceOfStructs, that.SliceOfStructs); c != 0 {
+	if c := deriveCompare_107(this.SliceOfStructs, that.SliceOfStructs); c != 0 {
 		return c
 	}
-	if c := deriveCompare_106(this.SliceToPtrOfStruct, that.SliceToPtrOfStruct); c != 0 {
+	if c := deriveCompare_108(this.SliceToPtrOfStruct, that.SliceToPtrOfStruct); c != 0 {
 		return c
 	}
 	return 0
@@ -3253,19 +3030,19 @@ func deriveComparePtrToMapWithStructs(this, that *MapWithStructs) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_107(this.NameToString, that.NameToString); c != 0 {
+	if c := deriveCompare_109(this.NameToString, that.NameToString); c != 0 {
 		return c
 	}
-	if c := deriveCompare_108(this.StringToName, that.StringToName); c != 0 {
+	if c := deriveCompare_110(this.StringToName, that.StringToName); c != 0 {
 		return c
 	}
-	if c := deriveCompare_109(this.StringToPtrToName, that.StringToPtrToName); c != 0 {
+	if c := deriveCompare_111(this.StringToPtrToName, that.StringToPtrToName); c != 0 {
 		return c
 	}
-	if c := deriveCompare_110(this.StringToSliceOfName, that.StringToSliceOfName); c != 0 {
+	if c := deriveCompare_112(this.StringToSliceOfName, that.StringToSliceOfName); c != 0 {
 		return c
 	}
-	if c := deriveCompare_111(this.StringToSliceOfPtrToName, that.StringToSliceOfPtrToName); c != 0 {
+	if c := deriveCompare_113(this.StringToSliceOfPtrToName, that.StringToSliceOfPtrToName); c != 0 {
 		return c
 	}
 	return 0
@@ -3288,7 +3065,7 @@ func deriveComparePtrToRecursiveType(this, that *RecursiveType) int {
 	if c := bytes.Compare(this.Bytes, that.Bytes); c != 0 {
 		return c
 	}
-	if c := deriveCompare_112(this.N, that.N); c != 0 {
+	if c := deriveCompare_114(this.N, that.N); c != 0 {
 		return c
 	}
 	return 0
@@ -3354,10 +3131,10 @@ func deriveComparePtrToStructWithStructFieldWithoutEqualMethod(this, that *Struc
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_113(this.A, that.A); c != 0 {
+	if c := deriveCompare_115(this.A, that.A); c != 0 {
 		return c
 	}
-	if c := deriveCompare_113(&this.B, &that.B); c != 0 {
+	if c := deriveCompare_115(&this.B, &that.B); c != 0 {
 		return c
 	}
 	return 0
@@ -3377,10 +3154,10 @@ func deriveComparePtrToStructWithStructWithFromAnotherPackage(this, that *Struct
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_114(this.A, that.A); c != 0 {
+	if c := deriveCompare_116(this.A, that.A); c != 0 {
 		return c
 	}
-	if c := deriveCompare_114(&this.B, &that.B); c != 0 {
+	if c := deriveCompare_116(&this.B, &that.B); c != 0 {
 		return c
 	}
 	return 0
@@ -3400,7 +3177,7 @@ func deriveComparePtrToFieldWithStructWithPrivateFields(this, that *FieldWithStr
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_115(this.A, that.A); c != 0 {
+	if c := deriveCompare_117(this.A, that.A); c != 0 {
 		return c
 	}
 	return 0
@@ -3423,22 +3200,22 @@ func deriveComparePtrToEnums(this, that *Enums) int {
 	if c := deriveCompare_M(this.Enum, that.Enum); c != 0 {
 		return c
 	}
-	if c := deriveCompare_116(this.PtrToEnum, that.PtrToEnum); c != 0 {
+	if c := deriveCompare_118(this.PtrToEnum, that.PtrToEnum); c != 0 {
 		return c
 	}
-	if c := deriveCompare_117(this.SliceToEnum, that.SliceToEnum); c != 0 {
+	if c := deriveCompare_119(this.SliceToEnum, that.SliceToEnum); c != 0 {
 		return c
 	}
-	if c := deriveCompare_118(this.SliceToPtrToEnum, that.SliceToPtrToEnum); c != 0 {
+	if c := deriveCompare_120(this.SliceToPtrToEnum, that.SliceToPtrToEnum); c != 0 {
 		return c
 	}
-	if c := deriveCompare_119(this.MapToEnum, that.MapToEnum); c != 0 {
+	if c := deriveCompare_121(this.MapToEnum, that.MapToEnum); c != 0 {
 		return c
 	}
-	if c := deriveCompare_120(this.EnumToMap, that.EnumToMap); c != 0 {
+	if c := deriveCompare_122(this.EnumToMap, that.EnumToMap); c != 0 {
 		return c
 	}
-	if c := deriveCompare_121(this.ArrayEnum, that.ArrayEnum); c != 0 {
+	if c := deriveCompare_123(this.ArrayEnum, that.ArrayEnum); c != 0 {
 		return c
 	}
 	return 0
@@ -3458,13 +3235,13 @@ func deriveComparePtrToNamedTypes(this, that *NamedTypes) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_27(this.Slice, that.Slice); c != 0 {
+	if c := deriveCompare_29(this.Slice, that.Slice); c != 0 {
 		return c
 	}
-	if c := deriveCompare_122(this.PtrToSlice, that.PtrToSlice); c != 0 {
+	if c := deriveCompare_124(this.PtrToSlice, that.PtrToSlice); c != 0 {
 		return c
 	}
-	if c := deriveCompare_123(this.SliceToSlice, that.SliceToSlice); c != 0 {
+	if c := deriveCompare_125(this.SliceToSlice, that.SliceToSlice); c != 0 {
 		return c
 	}
 	return 0
@@ -3487,16 +3264,16 @@ func deriveComparePtrToDuration(this, that *Duration) int {
 	if c := deriveCompare_D(this.D, that.D); c != 0 {
 		return c
 	}
-	if c := deriveCompare_124(this.P, that.P); c != 0 {
+	if c := deriveCompare_126(this.P, that.P); c != 0 {
 		return c
 	}
-	if c := deriveCompare_125(this.Ds, that.Ds); c != 0 {
+	if c := deriveCompare_127(this.Ds, that.Ds); c != 0 {
 		return c
 	}
-	if c := deriveCompare_126(this.DPs, that.DPs); c != 0 {
+	if c := deriveCompare_128(this.DPs, that.DPs); c != 0 {
 		return c
 	}
-	if c := deriveCompare_127(this.MD, that.MD); c != 0 {
+	if c := deriveCompare_129(this.MD, that.MD); c != 0 {
 		return c
 	}
 	return 0
@@ -3516,7 +3293,7 @@ func deriveComparePtrToNickname(this, that *Nickname) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_128(this.Alias, that.Alias); c != 0 {
+	if c := deriveCompare_130(this.Alias, that.Alias); c != 0 {
 		return c
 	}
 	return 0
@@ -3536,79 +3313,43 @@ func deriveComparePtrToPrivateEmbedded(this, that *PrivateEmbedded) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_129(&this.privateStruct, &that.privateStruct); c != 0 {
+	if c := deriveCompare_131(&this.privateStruct, &that.privateStruct); c != 0 {
 		return c
 	}
 	return 0
 }
 
-// deriveCompareComplex32 returns:
+// deriveComparePtrToTaggedFields returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompareComplex32(this, that complex64) int {
-	if thisr, thatr := real(this), real(that); thisr == thatr {
-		if thisi, thati := imag(this), imag(that); thisi == thati {
+func deriveComparePtrToTaggedFields(this, that *TaggedFields) int {
+	if this == nil {
+		if that == nil {
 			return 0
-		} else if thisi < thati {
-			return -1
-		} else {
-			return 1
 		}
-	} else if thisr < thatr {
 		return -1
-	} else {
-		return 1
 	}
-}
-
-// deriveCompareComplex64 returns:
-//   * 0 if this and that are equal,
-//   * -1 is this is smaller and
-//   * +1 is this is bigger.
-func deriveCompareComplex64(this, that complex128) int {
-	if thisr, thatr := real(this), real(that); thisr == thatr {
-		if thisi, thati := imag(this), imag(that); thisi == thati {
-			return 0
-		} else if thisi < thati {
-			return -1
-		} else {
-			return 1
-		}
-	} else if thisr < thatr {
-		return -1
-	} else {
+	if that == nil {
 		return 1
 	}
-}
-
-// deriveCompareCurryComplex64 returns a curried compare function, which returns:
-//   * 0 if this and that are equal,
-//   * -1 is this is smaller and
-//   * +1 is this is bigger.
-func deriveCompareCurryComplex64(this complex128) func(complex128) int {
-	return func(that complex128) int {
-		if thisr, thatr := real(this), real(that); thisr == thatr {
-			if thisi, thati := imag(this), imag(that); thisi == thati {
-				return 0
-			} else if thisi < thati {
-				return -1
-			} else {
-				return 1
-			}
-		} else if thisr < thatr {
-			return -1
-		} else {
-			return 1
-		}
+	if c := strings.Compare(this.Name, that.Name); c != 0 {
+		return c
+	}
+	if c := deriveCompare_132(&this.Version, &that.Version); c != 0 {
+		return c
 	}
+	if c := this.Parent.Compare(that.Parent); c != 0 {
+		return c
+	}
+	return 0
 }
 
-// deriveCompareDeriveTheDerived returns:
+// deriveComparePtrToWithInterfaces returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompareDeriveTheDerived(this, that *DeriveTheDerived) int {
+func deriveComparePtrToWithInterfaces(this, that *WithInterfaces) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -3618,129 +3359,24 @@ func deriveCompareDeriveTheDerived(this, that *DeriveTheDerived) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_i(this.Field, that.Field); c != 0 {
+	if c := deriveCompare_S(this.Shape, that.Shape); c != 0 {
 		return c
 	}
-	return 0
-}
-
-// deriveUniqueInt64s returns a list containing only the unique items from the input list.
-// It does this by reusing the input list.
-func deriveUniqueInt64s(list []int64) []int64 {
-	if len(list) == 0 {
-		return nil
-	}
-	return deriveKeysForInt64s(deriveSetInt64s(list))
-}
-
-// deriveUniqueStructs returns a list containing only the unique items from the input list.
-// It does this by reusing the input list.
-func deriveUniqueStructs(list []*BuiltInTypes) []*BuiltInTypes {
-	if len(list) == 0 {
-		return nil
-	}
-	table := make(map[uint64][]int)
-	u := 0
-	for i := 0; i < len(list); i++ {
-		contains := false
-		hash := deriveHashBuiltInTypes(list[i])
-		indexes := table[hash]
-		for _, index := range indexes {
-			if deriveEqualPtrToBuiltInTypes(list[index], list[i]) {
-				contains = true
-				break
-			}
-		}
-		if contains {
-			continue
-		}
-		if i != u {
-			list[u] = list[i]
-		}
-		table[hash] = append(table[hash], u)
-		u++
-	}
-	return list[:u]
-}
-
-// deriveFilter returns a list of all items in the list that matches the predicate.
-func deriveFilter(predicate func(int) bool, list []int) []int {
-	j := 0
-	for i, elem := range list {
-		if predicate(elem) {
-			if i != j {
-				list[j] = list[i]
-			}
-			j++
-		}
-	}
-	return list[:j]
-}
-
-// deriveFilterJudy returns a list of all items in the list that matches the predicate.
-func deriveFilterJudy(predicate func(string) bool, list []string) []string {
-	j := 0
-	for i, elem := range list {
-		if predicate(elem) {
-			if i != j {
-				list[j] = list[i]
-			}
-			j++
-		}
-	}
-	return list[:j]
-}
-
-// deriveUnionSetOfInt64s returns the union of two maps, with respect to the keys.
-// It does this by adding the keys to the first map.
-func deriveUnionSetOfInt64s(union, that map[int64]struct{}) map[int64]struct{} {
-	for k := range that {
-		union[k] = struct{}{}
-	}
-	return union
-}
-
-// deriveUnionOfInt64s returns the union of the items of the two input lists.
-// It does this by append items to the first list.
-func deriveUnionOfInt64s(this, that []int64) []int64 {
-	for i, v := range that {
-		if !deriveContainsInt64s(this, v) {
-			this = append(this, that[i])
-		}
-	}
-	return this
-}
-
-// deriveTuple1 returns a function, which returns the input values.
-// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
-func deriveTuple1(v0 int) func() int {
-	return func() int {
-		return v0
+	if c := deriveCompare_133(this.Shapes, that.Shapes); c != 0 {
+		return c
 	}
-}
-
-// deriveTuple2 returns a function, which returns the input values.
-// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
-func deriveTuple2(v0 int, v1 string) func() (int, string) {
-	return func() (int, string) {
-		return v0, v1
+	if c := deriveCompare_e(this.Err, that.Err); c != 0 {
+		return c
 	}
-}
-
-// deriveTuple3 returns a function, which returns the input values.
-// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
-func deriveTuple3(v0 int, v1 string, v2 *BuiltInTypes) func() (int, string, *BuiltInTypes) {
-	return func() (int, string, *BuiltInTypes) {
-		return v0, v1, v2
+	if c := deriveCompare_134(this.Any, that.Any); c != 0 {
+		return c
 	}
+	return 0
 }
 
-// deriveTupleError returns a function, which returns the input values.
-// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
-func deriveTupleError(v0 []byte, v1 error) func() ([]byte, error) {
-	return func() ([]byte, error) {
-		return v0, v1
-	}
+// deriveStringLoggable returns a compact and human readable representation of this.
+func deriveStringLoggable(this *Loggable) string {
+	return string(deriveString_(this, nil))
 }
 
 // deriveEqualPtrToEmpty returns whether this and that are equal.
@@ -3833,44 +3469,44 @@ func deriveEqualPtrToSliceOfBuiltInTypes(this, that *SliceOfBuiltInTypes) bool {
 			deriveEqual_2(this.Complex64, that.Complex64) &&
 			deriveEqual_3(this.Float64, that.Float64) &&
 			deriveEqual_4(this.Float32, that.Float32) &&
-			deriveEqualSliceOfint(this.Int, that.Int) &&
-			deriveEqual_5(this.Int16, that.Int16) &&
-			deriveEqual_6(this.Int32, that.Int32) &&
-			deriveEqual_7(this.Int64, that.Int64) &&
-			deriveEqual_8(this.Int8, that.Int8) &&
-			deriveEqual_6(this.Rune, that.Rune) &&
-			deriveEqual_9(this.String, that.String) &&
-			deriveEqual_10(this.Uint, that.Uint) &&
-			deriveEqual_11(this.Uint16, that.Uint16) &&
-			deriveEqual_12(this.Uint32, that.Uint32) &&
-			deriveEqual_13(this.Uint64, that.Uint64) &&
+			deriveEqual_5(this.Int, that.Int) &&
+			deriveEqual_6(this.Int16, that.Int16) &&
+			deriveEqual_7(this.Int32, that.Int32) &&
+			deriveEqual_8(this.Int64, that.Int64) &&
+			deriveEqual_9(this.Int8, that.Int8) &&
+			deriveEqual_7(this.Rune, that.Rune) &&
+			deriveEqual_10(this.String, that.String) &&
+			deriveEqual_11(this.Uint, that.Uint) &&
+			deriveEqual_12(this.Uint16, that.Uint16) &&
+			deriveEqual_13(this.Uint32, that.Uint32) &&
+			deriveEqual_14(this.Uint64, that.Uint64) &&
 			bytes.Equal(this.Uint8, that.Uint8) &&
-			deriveEqual_14(this.UintPtr, that.UintPtr)
+			deriveEqual_15(this.UintPtr, that.UintPtr)
 }
 
 // deriveEqualPtrToSliceOfPtrToBuiltInTypes returns whether this and that are equal.
 func deriveEqualPtrToSliceOfPtrToBuiltInTypes(this, that *SliceOfPtrToBuiltInTypes) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_15(this.Bool, that.Bool) &&
-			deriveEqual_16(this.Byte, that.Byte) &&
-			deriveEqual_17(this.Complex128, that.Complex128) &&
-			deriveEqual_18(this.Complex64, that.Complex64) &&
-			deriveEqual_19(this.Float64, that.Float64) &&
-			deriveEqual_20(this.Float32, that.Float32) &&
-			deriveEqual_21(this.Int, that.Int) &&
-			deriveEqual_22(this.Int16, that.Int16) &&
-			deriveEqual_23(this.Int32, that.Int32) &&
-			deriveEqual_24(this.Int64, that.Int64) &&
-			deriveEqual_25(this.Int8, that.Int8) &&
-			deriveEqual_23(this.Rune, that.Rune) &&
-			deriveEqual_26(this.String, that.String) &&
-			deriveEqual_27(this.Uint, that.Uint) &&
-			deriveEqual_28(this.Uint16, that.Uint16) &&
-			deriveEqual_29(this.Uint32, that.Uint32) &&
-			deriveEqual_30(this.Uint64, that.Uint64) &&
-			deriveEqual_16(this.Uint8, that.Uint8) &&
-			deriveEqual_31(this.UintPtr, that.UintPtr)
+			deriveEqual_16(this.Bool, that.Bool) &&
+			deriveEqual_17(this.Byte, that.Byte) &&
+			deriveEqual_18(this.Complex128, that.Complex128) &&
+			deriveEqual_19(this.Complex64, that.Complex64) &&
+			deriveEqual_20(this.Float64, that.Float64) &&
+			deriveEqual_21(this.Float32, that.Float32) &&
+			deriveEqual_22(this.Int, that.Int) &&
+			deriveEqual_23(this.Int16, that.Int16) &&
+			deriveEqual_24(this.Int32, that.Int32) &&
+			deriveEqual_25(this.Int64, that.Int64) &&
+			deriveEqual_26(this.Int8, that.Int8) &&
+			deriveEqual_24(this.Rune, that.Rune) &&
+			deriveEqual_27(this.String, that.String) &&
+			deriveEqual_28(this.Uint, that.Uint) &&
+			deriveEqual_29(this.Uint16, that.Uint16) &&
+			deriveEqual_30(this.Uint32, that.Uint32) &&
+			deriveEqual_31(this.Uint64, that.Uint64) &&
+			deriveEqual_17(this.Uint8, that.Uint8) &&
+			deriveEqual_32(this.UintPtr, that.UintPtr)
 }
 
 // deriveEqualPtrToArrayOfBuiltInTypes returns whether this and that are equal.
@@ -3903,54 +3539,54 @@ func deriveEqualPtrToArrayOfBuiltInTypes(this, that *ArrayOfBuiltInTypes) bool {
 func deriveEqualPtrToArrayOfPtrToBuiltInTypes(this, that *ArrayOfPtrToBuiltInTypes) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_32(this.Bool, that.Bool) &&
-			deriveEqual_33(this.Byte, that.Byte) &&
-			deriveEqual_34(this.Complex128, that.Complex128) &&
-			deriveEqual_35(this.Complex64, that.Complex64) &&
-			deriveEqual_36(this.Float64, that.Float64) &&
-			deriveEqual_37(this.Float32, that.Float32) &&
-			deriveEqual_38(this.Int, that.Int) &&
-			deriveEqual_39(this.Int16, that.Int16) &&
-			deriveEqual_40(this.Int32, that.Int32) &&
-			deriveEqual_41(this.Int64, that.Int64) &&
-			deriveEqual_42(this.Int8, that.Int8) &&
-			deriveEqual_43(this.Rune, that.Rune) &&
-			deriveEqual_44(this.String, that.String) &&
-			deriveEqual_45(this.Uint, that.Uint) &&
-			deriveEqual_46(this.Uint16, that.Uint16) &&
-			deriveEqual_47(this.Uint32, that.Uint32) &&
-			deriveEqual_48(this.Uint64, that.Uint64) &&
-			deriveEqual_49(this.Uint8, that.Uint8) &&
-			deriveEqual_50(this.UintPtr, that.UintPtr) &&
-			deriveEqual_51(this.AnotherBoolOfDifferentSize, that.AnotherBoolOfDifferentSize)
+			deriveEqual_33(this.Bool, that.Bool) &&
+			deriveEqual_34(this.Byte, that.Byte) &&
+			deriveEqual_35(this.Complex128, that.Complex128) &&
+			deriveEqual_36(this.Complex64, that.Complex64) &&
+			deriveEqual_37(this.Float64, that.Float64) &&
+			deriveEqual_38(this.Float32, that.Float32) &&
+			deriveEqual_39(this.Int, that.Int) &&
+			deriveEqual_40(this.Int16, that.Int16) &&
+			deriveEqual_41(this.Int32, that.Int32) &&
+			deriveEqual_42(this.Int64, that.Int64) &&
+			deriveEqual_43(this.Int8, that.Int8) &&
+			deriveEqual_44(this.Rune, that.Rune) &&
+			deriveEqual_45(this.String, that.String) &&
+			deriveEqual_46(this.Uint, that.Uint) &&
+			deriveEqual_47(this.Uint16, that.Uint16) &&
+			deriveEqual_48(this.Uint32, that.Uint32) &&
+			deriveEqual_49(this.Uint64, that.Uint64) &&
+			deriveEqual_50(this.Uint8, that.Uint8) &&
+			deriveEqual_51(this.UintPtr, that.UintPtr) &&
+			deriveEqual_52(this.AnotherBoolOfDifferentSize, that.AnotherBoolOfDifferentSize)
 }
 
 // deriveEqualPtrToMapsOfSimplerBuiltInTypes returns whether this and that are equal.
 func deriveEqualPtrToMapsOfSimplerBuiltInTypes(this, that *MapsOfSimplerBuiltInTypes) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_52(this.StringToUint32, that.StringToUint32) &&
-			deriveEqual_53(this.Uint64ToInt64, that.Uint64ToInt64)
+			deriveEqual_53(this.StringToUint32, that.StringToUint32) &&
+			deriveEqual_54(this.Uint64ToInt64, that.Uint64ToInt64)
 }
 
 // deriveEqualPtrToMapsOfBuiltInTypes returns whether this and that are equal.
 func deriveEqualPtrToMapsOfBuiltInTypes(this, that *MapsOfBuiltInTypes) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_54(this.BoolToString, that.BoolToString) &&
-			deriveEqual_55(this.StringToBool, that.StringToBool) &&
-			deriveEqual_56(this.Complex128ToComplex64, that.Complex128ToComplex64) &&
-			deriveEqual_57(this.Float64ToUint32, that.Float64ToUint32) &&
-			deriveEqual_58(this.Uint16ToUint8, that.Uint16ToUint8)
+			deriveEqual_55(this.BoolToString, that.BoolToString) &&
+			deriveEqual_56(this.StringToBool, that.StringToBool) &&
+			deriveEqual_57(this.Complex128ToComplex64, that.Complex128ToComplex64) &&
+			deriveEqual_58(this.Float64ToUint32, that.Float64ToUint32) &&
+			deriveEqual_59(this.Uint16ToUint8, that.Uint16ToUint8)
 }
 
 // deriveEqualPtrToSliceToSlice returns whether this and that are equal.
 func deriveEqualPtrToSliceToSlice(this, that *SliceToSlice) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_59(this.Ints, that.Ints) &&
-			deriveEqual_60(this.Strings, that.Strings) &&
-			deriveEqual_61(this.IntPtrs, that.IntPtrs)
+			deriveEqual_60(this.Ints, that.Ints) &&
+			deriveEqual_61(this.Strings, that.Strings) &&
+			deriveEqual_62(this.IntPtrs, that.IntPtrs)
 }
 
 // deriveEqualPtrToPtrTo returns whether this and that are equal.
@@ -3958,9 +3594,9 @@ func deriveEqualPtrToPtrTo(this, that *PtrTo) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
 			((this.Basic == nil && that.Basic == nil) || (this.Basic != nil && that.Basic != nil && *(this.Basic) == *(that.Basic))) &&
-			((this.Slice == nil && that.Slice == nil) || (this.Slice != nil && that.Slice != nil && deriveEqualSliceOfint(*(this.Slice), *(that.Slice)))) &&
+			((this.Slice == nil && that.Slice == nil) || (this.Slice != nil && that.Slice != nil && deriveEqual_5(*(this.Slice), *(that.Slice)))) &&
 			((this.Array == nil && that.Array == nil) || (this.Array != nil && that.Array != nil && *(this.Array) == *(that.Array))) &&
-			((this.Map == nil && that.Map == nil) || (this.Map != nil && that.Map != nil && deriveEqualMapOfintToint(*(this.Map), *(that.Map))))
+			((this.Map == nil && that.Map == nil) || (this.Map != nil && that.Map != nil && deriveEqual_63(*(this.Map), *(that.Map))))
 }
 
 // deriveEqualPtrToName returns whether this and that are equal.
@@ -3976,19 +3612,19 @@ func deriveEqualPtrToStructs(this, that *Structs) bool {
 		this != nil && that != nil &&
 			this.Struct == that.Struct &&
 			this.PtrToStruct.Equal(that.PtrToStruct) &&
-			deriveEqual_62(this.SliceOfStructs, that.SliceOfStructs) &&
-			deriveEqual_63(this.SliceToPtrOfStruct, that.SliceToPtrOfStruct)
+			deriveEqual_64(this.SliceOfStructs, that.SliceOfStructs) &&
+			deriveEqual_65(this.SliceToPtrOfStruct, that.SliceToPtrOfStruct)
 }
 
 // deriveEqualPtrToMapWithStructs returns whether this and that are equal.
 func deriveEqualPtrToMapWithStructs(this, that *MapWithStructs) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_64(this.NameToString, that.NameToString) &&
-			deriveEqual_65(this.StringToName, that.StringToName) &&
-			deriveEqual_66(this.StringToPtrToName, that.StringToPtrToName) &&
-			deriveEqual_67(this.StringToSliceOfName, that.StringToSliceOfName) &&
-			deriveEqual_68(this.StringToSliceOfPtrToName, that.StringToSliceOfPtrToName)
+			deriveEqual_66(this.NameToString, that.NameToString) &&
+			deriveEqual_67(this.StringToName, that.StringToName) &&
+			deriveEqual_68(this.StringToPtrToName, that.StringToPtrToName) &&
+			deriveEqual_69(this.StringToSliceOfName, that.StringToSliceOfName) &&
+			deriveEqual_70(this.StringToSliceOfPtrToName, that.StringToSliceOfPtrToName)
 }
 
 // deriveEqualPtrToRecursiveType returns whether this and that are equal.
@@ -3996,7 +3632,7 @@ func deriveEqualPtrToRecursiveType(this, that *RecursiveType) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
 			bytes.Equal(this.Bytes, that.Bytes) &&
-			deriveEqual_69(this.N, that.N)
+			deriveEqual_71(this.N, that.N)
 }
 
 // deriveEqualPtrToEmbeddedStruct1 returns whether this and that are equal.
@@ -4026,7 +3662,7 @@ func deriveEqualPtrToUnnamedStruct(this, that *UnnamedStruct) bool {
 func deriveEqualPtrToStructWithStructFieldWithoutEqualMethod(this, that *StructWithStructFieldWithoutEqualMethod) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_70(this.A, that.A) &&
+			deriveEqual_72(this.A, that.A) &&
 			this.B == that.B
 }
 
@@ -4034,7 +3670,7 @@ func deriveEqualPtrToStructWithStructFieldWithoutEqualMethod(this, that *StructW
 func deriveEqualPtrToStructWithStructWithFromAnotherPackage(this, that *StructWithStructWithFromAnotherPackage) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_71(this.A, that.A) &&
+			deriveEqual_73(this.A, that.A) &&
 			this.B == that.B
 }
 
@@ -4042,7 +3678,7 @@ func deriveEqualPtrToStructWithStructWithFromAnotherPackage(this, that *StructWi
 func deriveEqualPtrToFieldWithStructWithPrivateFields(this, that *FieldWithStructWithPrivateFields) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_72(this.A, that.A)
+			deriveEqual_74(this.A, that.A)
 }
 
 // deriveEqualPtrToEnums returns whether this and that are equal.
@@ -4050,11 +3686,11 @@ func deriveEqualPtrToEnums(this, that *Enums) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
 			this.Enum == that.Enum &&
-			deriveEqual_73(this.PtrToEnum, that.PtrToEnum) &&
-			deriveEqual_74(this.SliceToEnum, that.SliceToEnum) &&
-			deriveEqual_75(this.SliceToPtrToEnum, that.SliceToPtrToEnum) &&
-			deriveEqual_76(this.MapToEnum, that.MapToEnum) &&
-			deriveEqual_77(this.EnumToMap, that.EnumToMap) &&
+			deriveEqual_75(this.PtrToEnum, that.PtrToEnum) &&
+			deriveEqual_76(this.SliceToEnum, that.SliceToEnum) &&
+			deriveEqual_77(this.SliceToPtrToEnum, that.SliceToPtrToEnum) &&
+			deriveEqual_78(this.MapToEnum, that.MapToEnum) &&
+			deriveEqual_79(this.EnumToMap, that.EnumToMap) &&
 			this.ArrayEnum == that.ArrayEnum
 }
 
@@ -4062,9 +3698,9 @@ func deriveEqualPtrToEnums(this, that *Enums) bool {
 func deriveEqualPtrToNamedTypes(this, that *NamedTypes) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_7(this.Slice, that.Slice) &&
-			deriveEqual_78(this.PtrToSlice, that.PtrToSlice) &&
-			deriveEqual_79(this.SliceToSlice, that.SliceToSlice)
+			deriveEqual_8(this.Slice, that.Slice) &&
+			deriveEqual_80(this.PtrToSlice, that.PtrToSlice) &&
+			deriveEqual_81(this.SliceToSlice, that.SliceToSlice)
 }
 
 // deriveEqualPtrToTime returns whether this and that are equal.
@@ -4080,230 +3716,59 @@ func deriveEqualPtrToDuration(this, that *Duration) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
 			this.D == that.D &&
-			deriveEqual_80(this.P, that.P) &&
-			deriveEqual_81(this.Ds, that.Ds) &&
-			deriveEqual_82(this.DPs, that.DPs) &&
-			deriveEqual_83(this.MD, that.MD)
+			deriveEqual_82(this.P, that.P) &&
+			deriveEqual_83(this.Ds, that.Ds) &&
+			deriveEqual_84(this.DPs, that.DPs) &&
+			deriveEqual_85(this.MD, that.MD)
 }
 
 // deriveEqualPtrToNickname returns whether this and that are equal.
 func deriveEqualPtrToNickname(this, that *Nickname) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_84(this.Alias, that.Alias)
+			deriveEqual_86(this.Alias, that.Alias)
 }
 
 // deriveEqualPtrToPrivateEmbedded returns whether this and that are equal.
 func deriveEqualPtrToPrivateEmbedded(this, that *PrivateEmbedded) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_85(&this.privateStruct, &that.privateStruct)
+			deriveEqual_87(&this.privateStruct, &that.privateStruct)
 }
 
-// deriveEqualInefficientDeriveTheDerived returns whether this and that are equal.
-func deriveEqualInefficientDeriveTheDerived(this, that int) bool {
-	return this == that
+// deriveEqualPtrToTaggedFields returns whether this and that are equal.
+func deriveEqualPtrToTaggedFields(this, that *TaggedFields) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Name == that.Name &&
+			deriveEqual_88(&this.Version, &that.Version) &&
+			this.Parent.Equal(that.Parent)
 }
 
-// deriveEqualSliceOfint returns whether this and that are equal.
-func deriveEqualSliceOfint(this, that []int) bool {
-	if this == nil || that == nil {
-		return this == nil && that == nil
-	}
-	if len(this) != len(that) {
-		return false
-	}
-	for i := 0; i < len(this); i++ {
-		if !(this[i] == that[i]) {
-			return false
-		}
+// deriveEqualPtrToWithInterfaces returns whether this and that are equal.
+func deriveEqualPtrToWithInterfaces(this, that *WithInterfaces) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			deriveEqual_S(this.Shape, that.Shape) &&
+			deriveEqual_89(this.Shapes, that.Shapes) &&
+			deriveEqual_e(this.Err, that.Err) &&
+			deriveEqual_90(this.Any, that.Any)
+}
+
+// deriveCloneEmpty returns a clone of the src parameter.
+func deriveCloneEmpty(src *Empty) *Empty {
+	if src == nil {
+		return nil
 	}
-	return true
+	dst := new(Empty)
+	deriveDeepCopyPtrToEmpty(dst, src)
+	return dst
 }
 
-// deriveEqualMapOfintToint returns whether this and that are equal.
-func deriveEqualMapOfintToint(this, that map[int]int) bool {
-	if this == nil || that == nil {
-		return this == nil && that == nil
-	}
-	if len(this) != len(that) {
-		return false
-	}
-	for k, v := range this {
-		thatv, ok := that[k]
-		if !ok {
-			return false
-		}
-		if !(v == thatv) {
-			return false
-		}
-	}
-	return true
-}
-
-// deriveEqualPtrToint returns whether this and that are equal.
-func deriveEqualPtrToint(this, that *int) bool {
-	if this == nil && that == nil {
-		return true
-	}
-	if this != nil && that != nil {
-		return *this == *that
-	}
-	return false
-}
-
-// deriveEqualPtrToSliceOfint returns whether this and that are equal.
-func deriveEqualPtrToSliceOfint(this, that *[]int) bool {
-	if this == nil && that == nil {
-		return true
-	}
-	if this != nil && that != nil {
-		if *this == nil || *that == nil {
-			return *this == nil && *that == nil
-		}
-		if len(*this) != len(*that) {
-			return false
-		}
-		for i := 0; i < len(*this); i++ {
-			if !((*this)[i] == (*that)[i]) {
-				return false
-			}
-		}
-		return true
-	}
-	return false
-}
-
-// deriveEqualPtrToArray10Ofint returns whether this and that are equal.
-func deriveEqualPtrToArray10Ofint(this, that *[10]int) bool {
-	if this == nil && that == nil {
-		return true
-	}
-	if this != nil && that != nil {
-		for i := 0; i < len(*this); i++ {
-			if !((*this)[i] == (*that)[i]) {
-				return false
-			}
-		}
-		return true
-	}
-	return false
-}
-
-// deriveEqualPtrToMapOfintToint returns whether this and that are equal.
-func deriveEqualPtrToMapOfintToint(this, that *map[int]int) bool {
-	if this == nil && that == nil {
-		return true
-	}
-	if this != nil && that != nil {
-		if *this == nil || *that == nil {
-			return *this == nil && *that == nil
-		}
-		if len(*this) != len(*that) {
-			return false
-		}
-		for k, v := range *this {
-			thatv, ok := (*that)[k]
-			if !ok {
-				return false
-			}
-			if !(v == thatv) {
-				return false
-			}
-		}
-		return true
-	}
-	return false
-}
-
-// deriveEqual1 returns whether this and that are equal.
-func deriveEqual1(this, that BuiltInTypes) bool {
-	return this == that
-}
-
-// deriveEqualCurry returns an equal closure, with the first parameter already filled in.
-func deriveEqualCurry(this *BuiltInTypes) func(*BuiltInTypes) bool {
-	return func(that *BuiltInTypes) bool {
-		return (this == nil && that == nil) ||
-			this != nil && that != nil &&
-				this.Bool == that.Bool &&
-				this.Byte == that.Byte &&
-				this.Complex128 == that.Complex128 &&
-				this.Complex64 == that.Complex64 &&
-				this.Float64 == that.Float64 &&
-				this.Float32 == that.Float32 &&
-				this.Int == that.Int &&
-				this.Int16 == that.Int16 &&
-				this.Int32 == that.Int32 &&
-				this.Int64 == that.Int64 &&
-				this.Int8 == that.Int8 &&
-				this.Rune == that.Rune &&
-				this.String == that.String &&
-				this.Uint == that.Uint &&
-				this.Uint16 == that.Uint16 &&
-				this.Uint32 == that.Uint32 &&
-				this.Uint64 == that.Uint64 &&
-				this.Uint8 == that.Uint8 &&
-				this.UintPtr == that.UintPtr
-	}
-}
-
-// deriveEqualMapTypes returns whether this and that are equal.
-func deriveEqualMapTypes(this, that *SomeJson) bool {
-	return (this == nil && that == nil) ||
-		this != nil && that != nil &&
-			this.Name == that.Name &&
-			this.Other.Equal(that.Other)
-}
-
-// deriveEqual returns whether this and that are equal.
-func deriveEqual(this, that *UseVendor) bool {
-	return (this == nil && that == nil) ||
-		this != nil && that != nil &&
-			deriveEqual_86(this.Vendors, that.Vendors)
-}
-
-// deriveCurryMarshal returns a function that has one parameter, which corresponds to the input functions first parameter, and a result that is a function, which takes the rest of the parameters as input and finally returns the original input function's results.
-func deriveCurryMarshal(f func(data []byte, v interface{}) error) func(data []byte) func(v interface{}) error {
-	return func(data []byte) func(v interface{}) error {
-		return func(v interface{}) error {
-			return f(data, v)
-		}
-	}
-}
-
-// deriveCurry3 returns a function that has one parameter, which corresponds to the input functions first parameter, and a result that is a function, which takes the rest of the parameters as input and finally returns the original input function's results.
-func deriveCurry3(f func(a int, b string, c bool) string) func(a int) func(b string, c bool) string {
-	return func(a int) func(b string, c bool) string {
-		return func(b string, c bool) string {
-			return f(a, b, c)
-		}
-	}
-}
-
-// deriveCurryCurried returns a function that has one parameter, which corresponds to the input functions first parameter, and a result that is a function, which takes the rest of the parameters as input and finally returns the original input function's results.
-func deriveCurryCurried(f func(b string, c bool) string) func(b string) func(c bool) string {
-	return func(b string) func(c bool) string {
-		return func(c bool) string {
-			return f(b, c)
-		}
-	}
-}
-
-// deriveCloneEmpty returns a clone of the src parameter.
-func deriveCloneEmpty(src *Empty) *Empty {
-	if src == nil {
-		return nil
-	}
-	dst := new(Empty)
-	deriveDeepCopyPtrToEmpty(dst, src)
-	return dst
-}
-
-// deriveCloneBuiltInTypes returns a clone of the src parameter.
-func deriveCloneBuiltInTypes(src *BuiltInTypes) *BuiltInTypes {
-	if src == nil {
-		return nil
+// deriveCloneBuiltInTypes returns a clone of the src parameter.
+func deriveCloneBuiltInTypes(src *BuiltInTypes) *BuiltInTypes {
+	if src == nil {
+		return nil
 	}
 	dst := new(BuiltInTypes)
 	deriveDeepCopyPtrToBuiltInTypes(dst, src)
@@ -4320,320 +3785,6 @@ func deriveClonePtrToBuiltInTypes(src *PtrToBuiltInTypes) *PtrToBuiltInTypes {
 	return dst
 }
 
-// deriveCloneSliceOfint returns a clone of the src parameter.
-func deriveCloneSliceOfint(src []int) []int {
-	if src == nil {
-		return nil
-	}
-	dst := make([]int, len(src))
-	deriveDeepCopy_45(dst, src)
-	return dst
-}
-
-// deriveCloneMapOfintToint returns a clone of the src parameter.
-func deriveCloneMapOfintToint(src map[int]int) map[int]int {
-	if src == nil {
-		return nil
-	}
-	dst := make(map[int]int)
-	deriveDeepCopy_46(dst, src)
-	return dst
-}
-
-// deriveClonePtrToint returns a clone of the src parameter.
-func deriveClonePtrToint(src *int) *int {
-	if src == nil {
-		return nil
-	}
-	dst := new(int)
-	deriveDeepCopy_47(dst, src)
-	return dst
-}
-
-// deriveClonePtrToSliceOfint returns a clone of the src parameter.
-func deriveClonePtrToSliceOfint(src *[]int) *[]int {
-	if src == nil {
-		return nil
-	}
-	dst := new([]int)
-	deriveDeepCopy_26(dst, src)
-	return dst
-}
-
-// deriveClonePtrToArray10Ofint returns a clone of the src parameter.
-func deriveClonePtrToArray10Ofint(src *[10]int) *[10]int {
-	if src == nil {
-		return nil
-	}
-	dst := new([10]int)
-	deriveDeepCopy_48(dst, src)
-	return dst
-}
-
-// deriveClonePtrToMapOfintToint returns a clone of the src parameter.
-func deriveClonePtrToMapOfintToint(src *map[int]int) *map[int]int {
-	if src == nil {
-		return nil
-	}
-	dst := new(map[int]int)
-	deriveDeepCopy_27(dst, src)
-	return dst
-}
-
-// deriveClone1 returns a clone of the src parameter.
-func deriveClone1(src BuiltInTypes) BuiltInTypes {
-	dst := new(BuiltInTypes)
-	deriveDeepCopyPtrToBuiltInTypes(dst, &src)
-	return *dst
-}
-
-// deriveSortedInts sorts the slice inplace and also returns it.
-func deriveSortedInts(list []int) []int {
-	sort.Ints(list)
-	return list
-}
-
-// deriveSortInt64s sorts the slice inplace and also returns it.
-func deriveSortInt64s(list []int64) []int64 {
-	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
-	return list
-}
-
-// deriveSortStructs sorts the slice inplace and also returns it.
-func deriveSortStructs(list []*BuiltInTypes) []*BuiltInTypes {
-	sort.Slice(list, func(i, j int) bool { return deriveComparePtrToBuiltInTypes(list[i], list[j]) < 0 })
-	return list
-}
-
-// deriveSortedStrings sorts the slice inplace and also returns it.
-func deriveSortedStrings(list []string) []string {
-	sort.Strings(list)
-	return list
-}
-
-// deriveKeysForInt64s returns the keys of the input map as a slice.
-func deriveKeysForInt64s(m map[int64]struct{}) []int64 {
-	keys := make([]int64, 0, len(m))
-	for key := range m {
-		keys = append(keys, key)
-	}
-	return keys
-}
-
-// deriveKeysForFmap returns the keys of the input map as a slice.
-func deriveKeysForFmap(m map[int]string) []int {
-	keys := make([]int, 0, len(m))
-	for key := range m {
-		keys = append(keys, key)
-	}
-	return keys
-}
-
-// deriveKeysForMapStringToString returns the keys of the input map as a slice.
-func deriveKeysForMapStringToString(m map[string]string) []string {
-	keys := make([]string, 0, len(m))
-	for key := range m {
-		keys = append(keys, key)
-	}
-	return keys
-}
-
-// deriveKeysForMapIntToInt64 returns the keys of the input map as a slice.
-func deriveKeysForMapIntToInt64(m map[int]int64) []int {
-	keys := make([]int, 0, len(m))
-	for key := range m {
-		keys = append(keys, key)
-	}
-	return keys
-}
-
-// deriveKeysForMapInt64ToInt64 returns the keys of the input map as a slice.
-func deriveKeysForMapInt64ToInt64(m map[int64]int64) []int64 {
-	keys := make([]int64, 0, len(m))
-	for key := range m {
-		keys = append(keys, key)
-	}
-	return keys
-}
-
-// deriveJoinSS concatenates the list of lists into one list.
-func deriveJoinSS(listOfLists [][]string) []string {
-	if listOfLists == nil {
-		return nil
-	}
-	l := 0
-	for _, elem := range listOfLists {
-		l += len(elem)
-	}
-	res := make([]string, 0, l)
-	for _, elem := range listOfLists {
-		res = append(res, elem...)
-	}
-	return res
-}
-
-// deriveJoinEE returns the error or calls f and returns it's value and error.
-func deriveJoinEE(f func() (int64, error), err error) (int64, error) {
-	if err != nil {
-		return 0, err
-	}
-	return f()
-}
-
-// deriveJoinChannels listens on all channels resulting from the input channel and sends all their results on the output channel.
-func deriveJoinChannels(in <-chan (<-chan int)) <-chan int {
-	out := make(chan int)
-	go func() {
-		wait := sync.WaitGroup{}
-		for c := range in {
-			wait.Add(1)
-			res := c
-			go func() {
-				for r := range res {
-					out <- r
-				}
-				wait.Done()
-			}()
-		}
-		wait.Wait()
-		close(out)
-	}()
-	return out
-}
-
-// deriveJoin concatenates the list of lists into one list.
-func deriveJoin(listOfLists [][]int) []int {
-	if listOfLists == nil {
-		return nil
-	}
-	l := 0
-	for _, elem := range listOfLists {
-		l += len(elem)
-	}
-	res := make([]int, 0, l)
-	for _, elem := range listOfLists {
-		res = append(res, elem...)
-	}
-	return res
-}
-
-// deriveJoinString concatenates the list of strings into one string.
-func deriveJoinString(list []string) string {
-	return strings.Join(list, "")
-}
-
-// deriveJoinJustError returns the error or calls f and returns it's error.
-func deriveJoinJustError(f func() error, err error) error {
-	if err != nil {
-		return err
-	}
-	return f()
-}
-
-// deriveJoinErrorAndString returns the error or calls f and returns it's value and error.
-func deriveJoinErrorAndString(f func() (string, error), err error) (string, error) {
-	if err != nil {
-		return "", err
-	}
-	return f()
-}
-
-// deriveJoinErrorAndValues returns the error or calls f and returns it's value and error.
-func deriveJoinErrorAndValues(f func() (string, int, error), err error) (string, int, error) {
-	if err != nil {
-		return "", 0, err
-	}
-	return f()
-}
-
-// deriveJoinSendRecvChannels listens on all channels resulting from the input channel and sends all their results on the output channel.
-func deriveJoinSendRecvChannels(in chan (<-chan int64)) <-chan int64 {
-	out := make(chan int64)
-	go func() {
-		wait := sync.WaitGroup{}
-		for c := range in {
-			wait.Add(1)
-			res := c
-			go func() {
-				for r := range res {
-					out <- r
-				}
-				wait.Done()
-			}()
-		}
-		wait.Wait()
-		close(out)
-	}()
-	return out
-}
-
-// deriveJoinSliceOfRecvChannels listens on all input channels and sends all their results onto the single output channel.
-func deriveJoinSliceOfRecvChannels(in []<-chan int) <-chan int {
-	out := make(chan int)
-	go func() {
-		wait := sync.WaitGroup{}
-		for _, c := range in {
-			wait.Add(1)
-			res := c
-			go func() {
-				for r := range res {
-					out <- r
-				}
-				wait.Done()
-			}()
-		}
-		wait.Wait()
-		close(out)
-	}()
-	return out
-}
-
-// deriveJoinSliceOfSendRecvChannels listens on all input channels and sends all their results onto the single output channel.
-func deriveJoinSliceOfSendRecvChannels(in []chan int) <-chan int {
-	out := make(chan int)
-	go func() {
-		wait := sync.WaitGroup{}
-		for _, c := range in {
-			wait.Add(1)
-			res := c
-			go func() {
-				for r := range res {
-					out <- r
-				}
-				wait.Done()
-			}()
-		}
-		wait.Wait()
-		close(out)
-	}()
-	return out
-}
-
-// deriveJoinVariantOfSendRecvChannels listens on all input channels c0 and c1, and sends all their results onto the single output channel.
-func deriveJoinVariantOfSendRecvChannels(c0 chan int, c1 chan int) <-chan int {
-	out := make(chan int)
-	go func() {
-		for c0 != nil || c1 != nil {
-			select {
-			case v0, ok0 := <-c0:
-				if !ok0 {
-					c0 = nil
-				} else {
-					out <- v0
-				}
-			case v1, ok1 := <-c1:
-				if !ok1 {
-					c1 = nil
-				} else {
-					out <- v1
-				}
-			}
-		}
-		close(out)
-	}()
-	return out
-}
-
 // deriveHashEmpty returns the hash of the object.
 func deriveHashEmpty(object *Empty) uint64 {
 	if object == nil {
@@ -4710,19 +3861,19 @@ func deriveHashPtrToBuiltInTypes(object *PtrToBuiltInTypes) uint64 {
 	h = 31*h + deriveHash_4(object.Complex64)
 	h = 31*h + deriveHash_5(object.Float64)
 	h = 31*h + deriveHash_6(object.Float32)
-	h = 31*h + deriveHashPtrToint(object.Int)
-	h = 31*h + deriveHash_7(object.Int16)
-	h = 31*h + deriveHash_8(object.Int32)
-	h = 31*h + deriveHash_9(object.Int64)
-	h = 31*h + deriveHash_10(object.Int8)
-	h = 31*h + deriveHash_8(object.Rune)
-	h = 31*h + deriveHash_11(object.String)
-	h = 31*h + deriveHash_12(object.Uint)
-	h = 31*h + deriveHash_13(object.Uint16)
-	h = 31*h + deriveHash_14(object.Uint32)
-	h = 31*h + deriveHash_15(object.Uint64)
+	h = 31*h + deriveHash_7(object.Int)
+	h = 31*h + deriveHash_8(object.Int16)
+	h = 31*h + deriveHash_9(object.Int32)
+	h = 31*h + deriveHash_10(object.Int64)
+	h = 31*h + deriveHash_11(object.Int8)
+	h = 31*h + deriveHash_9(object.Rune)
+	h = 31*h + deriveHash_12(object.String)
+	h = 31*h + deriveHash_13(object.Uint)
+	h = 31*h + deriveHash_14(object.Uint16)
+	h = 31*h + deriveHash_15(object.Uint32)
+	h = 31*h + deriveHash_16(object.Uint64)
 	h = 31*h + deriveHash_2(object.Uint8)
-	h = 31*h + deriveHash_16(object.UintPtr)
+	h = 31*h + deriveHash_17(object.UintPtr)
 	return h
 }
 
@@ -4732,25 +3883,25 @@ func deriveHashSliceOfBuiltInTypes(object *SliceOfBuiltInTypes) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_17(object.Bool)
-	h = 31*h + deriveHash_18(object.Byte)
-	h = 31*h + deriveHash_19(object.Complex128)
-	h = 31*h + deriveHash_20(object.Complex64)
-	h = 31*h + deriveHash_21(object.Float64)
-	h = 31*h + deriveHash_22(object.Float32)
-	h = 31*h + deriveHashSliceOfint(object.Int)
-	h = 31*h + deriveHash_23(object.Int16)
-	h = 31*h + deriveHash_24(object.Int32)
-	h = 31*h + deriveHash_25(object.Int64)
-	h = 31*h + deriveHash_26(object.Int8)
-	h = 31*h + deriveHash_24(object.Rune)
-	h = 31*h + deriveHash_27(object.String)
-	h = 31*h + deriveHash_28(object.Uint)
-	h = 31*h + deriveHash_29(object.Uint16)
-	h = 31*h + deriveHash_30(object.Uint32)
-	h = 31*h + deriveHash_31(object.Uint64)
-	h = 31*h + deriveHash_18(object.Uint8)
-	h = 31*h + deriveHash_32(object.UintPtr)
+	h = 31*h + deriveHash_18(object.Bool)
+	h = 31*h + deriveHash_19(object.Byte)
+	h = 31*h + deriveHash_20(object.Complex128)
+	h = 31*h + deriveHash_21(object.Complex64)
+	h = 31*h + deriveHash_22(object.Float64)
+	h = 31*h + deriveHash_23(object.Float32)
+	h = 31*h + deriveHash_24(object.Int)
+	h = 31*h + deriveHash_25(object.Int16)
+	h = 31*h + deriveHash_26(object.Int32)
+	h = 31*h + deriveHash_27(object.Int64)
+	h = 31*h + deriveHash_28(object.Int8)
+	h = 31*h + deriveHash_26(object.Rune)
+	h = 31*h + deriveHash_29(object.String)
+	h = 31*h + deriveHash_30(object.Uint)
+	h = 31*h + deriveHash_31(object.Uint16)
+	h = 31*h + deriveHash_32(object.Uint32)
+	h = 31*h + deriveHash_33(object.Uint64)
+	h = 31*h + deriveHash_19(object.Uint8)
+	h = 31*h + deriveHash_34(object.UintPtr)
 	return h
 }
 
@@ -4760,25 +3911,25 @@ func deriveHashSliceOfPtrToBuiltInTypes(object *SliceOfPtrToBuiltInTypes) uint64
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_33(object.Bool)
-	h = 31*h + deriveHash_34(object.Byte)
-	h = 31*h + deriveHash_35(object.Complex128)
-	h = 31*h + deriveHash_36(object.Complex64)
-	h = 31*h + deriveHash_37(object.Float64)
-	h = 31*h + deriveHash_38(object.Float32)
-	h = 31*h + deriveHash_39(object.Int)
-	h = 31*h + deriveHash_40(object.Int16)
-	h = 31*h + deriveHash_41(object.Int32)
-	h = 31*h + deriveHash_42(object.Int64)
-	h = 31*h + deriveHash_43(object.Int8)
-	h = 31*h + deriveHash_41(object.Rune)
-	h = 31*h + deriveHash_44(object.String)
-	h = 31*h + deriveHash_45(object.Uint)
-	h = 31*h + deriveHash_46(object.Uint16)
-	h = 31*h + deriveHash_47(object.Uint32)
-	h = 31*h + deriveHash_48(object.Uint64)
-	h = 31*h + deriveHash_34(object.Uint8)
-	h = 31*h + deriveHash_49(object.UintPtr)
+	h = 31*h + deriveHash_35(object.Bool)
+	h = 31*h + deriveHash_36(object.Byte)
+	h = 31*h + deriveHash_37(object.Complex128)
+	h = 31*h + deriveHash_38(object.Complex64)
+	h = 31*h + deriveHash_39(object.Float64)
+	h = 31*h + deriveHash_40(object.Float32)
+	h = 31*h + deriveHash_41(object.Int)
+	h = 31*h + deriveHash_42(object.Int16)
+	h = 31*h + deriveHash_43(object.Int32)
+	h = 31*h + deriveHash_44(object.Int64)
+	h = 31*h + deriveHash_45(object.Int8)
+	h = 31*h + deriveHash_43(object.Rune)
+	h = 31*h + deriveHash_46(object.String)
+	h = 31*h + deriveHash_47(object.Uint)
+	h = 31*h + deriveHash_48(object.Uint16)
+	h = 31*h + deriveHash_49(object.Uint32)
+	h = 31*h + deriveHash_50(object.Uint64)
+	h = 31*h + deriveHash_36(object.Uint8)
+	h = 31*h + deriveHash_51(object.UintPtr)
 	return h
 }
 
@@ -4788,26 +3939,26 @@ func deriveHashArrayOfBuiltInTypes(object *ArrayOfBuiltInTypes) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_50(object.Bool)
-	h = 31*h + deriveHash_51(object.Byte)
-	h = 31*h + deriveHash_52(object.Complex128)
-	h = 31*h + deriveHash_53(object.Complex64)
-	h = 31*h + deriveHash_54(object.Float64)
-	h = 31*h + deriveHash_55(object.Float32)
-	h = 31*h + deriveHash_56(object.Int)
-	h = 31*h + deriveHash_57(object.Int16)
-	h = 31*h + deriveHash_58(object.Int32)
-	h = 31*h + deriveHash_59(object.Int64)
-	h = 31*h + deriveHash_60(object.Int8)
-	h = 31*h + deriveHash_61(object.Rune)
-	h = 31*h + deriveHash_62(object.String)
-	h = 31*h + deriveHash_63(object.Uint)
-	h = 31*h + deriveHash_64(object.Uint16)
-	h = 31*h + deriveHash_65(object.Uint32)
-	h = 31*h + deriveHash_66(object.Uint64)
-	h = 31*h + deriveHash_67(object.Uint8)
-	h = 31*h + deriveHash_68(object.UintPtr)
-	h = 31*h + deriveHash_69(object.AnotherBoolOfDifferentSize)
+	h = 31*h + deriveHash_52(object.Bool)
+	h = 31*h + deriveHash_53(object.Byte)
+	h = 31*h + deriveHash_54(object.Complex128)
+	h = 31*h + deriveHash_55(object.Complex64)
+	h = 31*h + deriveHash_56(object.Float64)
+	h = 31*h + deriveHash_57(object.Float32)
+	h = 31*h + deriveHash_58(object.Int)
+	h = 31*h + deriveHash_59(object.Int16)
+	h = 31*h + deriveHash_60(object.Int32)
+	h = 31*h + deriveHash_61(object.Int64)
+	h = 31*h + deriveHash_62(object.Int8)
+	h = 31*h + deriveHash_63(object.Rune)
+	h = 31*h + deriveHash_64(object.String)
+	h = 31*h + deriveHash_65(object.Uint)
+	h = 31*h + deriveHash_66(object.Uint16)
+	h = 31*h + deriveHash_67(object.Uint32)
+	h = 31*h + deriveHash_68(object.Uint64)
+	h = 31*h + deriveHash_69(object.Uint8)
+	h = 31*h + deriveHash_70(object.UintPtr)
+	h = 31*h + deriveHash_71(object.AnotherBoolOfDifferentSize)
 	return h
 }
 
@@ -4817,26 +3968,26 @@ func deriveHashArrayOfPtrToBuiltInTypes(object *ArrayOfPtrToBuiltInTypes) uint64
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_70(object.Bool)
-	h = 31*h + deriveHash_71(object.Byte)
-	h = 31*h + deriveHash_72(object.Complex128)
-	h = 31*h + deriveHash_73(object.Complex64)
-	h = 31*h + deriveHash_74(object.Float64)
-	h = 31*h + deriveHash_75(object.Float32)
-	h = 31*h + deriveHash_76(object.Int)
-	h = 31*h + deriveHash_77(object.Int16)
-	h = 31*h + deriveHash_78(object.Int32)
-	h = 31*h + deriveHash_79(object.Int64)
-	h = 31*h + deriveHash_80(object.Int8)
-	h = 31*h + deriveHash_81(object.Rune)
-	h = 31*h + deriveHash_82(object.String)
-	h = 31*h + deriveHash_83(object.Uint)
-	h = 31*h + deriveHash_84(object.Uint16)
-	h = 31*h + deriveHash_85(object.Uint32)
-	h = 31*h + deriveHash_86(object.Uint64)
-	h = 31*h + deriveHash_87(object.Uint8)
-	h = 31*h + deriveHash_88(object.UintPtr)
-	h = 31*h + deriveHash_89(object.AnotherBoolOfDifferentSize)
+	h = 31*h + deriveHash_72(object.Bool)
+	h = 31*h + deriveHash_73(object.Byte)
+	h = 31*h + deriveHash_74(object.Complex128)
+	h = 31*h + deriveHash_75(object.Complex64)
+	h = 31*h + deriveHash_76(object.Float64)
+	h = 31*h + deriveHash_77(object.Float32)
+	h = 31*h + deriveHash_78(object.Int)
+	h = 31*h + deriveHash_79(object.Int16)
+	h = 31*h + deriveHash_80(object.Int32)
+	h = 31*h + deriveHash_81(object.Int64)
+	h = 31*h + deriveHash_82(object.Int8)
+	h = 31*h + deriveHash_83(object.Rune)
+	h = 31*h + deriveHash_84(object.String)
+	h = 31*h + deriveHash_85(object.Uint)
+	h = 31*h + deriveHash_86(object.Uint16)
+	h = 31*h + deriveHash_87(object.Uint32)
+	h = 31*h + deriveHash_88(object.Uint64)
+	h = 31*h + deriveHash_89(object.Uint8)
+	h = 31*h + deriveHash_90(object.UintPtr)
+	h = 31*h + deriveHash_91(object.AnotherBoolOfDifferentSize)
 	return h
 }
 
@@ -4846,8 +3997,8 @@ func deriveHashMapsOfSimplerBuiltInTypes(object *MapsOfSimplerBuiltInTypes) uint
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_90(object.StringToUint32)
-	h = 31*h + deriveHash_91(object.Uint64ToInt64)
+	h = 31*h + deriveHash_92(object.StringToUint32)
+	h = 31*h + deriveHash_93(object.Uint64ToInt64)
 	return h
 }
 
@@ -4857,11 +4008,11 @@ func deriveHashMapsOfBuiltInTypes(object *MapsOfBuiltInTypes) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_92(object.BoolToString)
-	h = 31*h + deriveHash_93(object.StringToBool)
-	h = 31*h + deriveHash_94(object.Complex128ToComplex64)
-	h = 31*h + deriveHash_95(object.Float64ToUint32)
-	h = 31*h + deriveHash_96(object.Uint16ToUint8)
+	h = 31*h + deriveHash_94(object.BoolToString)
+	h = 31*h + deriveHash_95(object.StringToBool)
+	h = 31*h + deriveHash_96(object.Complex128ToComplex64)
+	h = 31*h + deriveHash_97(object.Float64ToUint32)
+	h = 31*h + deriveHash_98(object.Uint16ToUint8)
 	return h
 }
 
@@ -4871,9 +4022,9 @@ func deriveHashSliceToSlice(object *SliceToSlice) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_97(object.Ints)
-	h = 31*h + deriveHash_98(object.Strings)
-	h = 31*h + deriveHash_99(object.IntPtrs)
+	h = 31*h + deriveHash_99(object.Ints)
+	h = 31*h + deriveHash_100(object.Strings)
+	h = 31*h + deriveHash_101(object.IntPtrs)
 	return h
 }
 
@@ -4883,10 +4034,10 @@ func deriveHashPtrTo(object *PtrTo) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHashPtrToint(object.Basic)
-	h = 31*h + deriveHashPtrToSliceOfint(object.Slice)
-	h = 31*h + deriveHash_100(object.Array)
-	h = 31*h + deriveHashPtrToMapOfintToint(object.Map)
+	h = 31*h + deriveHash_7(object.Basic)
+	h = 31*h + deriveHash_102(object.Slice)
+	h = 31*h + deriveHash_103(object.Array)
+	h = 31*h + deriveHash_104(object.Map)
 	return h
 }
 
@@ -4908,8 +4059,8 @@ func deriveHashStructs(object *Structs) uint64 {
 	h := uint64(17)
 	h = 31*h + deriveHash_N(object.Struct)
 	h = 31*h + deriveHashName(object.PtrToStruct)
-	h = 31*h + deriveHash_101(object.SliceOfStructs)
-	h = 31*h + deriveHash_102(object.SliceToPtrOfStruct)
+	h = 31*h + deriveHash_105(object.SliceOfStructs)
+	h = 31*h + deriveHash_106(object.SliceToPtrOfStruct)
 	return h
 }
 
@@ -4919,11 +4070,11 @@ func deriveHashMapWithStructs(object *MapWithStructs) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_103(object.NameToString)
-	h = 31*h + deriveHash_104(object.StringToName)
-	h = 31*h + deriveHash_105(object.StringToPtrToName)
-	h = 31*h + deriveHash_106(object.StringToSliceOfName)
-	h = 31*h + deriveHash_107(object.StringToSliceOfPtrToName)
+	h = 31*h + deriveHash_107(object.NameToString)
+	h = 31*h + deriveHash_108(object.StringToName)
+	h = 31*h + deriveHash_109(object.StringToPtrToName)
+	h = 31*h + deriveHash_110(object.StringToSliceOfName)
+	h = 31*h + deriveHash_111(object.StringToSliceOfPtrToName)
 	return h
 }
 
@@ -4933,8 +4084,8 @@ func deriveHashRecursiveType(object *RecursiveType) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_18(object.Bytes)
-	h = 31*h + deriveHash_108(object.N)
+	h = 31*h + deriveHash_19(object.Bytes)
+	h = 31*h + deriveHash_112(object.N)
 	return h
 }
 
@@ -4963,560 +4114,147 @@ func deriveHashEmbeddedStruct2(object *EmbeddedStruct2) uint64 {
 // deriveHashStructWithStructFieldWithoutEqualMethod returns the hash of the object.
 func deriveHashStructWithStructFieldWithoutEqualMethod(object *StructWithStructFieldWithoutEqualMethod) uint64 {
 	if object == nil {
-		return 0
-	}
-	h := uint64(17)
-	h = 31*h + deriveHash_109(object.A)
-	h = 31*h + deriveHash_St(object.B)
-	return h
-}
-
-// deriveHashStructWithStructWithFromAnotherPackage returns the hash of the object.
-func deriveHashStructWithStructWithFromAnotherPackage(object *StructWithStructWithFromAnotherPackage) uint64 {
-	if object == nil {
-		return 0
-	}
-	h := uint64(17)
-	h = 31*h + deriveHash_110(object.A)
-	h = 31*h + deriveHash_Str(object.B)
-	return h
-}
-
-// deriveHashEnums returns the hash of the object.
-func deriveHashEnums(object *Enums) uint64 {
-	if object == nil {
-		return 0
-	}
-	h := uint64(17)
-	h = 31*h + uint64(object.Enum)
-	h = 31*h + deriveHash_111(object.PtrToEnum)
-	h = 31*h + deriveHash_112(object.SliceToEnum)
-	h = 31*h + deriveHash_113(object.SliceToPtrToEnum)
-	h = 31*h + deriveHash_114(object.MapToEnum)
-	h = 31*h + deriveHash_115(object.EnumToMap)
-	h = 31*h + deriveHash_116(object.ArrayEnum)
-	return h
-}
-
-// deriveHashNamedTypes returns the hash of the object.
-func deriveHashNamedTypes(object *NamedTypes) uint64 {
-	if object == nil {
-		return 0
-	}
-	h := uint64(17)
-	h = 31*h + deriveHash_25(object.Slice)
-	h = 31*h + deriveHash_117(object.PtrToSlice)
-	h = 31*h + deriveHash_118(object.SliceToSlice)
-	return h
-}
-
-// deriveHashDuration returns the hash of the object.
-func deriveHashDuration(object *Duration) uint64 {
-	if object == nil {
-		return 0
-	}
-	h := uint64(17)
-	h = 31*h + uint64(object.D)
-	h = 31*h + deriveHash_119(object.P)
-	h = 31*h + deriveHash_120(object.Ds)
-	h = 31*h + deriveHash_121(object.DPs)
-	h = 31*h + deriveHash_122(object.MD)
-	return h
-}
-
-// deriveHashNickname returns the hash of the object.
-func deriveHashNickname(object *Nickname) uint64 {
-	if object == nil {
-		return 0
-	}
-	h := uint64(17)
-	h = 31*h + deriveHash_123(object.Alias)
-	return h
-}
-
-// deriveHashPrivateEmbedded returns the hash of the object.
-func deriveHashPrivateEmbedded(object *PrivateEmbedded) uint64 {
-	if object == nil {
-		return 0
-	}
-	h := uint64(17)
-	h = 31*h + deriveHash_p(object.privateStruct)
-	return h
-}
-
-// deriveHashSliceOfint returns the hash of the object.
-func deriveHashSliceOfint(object []int) uint64 {
-	if object == nil {
-		return 0
-	}
-	h := uint64(17)
-	for i := 0; i < len(object); i++ {
-		h = 31*h + uint64(object[i])
-	}
-	return h
-}
-
-// deriveHashMapOfintToint returns the hash of the object.
-func deriveHashMapOfintToint(object map[int]int) uint64 {
-	if object == nil {
-		return 0
-	}
-	h := uint64(17)
-	for _, k := range deriveSortedInts(deriveKeys(object)) {
-		h = 31*h + uint64(k)
-		h = 31*h + uint64(object[k])
-	}
-	return h
-}
-
-// deriveHashPtrToint returns the hash of the object.
-func deriveHashPtrToint(object *int) uint64 {
-	if object == nil {
-		return 0
-	}
-	return (31 * 17) + uint64(*object)
-}
-
-// deriveHashPtrToSliceOfint returns the hash of the object.
-func deriveHashPtrToSliceOfint(object *[]int) uint64 {
-	if object == nil {
-		return 0
-	}
-	return (31 * 17) + deriveHashSliceOfint(*object)
-}
-
-// deriveHashPtrToArray10Ofint returns the hash of the object.
-func deriveHashPtrToArray10Ofint(object *[10]int) uint64 {
-	if object == nil {
-		return 0
-	}
-	return (31 * 17) + deriveHash_124(*object)
-}
-
-// deriveHashPtrToMapOfintToint returns the hash of the object.
-func deriveHashPtrToMapOfintToint(object *map[int]int) uint64 {
-	if object == nil {
-		return 0
-	}
-	return (31 * 17) + deriveHashMapOfintToint(*object)
-}
-
-// deriveHash1 returns the hash of the object.
-func deriveHash1(object BuiltInTypes) uint64 {
-	return deriveHashBuiltInTypes(&object)
-}
-
-// deriveFmapForKeys returns a list where each element of the input list has been morphed by the input function.
-func deriveFmapForKeys(f func(int) string, list []int) []string {
-	out := make([]string, len(list))
-	for i, elem := range list {
-		out[i] = f(elem)
-	}
-	return out
-}
-
-// deriveFmap returns a list where each element of the input list has been morphed by the input function.
-func deriveFmap(f func(int) int, list []int) []int {
-	out := make([]int, len(list))
-	for i, elem := range list {
-		out[i] = f(elem)
-	}
-	return out
-}
-
-// deriveFmapString morphs a string into list by apply the input function to each rune.
-func deriveFmapString(f func(rune) bool, ss string) []bool {
-	out := make([]bool, len([]rune(ss)))
-	for i, elem := range ss {
-		out[i] = f(elem)
-	}
-	return out
-}
-
-// deriveFmapError returns an error if g returns one, otherwise it applies f to g's result and returns it.
-func deriveFmapError(f func(int) int64, g func() (int, error)) (int64, error) {
-	v, err := g()
-	if err != nil {
-		return 0, err
-	}
-	return f(v), nil
-}
-
-// deriveFmapEE returns an error if g returns one, otherwise it applies f to g's result and returns it.
-func deriveFmapEE(f func(string) (int, error), g func() (string, error)) (func() (int, error), error) {
-	v, err := g()
-	if err != nil {
-		return nil, err
-	}
-	return deriveTuple(f(v)), nil
-}
-
-// deriveFmapPrint returns an error if g returns one, otherwise it applies f to g's result.
-func deriveFmapPrint(f func(string), g func() (string, error)) error {
-	v, err := g()
-	if err != nil {
-		return err
-	}
-	f(v)
-	return nil
-}
-
-// deriveFmapMore returns an error if g returns one, otherwise it applies f to g's result and returns it.
-func deriveFmapMore(f func(string) (int, string, error), g func() (string, error)) (func() (int, string, error), error) {
-	v, err := g()
-	if err != nil {
-		return nil, err
-	}
-	return deriveTuple_(f(v)), nil
-}
-
-// deriveFmapChan returns an output channel where the items are the result of the input function being applied to the items on the input channel.
-func deriveFmapChan(f func(string) int, in <-chan string) <-chan int {
-	out := make(chan int, cap(in))
-	go func() {
-		for a := range in {
-			b := f(a)
-			out <- b
-		}
-		close(out)
-	}()
-	return out
-}
-
-// deriveFmapSS returns a list where each element of the input list has been morphed by the input function.
-func deriveFmapSS(f func(string) []string, list []string) [][]string {
-	out := make([][]string, len(list))
-	for i, elem := range list {
-		out[i] = f(elem)
-	}
-	return out
-}
-
-// deriveFmapEE64 returns an error if g returns one, otherwise it applies f to g's result and returns it.
-func deriveFmapEE64(f func(string) (int64, error), g func() (string, error)) (func() (int64, error), error) {
-	v, err := g()
-	if err != nil {
-		return nil, err
-	}
-	return deriveTuple_i(f(v)), nil
-}
-
-// deriveFmapChanChan returns an output channel where the items are the result of the input function being applied to the items on the input channel.
-func deriveFmapChanChan(f func(string) <-chan int, in <-chan string) <-chan (<-chan int) {
-	out := make(chan (<-chan int), cap(in))
-	go func() {
-		for a := range in {
-			b := f(a)
-			out <- b
-		}
-		close(out)
-	}()
-	return out
-}
-
-// deriveFlipMarshal returns the input function, but where first two parameters are flipped.
-func deriveFlipMarshal(f func(data []byte, v interface{}) error) func(v interface{}, data []byte) error {
-	return func(v interface{}, data []byte) error {
-		return f(data, v)
-	}
-}
-
-// deriveFlip3 returns the input function, but where first two parameters are flipped.
-func deriveFlip3(f func(a int, b string, c bool) string) func(b string, a int, c bool) string {
-	return func(b string, a int, c bool) string {
-		return f(a, b, c)
-	}
-}
-
-// deriveSetInt64s returns the input list as a map with the items of the list as the keys of the map.
-func deriveSetInt64s(list []int64) map[int64]struct{} {
-	set := make(map[int64]struct{}, len(list))
-	for _, v := range list {
-		set[v] = struct{}{}
-	}
-	return set
-}
-
-// deriveMinInt64s returns the minimum value from the list, or the default value if the list is empty.
-func deriveMinInt64s(list []int64, def int64) int64 {
-	if len(list) == 0 {
-		return def
-	}
-	m := list[0]
-	list = list[1:]
-	for i, v := range list {
-		if v < m {
-			m = list[i]
-		}
-	}
-	return m
-}
-
-// deriveMinInt returns the mimimum of the two input values.
-func deriveMinInt(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
-}
-
-// deriveMinStructs returns the minimum value from the list, or the default value if the list is empty.
-func deriveMinStructs(list []*BuiltInTypes, def *BuiltInTypes) *BuiltInTypes {
-	if len(list) == 0 {
-		return def
-	}
-	m := list[0]
-	list = list[1:]
-	for i, v := range list {
-		if deriveComparePtrToBuiltInTypes(v, m) < 0 {
-			m = list[i]
-		}
-	}
-	return m
-}
-
-// deriveMemGet returns a memoized version of the input function.
-func deriveMemGet(f func() *BuiltInTypes) func() *BuiltInTypes {
-	memoized := false
-	var res0 *BuiltInTypes
-	return func() *BuiltInTypes {
-		if !memoized {
-			res0 = f()
-			memoized = true
-		}
-		return res0
-	}
-}
-
-// deriveMemInc returns a memoized version of the input function.
-func deriveMemInc(f func(n int) int) func(n int) int {
-	m := make(map[int]int)
-	return func(param0 int) int {
-		if v, ok := m[param0]; ok {
-			return v
-		}
-		v := f(param0)
-		m[param0] = v
-		return v
-	}
-}
-
-// deriveMemIncTo returns a memoized version of the input function.
-func deriveMemIncTo(f func(a Adder) int) func(a Adder) int {
-	m := make(map[Adder]int)
-	return func(param0 Adder) int {
-		if v, ok := m[param0]; ok {
-			return v
-		}
-		v := f(param0)
-		m[param0] = v
-		return v
-	}
-}
-
-// deriveMemAdd returns a memoized version of the input function.
-func deriveMemAdd(f func(a int, b int) int) func(a int, b int) int {
-	type input struct {
-		Param0 int
-		Param1 int
-	}
-	m := make(map[input]int)
-	return func(param0 int, param1 int) int {
-		in := input{param0, param1}
-		if v, ok := m[in]; ok {
-			return v
-		}
-		v := f(param0, param1)
-		m[in] = v
-		return v
+		return 0
 	}
+	h := uint64(17)
+	h = 31*h + deriveHash_113(object.A)
+	h = 31*h + deriveHash_St(object.B)
+	return h
 }
 
-// deriveMemAddTo returns a memoized version of the input function.
-func deriveMemAddTo(f func(a Adder, b int) int) func(a Adder, b int) int {
-	type input struct {
-		Param0 Adder
-		Param1 int
-	}
-	m := make(map[input]int)
-	return func(param0 Adder, param1 int) int {
-		in := input{param0, param1}
-		if v, ok := m[in]; ok {
-			return v
-		}
-		v := f(param0, param1)
-		m[in] = v
-		return v
+// deriveHashStructWithStructWithFromAnotherPackage returns the hash of the object.
+func deriveHashStructWithStructWithFromAnotherPackage(object *StructWithStructWithFromAnotherPackage) uint64 {
+	if object == nil {
+		return 0
 	}
+	h := uint64(17)
+	h = 31*h + deriveHash_114(object.A)
+	h = 31*h + deriveHash_Str(object.B)
+	return h
 }
 
-// deriveMemSet returns a memoized version of the input function.
-func deriveMemSet(f func(a *BuiltInTypes, b int) *BuiltInTypes) func(a *BuiltInTypes, b int) *BuiltInTypes {
-	type input struct {
-		Param0 *BuiltInTypes
-		Param1 int
-	}
-	type mem struct {
-		in  input
-		out *BuiltInTypes
-	}
-	m := make(map[uint64][]mem)
-	return func(param0 *BuiltInTypes, param1 int) *BuiltInTypes {
-		in := input{param0, param1}
-		h := deriveHash_125(in)
-		vs, ok := m[h]
-		if ok {
-			for _, v := range vs {
-				if deriveEqual_87(v.in, in) {
-					return v.out
-				}
-			}
-		}
-		res0 := f(param0, param1)
-		m[h] = append(m[h], mem{in, res0})
-		return res0
+// deriveHashEnums returns the hash of the object.
+func deriveHashEnums(object *Enums) uint64 {
+	if object == nil {
+		return 0
 	}
+	h := uint64(17)
+	h = 31*h + uint64(object.Enum)
+	h = 31*h + deriveHash_115(object.PtrToEnum)
+	h = 31*h + deriveHash_116(object.SliceToEnum)
+	h = 31*h + deriveHash_117(object.SliceToPtrToEnum)
+	h = 31*h + deriveHash_118(object.MapToEnum)
+	h = 31*h + deriveHash_119(object.EnumToMap)
+	h = 31*h + deriveHash_120(object.ArrayEnum)
+	return h
 }
 
-// deriveMemSetErr returns a memoized version of the input function.
-func deriveMemSetErr(f func(a *BuiltInTypes, b int) (*BuiltInTypes, error)) func(a *BuiltInTypes, b int) (*BuiltInTypes, error) {
-	type input struct {
-		Param0 *BuiltInTypes
-		Param1 int
-	}
-	type output struct {
-		Res0 *BuiltInTypes
-		Res1 error
-	}
-	type mem struct {
-		in  input
-		out output
-	}
-	m := make(map[uint64][]mem)
-	return func(param0 *BuiltInTypes, param1 int) (*BuiltInTypes, error) {
-		in := input{param0, param1}
-		h := deriveHash_125(in)
-		vs, ok := m[h]
-		if ok {
-			for _, v := range vs {
-				if deriveEqual_87(v.in, in) {
-					return v.out.Res0, v.out.Res1
-				}
-			}
-		}
-		res0, res1 := f(param0, param1)
-		m[h] = append(m[h], mem{in, output{res0, res1}})
-		return res0, res1
+// deriveHashNamedTypes returns the hash of the object.
+func deriveHashNamedTypes(object *NamedTypes) uint64 {
+	if object == nil {
+		return 0
 	}
+	h := uint64(17)
+	h = 31*h + deriveHash_27(object.Slice)
+	h = 31*h + deriveHash_121(object.PtrToSlice)
+	h = 31*h + deriveHash_122(object.SliceToSlice)
+	return h
 }
 
-// deriveMaxInt64s returns the maximum value from the input list and the default value, if the list is empty.
-func deriveMaxInt64s(list []int64, def int64) int64 {
-	if len(list) == 0 {
-		return def
-	}
-	m := list[0]
-	list = list[1:]
-	for i, v := range list {
-		if v > m {
-			m = list[i]
-		}
+// deriveHashDuration returns the hash of the object.
+func deriveHashDuration(object *Duration) uint64 {
+	if object == nil {
+		return 0
 	}
-	return m
+	h := uint64(17)
+	h = 31*h + uint64(object.D)
+	h = 31*h + deriveHash_123(object.P)
+	h = 31*h + deriveHash_124(object.Ds)
+	h = 31*h + deriveHash_125(object.DPs)
+	h = 31*h + deriveHash_126(object.MD)
+	return h
 }
 
-// deriveMaxInt returns the maximum of the two input values.
-func deriveMaxInt(a, b int) int {
-	if a > b {
-		return a
+// deriveHashNickname returns the hash of the object.
+func deriveHashNickname(object *Nickname) uint64 {
+	if object == nil {
+		return 0
 	}
-	return b
+	h := uint64(17)
+	h = 31*h + deriveHash_127(object.Alias)
+	return h
 }
 
-// deriveMaxStructs returns the maximum value from the input list and the default value, if the list is empty.
-func deriveMaxStructs(list []*BuiltInTypes, def *BuiltInTypes) *BuiltInTypes {
-	if len(list) == 0 {
-		return def
-	}
-	m := list[0]
-	list = list[1:]
-	for i, v := range list {
-		if deriveComparePtrToBuiltInTypes(v, m) > 0 {
-			m = list[i]
-		}
+// deriveHashPrivateEmbedded returns the hash of the object.
+func deriveHashPrivateEmbedded(object *PrivateEmbedded) uint64 {
+	if object == nil {
+		return 0
 	}
-	return m
-}
-
-// deriveDup duplicates messages received on c to both c1 and c2.
-func deriveDup(c chan int) (c1, c2 <-chan int) {
-	cc1, cc2 := make(chan int, cap(c)), make(chan int, cap(c))
-	go func() {
-		for v := range c {
-			cc1 <- v
-			cc2 <- v
-		}
-		close(cc1)
-		close(cc2)
-	}()
-	return cc1, cc2
+	h := uint64(17)
+	h = 31*h + deriveHash_p(object.privateStruct)
+	return h
 }
 
-// deriveAny reports whether the predicate returns true for any of the elements in the given slice.
-func deriveAny(pred func(int) bool, list []int) bool {
-	for _, elem := range list {
-		if pred(elem) {
-			return true
-		}
+// deriveHashTaggedFields returns the hash of the object.
+func deriveHashTaggedFields(object *TaggedFields) uint64 {
+	if object == nil {
+		return 0
 	}
-	return false
+	h := uint64(17)
+	h = 31*h + deriveHash_(object.Name)
+	h = 31*h + deriveHash_T(object.Version)
+	h = 31*h + deriveHashTaggedFields(object.Parent)
+	return h
 }
 
-// deriveAnyEqualCurry reports whether the predicate returns true for any of the elements in the given slice.
-func deriveAnyEqualCurry(pred func(*BuiltInTypes) bool, list []*BuiltInTypes) bool {
-	for _, elem := range list {
-		if pred(elem) {
-			return true
-		}
+// deriveHashPtrToWithInterfaces returns the hash of the object.
+func deriveHashPtrToWithInterfaces(object *WithInterfaces) uint64 {
+	if object == nil {
+		return 0
 	}
-	return false
+	h := uint64(17)
+	h = 31*h + deriveHash_Sh(object.Shape)
+	h = 31*h + deriveHash_128(object.Shapes)
+	h = 31*h + deriveHash_e(object.Err)
+	h = 31*h + deriveHash_129(object.Any)
+	return h
 }
 
-// deriveAll reports whether the predicate returns true for all of the elements in the given slice.
-func deriveAll(predicate func(int) bool, slice []int) bool {
-	for _, elem := range slice {
-		if !predicate(elem) {
-			return false
-		}
+// deriveDeepCopyGraph_ recursively copies the contents of src into dst.
+func deriveDeepCopyGraph_(dst, src *DoublyLinked, visited map[interface{}]interface{}) {
+	dst.Value = src.Value
+	if src.Prev == nil {
+		dst.Prev = nil
+	} else if v, ok := visited[src.Prev]; ok {
+		dst.Prev = v.(*DoublyLinked)
+	} else {
+		dst.Prev = new(DoublyLinked)
+		visited[src.Prev] = dst.Prev
+		deriveDeepCopyGraph_(dst.Prev, src.Prev, visited)
+	}
+	if src.Next == nil {
+		dst.Next = nil
+	} else if v, ok := visited[src.Next]; ok {
+		dst.Next = v.(*DoublyLinked)
+	} else {
+		dst.Next = new(DoublyLinked)
+		visited[src.Next] = dst.Next
+		deriveDeepCopyGraph_(dst.Next, src.Next, visited)
 	}
-	return true
 }
 
-// deriveDo concurrently executes the input functions f0 and f1 and when all functions are finished the first error, if any, and results are returned.
-func deriveDo(f0 func() (string, error), f1 func() (int, error)) (string, int, error) {
-	errChan := make(chan error)
-	var v0 string
-	go func() {
-		var v0err error
-		v0, v0err = f0()
-		errChan <- v0err
-	}()
-	var v1 int
-	go func() {
-		var v1err error
-		v1, v1err = f1()
-		errChan <- v1err
-	}()
-	var err error
-	for i := 0; i < 2; i++ {
-		errc := <-errChan
-		if errc != nil {
-			if err == nil {
-				err = errc
-			}
-		}
+// deriveEqualGraph_ returns whether this and that are equal.
+func deriveEqualGraph_(this, that *DoublyLinked, visited map[[2]interface{}]bool) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if visited[[2]interface{}{this, that}] {
+		return true
 	}
-	return v0, v1, err
+	visited[[2]interface{}{this, that}] = true
+	return this.Value == that.Value &&
+		deriveEqualGraph_(this.Prev, that.Prev, visited) &&
+		deriveEqualGraph_(this.Next, that.Next, visited)
 }
 
 // deriveGoString returns a recursive representation of this as a valid go string.
@@ -5528,7 +4266,7 @@ func deriveGoString(this []*bool) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*bool, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_63(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_65(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -5545,7 +4283,7 @@ func deriveGoString_(this []*byte) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*byte, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_64(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_66(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -5562,7 +4300,7 @@ func deriveGoString_1(this []*complex128) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*complex128, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_65(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_67(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -5579,7 +4317,7 @@ func deriveGoString_2(this []*complex64) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*complex64, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_66(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_68(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -5596,7 +4334,7 @@ func deriveGoString_3(this []*float64) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*float64, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_67(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_69(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -5613,7 +4351,7 @@ func deriveGoString_4(this []*float32) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*float32, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_68(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_70(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -5630,7 +4368,7 @@ func deriveGoString_5(this []*int) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*int, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoStringIntPtr(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_71(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -5647,7 +4385,7 @@ func deriveGoString_6(this []*int16) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*int16, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_69(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_72(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -5664,7 +4402,7 @@ func deriveGoString_7(this []*int32) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*int32, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_70(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_73(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -5681,7 +4419,7 @@ func deriveGoString_8(this []*int64) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*int64, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_71(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_74(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -5698,7 +4436,7 @@ func deriveGoString_9(this []*int8) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*int8, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_72(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_75(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -5715,7 +4453,7 @@ func deriveGoString_10(this []*string) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*string, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_73(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_76(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -5732,7 +4470,7 @@ func deriveGoString_11(this []*uint) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*uint, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_74(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_77(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -5749,7 +4487,7 @@ func deriveGoString_12(this []*uint16) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*uint16, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_75(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_78(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -5766,7 +4504,7 @@ func deriveGoString_13(this []*uint32) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*uint32, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_76(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_79(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -5783,7 +4521,7 @@ func deriveGoString_14(this []*uint64) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*uint64, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_77(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_80(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -5800,7 +4538,7 @@ func deriveGoString_15(this []*uintptr) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*uintptr, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_78(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_81(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -5814,7 +4552,7 @@ func deriveGoString_16(this [1]*bool) string {
 	fmt.Fprintf(buf, "func() [1]*bool {\n")
 	fmt.Fprintf(buf, "this := [1]*bool{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_63(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_65(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
@@ -5827,7 +4565,7 @@ func deriveGoString_17(this [2]*byte) string {
 	fmt.Fprintf(buf, "func() [2]*byte {\n")
 	fmt.Fprintf(buf, "this := [2]*byte{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_64(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_66(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
@@ -5840,7 +4578,7 @@ func deriveGoString_18(this [3]*complex128) string {
 	fmt.Fprintf(buf, "func() [3]*complex128 {\n")
 	fmt.Fprintf(buf, "this := [3]*complex128{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_65(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_67(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
@@ -5853,7 +4591,7 @@ func deriveGoString_19(this [4]*complex64) string {
 	fmt.Fprintf(buf, "func() [4]*complex64 {\n")
 	fmt.Fprintf(buf, "this := [4]*complex64{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_66(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_68(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
@@ -5866,7 +4604,7 @@ func deriveGoString_20(this [5]*float64) string {
 	fmt.Fprintf(buf, "func() [5]*float64 {\n")
 	fmt.Fprintf(buf, "this := [5]*float64{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_67(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_69(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
@@ -5879,7 +4617,7 @@ func deriveGoString_21(this [6]*float32) string {
 	fmt.Fprintf(buf, "func() [6]*float32 {\n")
 	fmt.Fprintf(buf, "this := [6]*float32{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_68(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_70(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
@@ -5892,7 +4630,7 @@ func deriveGoString_22(this [7]*int) string {
 	fmt.Fprintf(buf, "func() [7]*int {\n")
 	fmt.Fprintf(buf, "this := [7]*int{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoStringIntPtr(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_71(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
@@ -5905,7 +4643,7 @@ func deriveGoString_23(this [8]*int16) string {
 	fmt.Fprintf(buf, "func() [8]*int16 {\n")
 	fmt.Fprintf(buf, "this := [8]*int16{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_69(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_72(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
@@ -5918,7 +4656,7 @@ func deriveGoString_24(this [9]*int32) string {
 	fmt.Fprintf(buf, "func() [9]*int32 {\n")
 	fmt.Fprintf(buf, "this := [9]*int32{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_70(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_73(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
@@ -5931,7 +4669,7 @@ func deriveGoString_25(this [10]*int64) string {
 	fmt.Fprintf(buf, "func() [10]*int64 {\n")
 	fmt.Fprintf(buf, "this := [10]*int64{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_71(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_74(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
@@ -5944,7 +4682,7 @@ func deriveGoString_26(this [11]*int8) string {
 	fmt.Fprintf(buf, "func() [11]*int8 {\n")
 	fmt.Fprintf(buf, "this := [11]*int8{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_72(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_75(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
@@ -5957,7 +4695,7 @@ func deriveGoString_27(this [12]*rune) string {
 	fmt.Fprintf(buf, "func() [12]*rune {\n")
 	fmt.Fprintf(buf, "this := [12]*rune{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_70(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_73(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
@@ -5970,7 +4708,7 @@ func deriveGoString_28(this [13]*string) string {
 	fmt.Fprintf(buf, "func() [13]*string {\n")
 	fmt.Fprintf(buf, "this := [13]*string{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_73(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_76(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
@@ -5983,7 +4721,7 @@ func deriveGoString_29(this [14]*uint) string {
 	fmt.Fprintf(buf, "func() [14]*uint {\n")
 	fmt.Fprintf(buf, "this := [14]*uint{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_74(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_77(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
@@ -5996,7 +4734,7 @@ func deriveGoString_30(this [15]*uint16) string {
 	fmt.Fprintf(buf, "func() [15]*uint16 {\n")
 	fmt.Fprintf(buf, "this := [15]*uint16{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_75(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_78(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
@@ -6009,7 +4747,7 @@ func deriveGoString_31(this [16]*uint32) string {
 	fmt.Fprintf(buf, "func() [16]*uint32 {\n")
 	fmt.Fprintf(buf, "this := [16]*uint32{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_76(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_79(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
@@ -6022,7 +4760,7 @@ func deriveGoString_32(this [17]*uint64) string {
 	fmt.Fprintf(buf, "func() [17]*uint64 {\n")
 	fmt.Fprintf(buf, "this := [17]*uint64{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_77(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_80(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
@@ -6035,7 +4773,7 @@ func deriveGoString_33(this [18]*uint8) string {
 	fmt.Fprintf(buf, "func() [18]*uint8 {\n")
 	fmt.Fprintf(buf, "this := [18]*uint8{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_64(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_66(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
@@ -6048,7 +4786,7 @@ func deriveGoString_34(this [19]*uintptr) string {
 	fmt.Fprintf(buf, "func() [19]*uintptr {\n")
 	fmt.Fprintf(buf, "this := [19]*uintptr{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_78(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_81(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
@@ -6061,7 +4799,7 @@ func deriveGoString_35(this [10]*bool) string {
 	fmt.Fprintf(buf, "func() [10]*bool {\n")
 	fmt.Fprintf(buf, "this := [10]*bool{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_63(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_65(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
@@ -6077,7 +4815,7 @@ func deriveGoString_36(this [][]int) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([][]int, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoStringIntSlices(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_82(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6094,7 +4832,7 @@ func deriveGoString_37(this [][]string) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([][]string, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_79(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_83(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6120,7 +4858,24 @@ func deriveGoString_38(this [][]*int) string {
 }
 
 // deriveGoString_39 returns a recursive representation of this as a valid go string.
-func deriveGoString_39(this *[4]int) string {
+func deriveGoString_39(this *[]int) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() *[]int {\n")
+	if this == nil {
+		fmt.Fprintf(buf, "return nil\n")
+	} else {
+		fmt.Fprintf(buf, "this := new([]int)\n")
+		if *this != nil {
+			fmt.Fprintf(buf, "*this = %#v\n", *this)
+		}
+		fmt.Fprintf(buf, "return this\n")
+	}
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
+// deriveGoString_40 returns a recursive representation of this as a valid go string.
+func deriveGoString_40(this *[4]int) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *[4]int {\n")
 	if this == nil {
@@ -6134,6 +4889,23 @@ func deriveGoString_39(this *[4]int) string {
 	return buf.String()
 }
 
+// deriveGoString_41 returns a recursive representation of this as a valid go string.
+func deriveGoString_41(this *map[int]int) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() *map[int]int {\n")
+	if this == nil {
+		fmt.Fprintf(buf, "return nil\n")
+	} else {
+		fmt.Fprintf(buf, "this := new(map[int]int)\n")
+		if *this != nil {
+			fmt.Fprintf(buf, "*this = %#v\n", *this)
+		}
+		fmt.Fprintf(buf, "return this\n")
+	}
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
 // deriveGoString_N returns a recursive representation of this as a valid go string.
 func deriveGoString_N(this Name) string {
 	buf := bytes.NewBuffer(nil)
@@ -6145,8 +4917,8 @@ func deriveGoString_N(this Name) string {
 	return buf.String()
 }
 
-// deriveGoString_40 returns a recursive representation of this as a valid go string.
-func deriveGoString_40(this []Name) string {
+// deriveGoString_42 returns a recursive representation of this as a valid go string.
+func deriveGoString_42(this []Name) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []test.Name {\n")
 	if this == nil {
@@ -6162,8 +4934,8 @@ func deriveGoString_40(this []Name) string {
 	return buf.String()
 }
 
-// deriveGoString_41 returns a recursive representation of this as a valid go string.
-func deriveGoString_41(this []*Name) string {
+// deriveGoString_43 returns a recursive representation of this as a valid go string.
+func deriveGoString_43(this []*Name) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*test.Name {\n")
 	if this == nil {
@@ -6179,8 +4951,8 @@ func deriveGoString_41(this []*Name) string {
 	return buf.String()
 }
 
-// deriveGoString_42 returns a recursive representation of this as a valid go string.
-func deriveGoString_42(this map[Name]string) string {
+// deriveGoString_44 returns a recursive representation of this as a valid go string.
+func deriveGoString_44(this map[Name]string) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[test.Name]string {\n")
 	if this == nil {
@@ -6199,8 +4971,8 @@ func deriveGoString_42(this map[Name]string) string {
 	return buf.String()
 }
 
-// deriveGoString_43 returns a recursive representation of this as a valid go string.
-func deriveGoString_43(this map[string]Name) string {
+// deriveGoString_45 returns a recursive representation of this as a valid go string.
+func deriveGoString_45(this map[string]Name) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[string]test.Name {\n")
 	if this == nil {
@@ -6216,8 +4988,8 @@ func deriveGoString_43(this map[string]Name) string {
 	return buf.String()
 }
 
-// deriveGoString_44 returns a recursive representation of this as a valid go string.
-func deriveGoString_44(this map[string]*Name) string {
+// deriveGoString_46 returns a recursive representation of this as a valid go string.
+func deriveGoString_46(this map[string]*Name) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[string]*test.Name {\n")
 	if this == nil {
@@ -6233,8 +5005,8 @@ func deriveGoString_44(this map[string]*Name) string {
 	return buf.String()
 }
 
-// deriveGoString_45 returns a recursive representation of this as a valid go string.
-func deriveGoString_45(this map[string][]Name) string {
+// deriveGoString_47 returns a recursive representation of this as a valid go string.
+func deriveGoString_47(this map[string][]Name) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[string][]test.Name {\n")
 	if this == nil {
@@ -6242,7 +5014,7 @@ func deriveGoString_45(this map[string][]Name) string {
 	} else {
 		fmt.Fprintf(buf, "this := make(map[string][]test.Name)\n")
 		for k, v := range this {
-			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_40(v))
+			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_42(v))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6250,8 +5022,8 @@ func deriveGoString_45(this map[string][]Name) string {
 	return buf.String()
 }
 
-// deriveGoString_46 returns a recursive representation of this as a valid go string.
-func deriveGoString_46(this map[string][]*Name) string {
+// deriveGoString_48 returns a recursive representation of this as a valid go string.
+func deriveGoString_48(this map[string][]*Name) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[string][]*test.Name {\n")
 	if this == nil {
@@ -6259,7 +5031,7 @@ func deriveGoString_46(this map[string][]*Name) string {
 	} else {
 		fmt.Fprintf(buf, "this := make(map[string][]*test.Name)\n")
 		for k, v := range this {
-			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_41(v))
+			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_43(v))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6267,8 +5039,8 @@ func deriveGoString_46(this map[string][]*Name) string {
 	return buf.String()
 }
 
-// deriveGoString_47 returns a recursive representation of this as a valid go string.
-func deriveGoString_47(this map[int]RecursiveType) string {
+// deriveGoString_49 returns a recursive representation of this as a valid go string.
+func deriveGoString_49(this map[int]RecursiveType) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[int]test.RecursiveType {\n")
 	if this == nil {
@@ -6294,18 +5066,18 @@ func deriveGoString_S(this Structs) string {
 		fmt.Fprintf(buf, "this.PtrToStruct = %s\n", deriveGoStringName(this.PtrToStruct))
 	}
 	if this.SliceOfStructs != nil {
-		fmt.Fprintf(buf, "this.SliceOfStructs = %s\n", deriveGoString_40(this.SliceOfStructs))
+		fmt.Fprintf(buf, "this.SliceOfStructs = %s\n", deriveGoString_42(this.SliceOfStructs))
 	}
 	if this.SliceToPtrOfStruct != nil {
-		fmt.Fprintf(buf, "this.SliceToPtrOfStruct = %s\n", deriveGoString_41(this.SliceToPtrOfStruct))
+		fmt.Fprintf(buf, "this.SliceToPtrOfStruct = %s\n", deriveGoString_43(this.SliceToPtrOfStruct))
 	}
 	fmt.Fprintf(buf, "return *this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoString_48 returns a recursive representation of this as a valid go string.
-func deriveGoString_48(this *StructWithoutEqualMethod) string {
+// deriveGoString_50 returns a recursive representation of this as a valid go string.
+func deriveGoString_50(this *StructWithoutEqualMethod) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *test.StructWithoutEqualMethod {\n")
 	if this == nil {
@@ -6330,8 +5102,8 @@ func deriveGoString_St(this StructWithoutEqualMethod) string {
 	return buf.String()
 }
 
-// deriveGoString_49 returns a recursive representation of this as a valid go string.
-func deriveGoString_49(this *extra.StructWithoutEqualMethod) string {
+// deriveGoString_51 returns a recursive representation of this as a valid go string.
+func deriveGoString_51(this *extra.StructWithoutEqualMethod) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *extra.StructWithoutEqualMethod {\n")
 	if this == nil {
@@ -6356,8 +5128,8 @@ func deriveGoString_Str(this extra.StructWithoutEqualMethod) string {
 	return buf.String()
 }
 
-// deriveGoString_50 returns a recursive representation of this as a valid go string.
-func deriveGoString_50(this *MyEnum) string {
+// deriveGoString_52 returns a recursive representation of this as a valid go string.
+func deriveGoString_52(this *MyEnum) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *test.MyEnum {\n")
 	if this == nil {
@@ -6371,8 +5143,8 @@ func deriveGoString_50(this *MyEnum) string {
 	return buf.String()
 }
 
-// deriveGoString_51 returns a recursive representation of this as a valid go string.
-func deriveGoString_51(this []MyEnum) string {
+// deriveGoString_53 returns a recursive representation of this as a valid go string.
+func deriveGoString_53(this []MyEnum) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []test.MyEnum {\n")
 	if this == nil {
@@ -6388,8 +5160,8 @@ func deriveGoString_51(this []MyEnum) string {
 	return buf.String()
 }
 
-// deriveGoString_52 returns a recursive representation of this as a valid go string.
-func deriveGoString_52(this []*MyEnum) string {
+// deriveGoString_54 returns a recursive representation of this as a valid go string.
+func deriveGoString_54(this []*MyEnum) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*test.MyEnum {\n")
 	if this == nil {
@@ -6397,7 +5169,7 @@ func deriveGoString_52(this []*MyEnum) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*test.MyEnum, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_50(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_52(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6405,8 +5177,8 @@ func deriveGoString_52(this []*MyEnum) string {
 	return buf.String()
 }
 
-// deriveGoString_53 returns a recursive representation of this as a valid go string.
-func deriveGoString_53(this map[int32]MyEnum) string {
+// deriveGoString_55 returns a recursive representation of this as a valid go string.
+func deriveGoString_55(this map[int32]MyEnum) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[int32]test.MyEnum {\n")
 	if this == nil {
@@ -6422,8 +5194,8 @@ func deriveGoString_53(this map[int32]MyEnum) string {
 	return buf.String()
 }
 
-// deriveGoString_54 returns a recursive representation of this as a valid go string.
-func deriveGoString_54(this map[MyEnum]int32) string {
+// deriveGoString_56 returns a recursive representation of this as a valid go string.
+func deriveGoString_56(this map[MyEnum]int32) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[test.MyEnum]int32 {\n")
 	if this == nil {
@@ -6442,8 +5214,8 @@ func deriveGoString_54(this map[MyEnum]int32) string {
 	return buf.String()
 }
 
-// deriveGoString_55 returns a recursive representation of this as a valid go string.
-func deriveGoString_55(this [2]MyEnum) string {
+// deriveGoString_57 returns a recursive representation of this as a valid go string.
+func deriveGoString_57(this [2]MyEnum) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [2]test.MyEnum {\n")
 	fmt.Fprintf(buf, "this := [2]test.MyEnum{}\n")
@@ -6455,8 +5227,8 @@ func deriveGoString_55(this [2]MyEnum) string {
 	return buf.String()
 }
 
-// deriveGoString_56 returns a recursive representation of this as a valid go string.
-func deriveGoString_56(this *MySlice) string {
+// deriveGoString_58 returns a recursive representation of this as a valid go string.
+func deriveGoString_58(this *MySlice) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *test.MySlice {\n")
 	if this == nil {
@@ -6472,8 +5244,8 @@ func deriveGoString_56(this *MySlice) string {
 	return buf.String()
 }
 
-// deriveGoString_57 returns a recursive representation of this as a valid go string.
-func deriveGoString_57(this []MySlice) string {
+// deriveGoString_59 returns a recursive representation of this as a valid go string.
+func deriveGoString_59(this []MySlice) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []test.MySlice {\n")
 	if this == nil {
@@ -6489,8 +5261,8 @@ func deriveGoString_57(this []MySlice) string {
 	return buf.String()
 }
 
-// deriveGoString_58 returns a recursive representation of this as a valid go string.
-func deriveGoString_58(this *time.Duration) string {
+// deriveGoString_60 returns a recursive representation of this as a valid go string.
+func deriveGoString_60(this *time.Duration) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *time.Duration {\n")
 	if this == nil {
@@ -6504,8 +5276,8 @@ func deriveGoString_58(this *time.Duration) string {
 	return buf.String()
 }
 
-// deriveGoString_59 returns a recursive representation of this as a valid go string.
-func deriveGoString_59(this []time.Duration) string {
+// deriveGoString_61 returns a recursive representation of this as a valid go string.
+func deriveGoString_61(this []time.Duration) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []time.Duration {\n")
 	if this == nil {
@@ -6521,8 +5293,8 @@ func deriveGoString_59(this []time.Duration) string {
 	return buf.String()
 }
 
-// deriveGoString_60 returns a recursive representation of this as a valid go string.
-func deriveGoString_60(this []*time.Duration) string {
+// deriveGoString_62 returns a recursive representation of this as a valid go string.
+func deriveGoString_62(this []*time.Duration) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*time.Duration {\n")
 	if this == nil {
@@ -6530,7 +5302,7 @@ func deriveGoString_60(this []*time.Duration) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*time.Duration, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_58(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_60(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6538,8 +5310,8 @@ func deriveGoString_60(this []*time.Duration) string {
 	return buf.String()
 }
 
-// deriveGoString_61 returns a recursive representation of this as a valid go string.
-func deriveGoString_61(this map[int]time.Duration) string {
+// deriveGoString_63 returns a recursive representation of this as a valid go string.
+func deriveGoString_63(this map[int]time.Duration) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[int]time.Duration {\n")
 	if this == nil {
@@ -6555,8 +5327,8 @@ func deriveGoString_61(this map[int]time.Duration) string {
 	return buf.String()
 }
 
-// deriveGoString_62 returns a recursive representation of this as a valid go string.
-func deriveGoString_62(this map[string][]*pickle.Rick) string {
+// deriveGoString_64 returns a recursive representation of this as a valid go string.
+func deriveGoString_64(this map[string][]*pickle.Rick) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[string][]*pickle.Rick {\n")
 	if this == nil {
@@ -6564,7 +5336,7 @@ func deriveGoString_62(this map[string][]*pickle.Rick) string {
 	} else {
 		fmt.Fprintf(buf, "this := make(map[string][]*pickle.Rick)\n")
 		for k, v := range this {
-			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_80(v))
+			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_84(v))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6585,6 +5357,17 @@ func deriveGoString_p(this privateStruct) string {
 	return buf.String()
 }
 
+// deriveGoString_T returns a recursive representation of this as a valid go string.
+func deriveGoString_T(this TaggedVersion) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() test.TaggedVersion {\n")
+	fmt.Fprintf(buf, "this := &test.TaggedVersion{}\n")
+	fmt.Fprintf(buf, "this.Major = %#v\n", this.Major)
+	fmt.Fprintf(buf, "return *this\n")
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
 // deriveDeepCopy recursively copies the contents of src into dst.
 func deriveDeepCopy(dst, src []*bool) {
 	for src_i, src_value := range src {
@@ -6936,7 +5719,7 @@ func deriveDeepCopy_26(dst, src *[]int) {
 func deriveDeepCopy_27(dst, src *map[int]int) {
 	if *src != nil {
 		*dst = make(map[int]int, len(*src))
-		deriveDeepCopy_46(*dst, *src)
+		deriveDeepCopy_48(*dst, *src)
 	} else {
 		*dst = nil
 	}
@@ -7230,25 +6013,86 @@ func deriveDeepCopy_44(dst, src *privateStruct) {
 }
 
 // deriveDeepCopy_45 recursively copies the contents of src into dst.
-func deriveDeepCopy_45(dst, src []int) {
-	copy(dst, src)
+func deriveDeepCopy_45(dst, src *TaggedVersion) {
+	dst.Major = src.Major
+}
+
+// deriveDeepCopy_S returns a recursive copy of src.
+func deriveDeepCopy_S(src Shape) Shape {
+	if src == nil {
+		return nil
+	}
+	switch src := src.(type) {
+	case *Circle:
+		var dst *Circle
+		if src == nil {
+			dst = nil
+		} else {
+			dst = new(Circle)
+			*dst = *src
+		}
+		return dst
+	case Square:
+		var dst Square
+		field := new(Square)
+		deriveDeepCopy_50(field, &src)
+		dst = *field
+		return dst
+	case *Square:
+		var dst *Square
+		if src == nil {
+			dst = nil
+		} else {
+			dst = new(Square)
+			deriveDeepCopy_50(dst, src)
+		}
+		return dst
+	}
+	if typ := reflect.TypeOf(src); typ.Kind() == reflect.Ptr {
+		if cp := reflect.ValueOf(src).MethodByName("DeepCopy"); cp.IsValid() && cp.Type().NumIn() == 1 && cp.Type().In(0) == typ {
+			dst := reflect.New(typ.Elem())
+			cp.Call([]reflect.Value{dst})
+			return dst.Interface().(Shape)
+		}
+	}
+	return src
 }
 
 // deriveDeepCopy_46 recursively copies the contents of src into dst.
-func deriveDeepCopy_46(dst, src map[int]int) {
-	for src_key, src_value := range src {
-		dst[src_key] = src_value
+func deriveDeepCopy_46(dst, src []Shape) {
+	for src_i, src_value := range src {
+		dst[src_i] = deriveDeepCopy_S(src_value)
+	}
+}
+
+// deriveDeepCopy_e returns a recursive copy of src.
+func deriveDeepCopy_e(src error) error {
+	if src == nil {
+		return nil
 	}
+	if typ := reflect.TypeOf(src); typ.Kind() == reflect.Ptr {
+		if cp := reflect.ValueOf(src).MethodByName("DeepCopy"); cp.IsValid() && cp.Type().NumIn() == 1 && cp.Type().In(0) == typ {
+			dst := reflect.New(typ.Elem())
+			cp.Call([]reflect.Value{dst})
+			return dst.Interface().(error)
+		}
+	}
+	return src
 }
 
-// deriveDeepCopy_47 recursively copies the contents of src into dst.
-func deriveDeepCopy_47(dst, src *int) {
-	*dst = *src
-}
-
-// deriveDeepCopy_48 recursively copies the contents of src into dst.
-func deriveDeepCopy_48(dst, src *[10]int) {
-	*dst = *src
+// deriveDeepCopy_47 returns a recursive copy of src.
+func deriveDeepCopy_47(src interface{}) interface{} {
+	if src == nil {
+		return nil
+	}
+	if typ := reflect.TypeOf(src); typ.Kind() == reflect.Ptr {
+		if cp := reflect.ValueOf(src).MethodByName("DeepCopy"); cp.IsValid() && cp.Type().NumIn() == 1 && cp.Type().In(0) == typ {
+			dst := reflect.New(typ.Elem())
+			cp.Call([]reflect.Value{dst})
+			return dst.Interface().(interface{})
+		}
+	}
+	return src
 }
 
 // deriveCompare returns:
@@ -7280,6 +6124,46 @@ func deriveCompare_(this, that byte) int {
 	return 0
 }
 
+// deriveCompare_1 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_1(this, that complex128) int {
+	if thisr, thatr := real(this), real(that); thisr == thatr {
+		if thisi, thati := imag(this), imag(that); thisi == thati {
+			return 0
+		} else if thisi < thati {
+			return -1
+		} else {
+			return 1
+		}
+	} else if thisr < thatr {
+		return -1
+	} else {
+		return 1
+	}
+}
+
+// deriveCompare_2 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_2(this, that complex64) int {
+	if thisr, thatr := real(this), real(that); thisr == thatr {
+		if thisi, thati := imag(this), imag(that); thisi == thati {
+			return 0
+		} else if thisi < thati {
+			return -1
+		} else {
+			return 1
+		}
+	} else if thisr < thatr {
+		return -1
+	} else {
+		return 1
+	}
+}
+
 // deriveCompare_f returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
@@ -7445,11 +6329,11 @@ func deriveCompare_uint(this, that uint64) int {
 	return 0
 }
 
-// deriveCompare_1 returns:
+// deriveCompare_3 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_1(this, that uintptr) int {
+func deriveCompare_3(this, that uintptr) int {
 	if this != that {
 		if this < that {
 			return -1
@@ -7460,45 +6344,11 @@ func deriveCompare_1(this, that uintptr) int {
 	return 0
 }
 
-// deriveCompare_2 returns:
-//   * 0 if this and that are equal,
-//   * -1 is this is smaller and
-//   * +1 is this is bigger.
-func deriveCompare_2(this, that *bool) int {
-	if this == nil {
-		if that == nil {
-			return 0
-		}
-		return -1
-	}
-	if that == nil {
-		return 1
-	}
-	return deriveCompare(*this, *that)
-}
-
-// deriveCompare_3 returns:
-//   * 0 if this and that are equal,
-//   * -1 is this is smaller and
-//   * +1 is this is bigger.
-func deriveCompare_3(this, that *byte) int {
-	if this == nil {
-		if that == nil {
-			return 0
-		}
-		return -1
-	}
-	if that == nil {
-		return 1
-	}
-	return deriveCompare_(*this, *that)
-}
-
 // deriveCompare_4 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_4(this, that *complex128) int {
+func deriveCompare_4(this, that *bool) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7508,14 +6358,14 @@ func deriveCompare_4(this, that *complex128) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompareComplex64(*this, *that)
+	return deriveCompare(*this, *that)
 }
 
 // deriveCompare_5 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_5(this, that *complex64) int {
+func deriveCompare_5(this, that *byte) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7525,14 +6375,14 @@ func deriveCompare_5(this, that *complex64) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompareComplex32(*this, *that)
+	return deriveCompare_(*this, *that)
 }
 
 // deriveCompare_6 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_6(this, that *float64) int {
+func deriveCompare_6(this, that *complex128) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7542,14 +6392,14 @@ func deriveCompare_6(this, that *float64) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_f(*this, *that)
+	return deriveCompare_1(*this, *that)
 }
 
 // deriveCompare_7 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_7(this, that *float32) int {
+func deriveCompare_7(this, that *complex64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7559,14 +6409,14 @@ func deriveCompare_7(this, that *float32) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_fl(*this, *that)
+	return deriveCompare_2(*this, *that)
 }
 
 // deriveCompare_8 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_8(this, that *int) int {
+func deriveCompare_8(this, that *float64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7576,14 +6426,14 @@ func deriveCompare_8(this, that *int) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_i(*this, *that)
+	return deriveCompare_f(*this, *that)
 }
 
 // deriveCompare_9 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_9(this, that *int16) int {
+func deriveCompare_9(this, that *float32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7593,14 +6443,14 @@ func deriveCompare_9(this, that *int16) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_in(*this, *that)
+	return deriveCompare_fl(*this, *that)
 }
 
 // deriveCompare_10 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_10(this, that *int32) int {
+func deriveCompare_10(this, that *int) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7610,14 +6460,14 @@ func deriveCompare_10(this, that *int32) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_int(*this, *that)
+	return deriveCompare_i(*this, *that)
 }
 
 // deriveCompare_11 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_11(this, that *int64) int {
+func deriveCompare_11(this, that *int16) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7627,14 +6477,14 @@ func deriveCompare_11(this, that *int64) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_int6(*this, *that)
+	return deriveCompare_in(*this, *that)
 }
 
 // deriveCompare_12 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_12(this, that *int8) int {
+func deriveCompare_12(this, that *int32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7644,14 +6494,14 @@ func deriveCompare_12(this, that *int8) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_int8(*this, *that)
+	return deriveCompare_int(*this, *that)
 }
 
 // deriveCompare_13 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_13(this, that *string) int {
+func deriveCompare_13(this, that *int64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7661,14 +6511,14 @@ func deriveCompare_13(this, that *string) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_s(*this, *that)
+	return deriveCompare_int6(*this, *that)
 }
 
 // deriveCompare_14 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_14(this, that *uint) int {
+func deriveCompare_14(this, that *int8) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7678,14 +6528,14 @@ func deriveCompare_14(this, that *uint) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_u(*this, *that)
+	return deriveCompare_int8(*this, *that)
 }
 
 // deriveCompare_15 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_15(this, that *uint16) int {
+func deriveCompare_15(this, that *string) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7695,14 +6545,14 @@ func deriveCompare_15(this, that *uint16) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_ui(*this, *that)
+	return deriveCompare_s(*this, *that)
 }
 
 // deriveCompare_16 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_16(this, that *uint32) int {
+func deriveCompare_16(this, that *uint) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7712,14 +6562,14 @@ func deriveCompare_16(this, that *uint32) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_uin(*this, *that)
+	return deriveCompare_u(*this, *that)
 }
 
 // deriveCompare_17 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_17(this, that *uint64) int {
+func deriveCompare_17(this, that *uint16) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7729,14 +6579,14 @@ func deriveCompare_17(this, that *uint64) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_uint(*this, *that)
+	return deriveCompare_ui(*this, *that)
 }
 
 // deriveCompare_18 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_18(this, that *uintptr) int {
+func deriveCompare_18(this, that *uint32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7746,14 +6596,14 @@ func deriveCompare_18(this, that *uintptr) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_1(*this, *that)
+	return deriveCompare_uin(*this, *that)
 }
 
 // deriveCompare_19 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_19(this, that []bool) int {
+func deriveCompare_19(this, that *uint64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7763,25 +6613,14 @@ func deriveCompare_19(this, that []bool) int {
 	if that == nil {
 		return 1
 	}
-	if len(this) != len(that) {
-		if len(this) < len(that) {
-			return -1
-		}
-		return 1
-	}
-	for i := 0; i < len(this); i++ {
-		if c := deriveCompare(this[i], that[i]); c != 0 {
-			return c
-		}
-	}
-	return 0
+	return deriveCompare_uint(*this, *that)
 }
 
 // deriveCompare_20 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_20(this, that []complex128) int {
+func deriveCompare_20(this, that *uintptr) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7791,25 +6630,14 @@ func deriveCompare_20(this, that []complex128) int {
 	if that == nil {
 		return 1
 	}
-	if len(this) != len(that) {
-		if len(this) < len(that) {
-			return -1
-		}
-		return 1
-	}
-	for i := 0; i < len(this); i++ {
-		if c := deriveCompareComplex64(this[i], that[i]); c != 0 {
-			return c
-		}
-	}
-	return 0
+	return deriveCompare_3(*this, *that)
 }
 
 // deriveCompare_21 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_21(this, that []complex64) int {
+func deriveCompare_21(this, that []bool) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7826,7 +6654,7 @@ func deriveCompare_21(this, that []complex64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompareComplex32(this[i], that[i]); c != 0 {
+		if c := deriveCompare(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -7837,7 +6665,7 @@ func deriveCompare_21(this, that []complex64) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_22(this, that []float64) int {
+func deriveCompare_22(this, that []complex128) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7854,7 +6682,7 @@ func deriveCompare_22(this, that []float64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_f(this[i], that[i]); c != 0 {
+		if c := deriveCompare_1(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -7865,7 +6693,7 @@ func deriveCompare_22(this, that []float64) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_23(this, that []float32) int {
+func deriveCompare_23(this, that []complex64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7882,7 +6710,7 @@ func deriveCompare_23(this, that []float32) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_fl(this[i], that[i]); c != 0 {
+		if c := deriveCompare_2(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -7893,7 +6721,7 @@ func deriveCompare_23(this, that []float32) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_24(this, that []int) int {
+func deriveCompare_24(this, that []float64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7910,7 +6738,7 @@ func deriveCompare_24(this, that []int) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_i(this[i], that[i]); c != 0 {
+		if c := deriveCompare_f(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -7921,7 +6749,7 @@ func deriveCompare_24(this, that []int) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_25(this, that []int16) int {
+func deriveCompare_25(this, that []float32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7938,7 +6766,7 @@ func deriveCompare_25(this, that []int16) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_in(this[i], that[i]); c != 0 {
+		if c := deriveCompare_fl(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -7949,7 +6777,7 @@ func deriveCompare_25(this, that []int16) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_26(this, that []int32) int {
+func deriveCompare_26(this, that []int) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7966,7 +6794,7 @@ func deriveCompare_26(this, that []int32) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_int(this[i], that[i]); c != 0 {
+		if c := deriveCompare_i(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -7977,7 +6805,7 @@ func deriveCompare_26(this, that []int32) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_27(this, that []int64) int {
+func deriveCompare_27(this, that []int16) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -7994,7 +6822,7 @@ func deriveCompare_27(this, that []int64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_int6(this[i], that[i]); c != 0 {
+		if c := deriveCompare_in(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8005,7 +6833,7 @@ func deriveCompare_27(this, that []int64) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_28(this, that []int8) int {
+func deriveCompare_28(this, that []int32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8022,7 +6850,7 @@ func deriveCompare_28(this, that []int8) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_int8(this[i], that[i]); c != 0 {
+		if c := deriveCompare_int(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8033,7 +6861,7 @@ func deriveCompare_28(this, that []int8) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_29(this, that []string) int {
+func deriveCompare_29(this, that []int64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8050,7 +6878,7 @@ func deriveCompare_29(this, that []string) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := strings.Compare(this[i], that[i]); c != 0 {
+		if c := deriveCompare_int6(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8061,7 +6889,7 @@ func deriveCompare_29(this, that []string) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_30(this, that []uint) int {
+func deriveCompare_30(this, that []int8) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8078,7 +6906,7 @@ func deriveCompare_30(this, that []uint) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_u(this[i], that[i]); c != 0 {
+		if c := deriveCompare_int8(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8089,7 +6917,7 @@ func deriveCompare_30(this, that []uint) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_31(this, that []uint16) int {
+func deriveCompare_31(this, that []string) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8106,7 +6934,7 @@ func deriveCompare_31(this, that []uint16) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_ui(this[i], that[i]); c != 0 {
+		if c := strings.Compare(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8117,7 +6945,7 @@ func deriveCompare_31(this, that []uint16) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_32(this, that []uint32) int {
+func deriveCompare_32(this, that []uint) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8134,7 +6962,7 @@ func deriveCompare_32(this, that []uint32) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_uin(this[i], that[i]); c != 0 {
+		if c := deriveCompare_u(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8145,7 +6973,7 @@ func deriveCompare_32(this, that []uint32) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_33(this, that []uint64) int {
+func deriveCompare_33(this, that []uint16) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8162,7 +6990,7 @@ func deriveCompare_33(this, that []uint64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_uint(this[i], that[i]); c != 0 {
+		if c := deriveCompare_ui(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8173,7 +7001,7 @@ func deriveCompare_33(this, that []uint64) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_34(this, that []uintptr) int {
+func deriveCompare_34(this, that []uint32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8190,7 +7018,7 @@ func deriveCompare_34(this, that []uintptr) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_1(this[i], that[i]); c != 0 {
+		if c := deriveCompare_uin(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8201,7 +7029,7 @@ func deriveCompare_34(this, that []uintptr) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_35(this, that []*bool) int {
+func deriveCompare_35(this, that []uint64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8218,7 +7046,7 @@ func deriveCompare_35(this, that []*bool) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_2(this[i], that[i]); c != 0 {
+		if c := deriveCompare_uint(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8229,7 +7057,7 @@ func deriveCompare_35(this, that []*bool) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_36(this, that []*byte) int {
+func deriveCompare_36(this, that []uintptr) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8257,7 +7085,7 @@ func deriveCompare_36(this, that []*byte) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_37(this, that []*complex128) int {
+func deriveCompare_37(this, that []*bool) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8285,7 +7113,7 @@ func deriveCompare_37(this, that []*complex128) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_38(this, that []*complex64) int {
+func deriveCompare_38(this, that []*byte) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8313,7 +7141,7 @@ func deriveCompare_38(this, that []*complex64) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_39(this, that []*float64) int {
+func deriveCompare_39(this, that []*complex128) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8341,7 +7169,7 @@ func deriveCompare_39(this, that []*float64) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_40(this, that []*float32) int {
+func deriveCompare_40(this, that []*complex64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8369,7 +7197,7 @@ func deriveCompare_40(this, that []*float32) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_41(this, that []*int) int {
+func deriveCompare_41(this, that []*float64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8397,7 +7225,7 @@ func deriveCompare_41(this, that []*int) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_42(this, that []*int16) int {
+func deriveCompare_42(this, that []*float32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8425,7 +7253,7 @@ func deriveCompare_42(this, that []*int16) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_43(this, that []*int32) int {
+func deriveCompare_43(this, that []*int) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8453,7 +7281,7 @@ func deriveCompare_43(this, that []*int32) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_44(this, that []*int64) int {
+func deriveCompare_44(this, that []*int16) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8481,7 +7309,7 @@ func deriveCompare_44(this, that []*int64) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_45(this, that []*int8) int {
+func deriveCompare_45(this, that []*int32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8509,7 +7337,7 @@ func deriveCompare_45(this, that []*int8) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_46(this, that []*string) int {
+func deriveCompare_46(this, that []*int64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8537,7 +7365,7 @@ func deriveCompare_46(this, that []*string) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_47(this, that []*uint) int {
+func deriveCompare_47(this, that []*int8) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8565,7 +7393,7 @@ func deriveCompare_47(this, that []*uint) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_48(this, that []*uint16) int {
+func deriveCompare_48(this, that []*string) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8593,7 +7421,7 @@ func deriveCompare_48(this, that []*uint16) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_49(this, that []*uint32) int {
+func deriveCompare_49(this, that []*uint) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8621,7 +7449,7 @@ func deriveCompare_49(this, that []*uint32) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_50(this, that []*uint64) int {
+func deriveCompare_50(this, that []*uint16) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8649,7 +7477,7 @@ func deriveCompare_50(this, that []*uint64) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_51(this, that []*uintptr) int {
+func deriveCompare_51(this, that []*uint32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8677,7 +7505,16 @@ func deriveCompare_51(this, that []*uintptr) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_52(this, that [1]bool) int {
+func deriveCompare_52(this, that []*uint64) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -8685,7 +7522,7 @@ func deriveCompare_52(this, that [1]bool) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare(this[i], that[i]); c != 0 {
+		if c := deriveCompare_19(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8696,7 +7533,16 @@ func deriveCompare_52(this, that [1]bool) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_53(this, that [2]byte) int {
+func deriveCompare_53(this, that []*uintptr) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -8704,7 +7550,7 @@ func deriveCompare_53(this, that [2]byte) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_(this[i], that[i]); c != 0 {
+		if c := deriveCompare_20(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8715,7 +7561,7 @@ func deriveCompare_53(this, that [2]byte) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_54(this, that [3]complex128) int {
+func deriveCompare_54(this, that [1]bool) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -8723,7 +7569,7 @@ func deriveCompare_54(this, that [3]complex128) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompareComplex64(this[i], that[i]); c != 0 {
+		if c := deriveCompare(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8734,7 +7580,7 @@ func deriveCompare_54(this, that [3]complex128) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_55(this, that [4]complex64) int {
+func deriveCompare_55(this, that [2]byte) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -8742,7 +7588,7 @@ func deriveCompare_55(this, that [4]complex64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompareComplex32(this[i], that[i]); c != 0 {
+		if c := deriveCompare_(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8753,7 +7599,7 @@ func deriveCompare_55(this, that [4]complex64) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_56(this, that [5]float64) int {
+func deriveCompare_56(this, that [3]complex128) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -8761,7 +7607,7 @@ func deriveCompare_56(this, that [5]float64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_f(this[i], that[i]); c != 0 {
+		if c := deriveCompare_1(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8772,7 +7618,7 @@ func deriveCompare_56(this, that [5]float64) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_57(this, that [6]float32) int {
+func deriveCompare_57(this, that [4]complex64) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -8780,7 +7626,7 @@ func deriveCompare_57(this, that [6]float32) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_fl(this[i], that[i]); c != 0 {
+		if c := deriveCompare_2(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8791,7 +7637,7 @@ func deriveCompare_57(this, that [6]float32) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_58(this, that [7]int) int {
+func deriveCompare_58(this, that [5]float64) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -8799,7 +7645,7 @@ func deriveCompare_58(this, that [7]int) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_i(this[i], that[i]); c != 0 {
+		if c := deriveCompare_f(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8810,7 +7656,7 @@ func deriveCompare_58(this, that [7]int) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_59(this, that [8]int16) int {
+func deriveCompare_59(this, that [6]float32) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -8818,7 +7664,7 @@ func deriveCompare_59(this, that [8]int16) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_in(this[i], that[i]); c != 0 {
+		if c := deriveCompare_fl(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8829,7 +7675,7 @@ func deriveCompare_59(this, that [8]int16) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_60(this, that [9]int32) int {
+func deriveCompare_60(this, that [7]int) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -8837,7 +7683,7 @@ func deriveCompare_60(this, that [9]int32) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_int(this[i], that[i]); c != 0 {
+		if c := deriveCompare_i(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8848,7 +7694,7 @@ func deriveCompare_60(this, that [9]int32) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_61(this, that [10]int64) int {
+func deriveCompare_61(this, that [8]int16) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -8856,7 +7702,7 @@ func deriveCompare_61(this, that [10]int64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_int6(this[i], that[i]); c != 0 {
+		if c := deriveCompare_in(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8867,7 +7713,7 @@ func deriveCompare_61(this, that [10]int64) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_62(this, that [11]int8) int {
+func deriveCompare_62(this, that [9]int32) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -8875,7 +7721,7 @@ func deriveCompare_62(this, that [11]int8) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_int8(this[i], that[i]); c != 0 {
+		if c := deriveCompare_int(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8886,7 +7732,7 @@ func deriveCompare_62(this, that [11]int8) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_63(this, that [12]rune) int {
+func deriveCompare_63(this, that [10]int64) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -8894,7 +7740,7 @@ func deriveCompare_63(this, that [12]rune) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_int(this[i], that[i]); c != 0 {
+		if c := deriveCompare_int6(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8905,7 +7751,7 @@ func deriveCompare_63(this, that [12]rune) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_64(this, that [13]string) int {
+func deriveCompare_64(this, that [11]int8) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -8913,7 +7759,7 @@ func deriveCompare_64(this, that [13]string) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := strings.Compare(this[i], that[i]); c != 0 {
+		if c := deriveCompare_int8(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8924,7 +7770,7 @@ func deriveCompare_64(this, that [13]string) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_65(this, that [14]uint) int {
+func deriveCompare_65(this, that [12]rune) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -8932,7 +7778,7 @@ func deriveCompare_65(this, that [14]uint) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_u(this[i], that[i]); c != 0 {
+		if c := deriveCompare_int(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8943,7 +7789,7 @@ func deriveCompare_65(this, that [14]uint) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_66(this, that [15]uint16) int {
+func deriveCompare_66(this, that [13]string) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -8951,7 +7797,7 @@ func deriveCompare_66(this, that [15]uint16) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_ui(this[i], that[i]); c != 0 {
+		if c := strings.Compare(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8962,7 +7808,7 @@ func deriveCompare_66(this, that [15]uint16) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_67(this, that [16]uint32) int {
+func deriveCompare_67(this, that [14]uint) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -8970,7 +7816,7 @@ func deriveCompare_67(this, that [16]uint32) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_uin(this[i], that[i]); c != 0 {
+		if c := deriveCompare_u(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -8981,7 +7827,7 @@ func deriveCompare_67(this, that [16]uint32) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_68(this, that [17]uint64) int {
+func deriveCompare_68(this, that [15]uint16) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -8989,7 +7835,7 @@ func deriveCompare_68(this, that [17]uint64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_uint(this[i], that[i]); c != 0 {
+		if c := deriveCompare_ui(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -9000,7 +7846,7 @@ func deriveCompare_68(this, that [17]uint64) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_69(this, that [18]uint8) int {
+func deriveCompare_69(this, that [16]uint32) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9008,7 +7854,7 @@ func deriveCompare_69(this, that [18]uint8) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_(this[i], that[i]); c != 0 {
+		if c := deriveCompare_uin(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -9019,7 +7865,7 @@ func deriveCompare_69(this, that [18]uint8) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_70(this, that [19]uintptr) int {
+func deriveCompare_70(this, that [17]uint64) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9027,7 +7873,7 @@ func deriveCompare_70(this, that [19]uintptr) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_1(this[i], that[i]); c != 0 {
+		if c := deriveCompare_uint(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -9038,7 +7884,7 @@ func deriveCompare_70(this, that [19]uintptr) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_71(this, that [10]bool) int {
+func deriveCompare_71(this, that [18]uint8) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9046,7 +7892,7 @@ func deriveCompare_71(this, that [10]bool) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare(this[i], that[i]); c != 0 {
+		if c := deriveCompare_(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -9057,7 +7903,7 @@ func deriveCompare_71(this, that [10]bool) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_72(this, that [1]*bool) int {
+func deriveCompare_72(this, that [19]uintptr) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9065,7 +7911,7 @@ func deriveCompare_72(this, that [1]*bool) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_2(this[i], that[i]); c != 0 {
+		if c := deriveCompare_3(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -9076,7 +7922,7 @@ func deriveCompare_72(this, that [1]*bool) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_73(this, that [2]*byte) int {
+func deriveCompare_73(this, that [10]bool) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9084,7 +7930,7 @@ func deriveCompare_73(this, that [2]*byte) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_3(this[i], that[i]); c != 0 {
+		if c := deriveCompare(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -9095,7 +7941,7 @@ func deriveCompare_73(this, that [2]*byte) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_74(this, that [3]*complex128) int {
+func deriveCompare_74(this, that [1]*bool) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9114,7 +7960,7 @@ func deriveCompare_74(this, that [3]*complex128) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_75(this, that [4]*complex64) int {
+func deriveCompare_75(this, that [2]*byte) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9133,7 +7979,7 @@ func deriveCompare_75(this, that [4]*complex64) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_76(this, that [5]*float64) int {
+func deriveCompare_76(this, that [3]*complex128) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9152,7 +7998,7 @@ func deriveCompare_76(this, that [5]*float64) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_77(this, that [6]*float32) int {
+func deriveCompare_77(this, that [4]*complex64) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9171,7 +8017,7 @@ func deriveCompare_77(this, that [6]*float32) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_78(this, that [7]*int) int {
+func deriveCompare_78(this, that [5]*float64) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9190,7 +8036,7 @@ func deriveCompare_78(this, that [7]*int) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_79(this, that [8]*int16) int {
+func deriveCompare_79(this, that [6]*float32) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9209,7 +8055,7 @@ func deriveCompare_79(this, that [8]*int16) int {
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_80(this, that [9]*int32) int {
+func deriveCompare_80(this, that [7]*int) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9224,11 +8070,49 @@ func deriveCompare_80(this, that [9]*int32) int {
 	return 0
 }
 
-// deriveCompare_81 returns:
+// deriveCompare_81 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_81(this, that [8]*int16) int {
+	if len(this) != len(that) {
+		if len(this) < len(that) {
+			return -1
+		}
+		return 1
+	}
+	for i := 0; i < len(this); i++ {
+		if c := deriveCompare_11(this[i], that[i]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// deriveCompare_82 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_82(this, that [9]*int32) int {
+	if len(this) != len(that) {
+		if len(this) < len(that) {
+			return -1
+		}
+		return 1
+	}
+	for i := 0; i < len(this); i++ {
+		if c := deriveCompare_12(this[i], that[i]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// deriveCompare_83 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_81(this, that [10]*int64) int {
+func deriveCompare_83(this, that [10]*int64) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9236,18 +8120,18 @@ func deriveCompare_81(this, that [10]*int64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_11(this[i], that[i]); c != 0 {
+		if c := deriveCompare_13(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_82 returns:
+// deriveCompare_84 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_82(this, that [11]*int8) int {
+func deriveCompare_84(this, that [11]*int8) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9255,18 +8139,18 @@ func deriveCompare_82(this, that [11]*int8) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_12(this[i], that[i]); c != 0 {
+		if c := deriveCompare_14(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_83 returns:
+// deriveCompare_85 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_83(this, that [12]*rune) int {
+func deriveCompare_85(this, that [12]*rune) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9274,18 +8158,18 @@ func deriveCompare_83(this, that [12]*rune) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_10(this[i], that[i]); c != 0 {
+		if c := deriveCompare_12(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_84 returns:
+// deriveCompare_86 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_84(this, that [13]*string) int {
+func deriveCompare_86(this, that [13]*string) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9293,18 +8177,18 @@ func deriveCompare_84(this, that [13]*string) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_13(this[i], that[i]); c != 0 {
+		if c := deriveCompare_15(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_85 returns:
+// deriveCompare_87 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_85(this, that [14]*uint) int {
+func deriveCompare_87(this, that [14]*uint) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9312,18 +8196,18 @@ func deriveCompare_85(this, that [14]*uint) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_14(this[i], that[i]); c != 0 {
+		if c := deriveCompare_16(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_86 returns:
+// deriveCompare_88 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_86(this, that [15]*uint16) int {
+func deriveCompare_88(this, that [15]*uint16) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9331,18 +8215,18 @@ func deriveCompare_86(this, that [15]*uint16) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_15(this[i], that[i]); c != 0 {
+		if c := deriveCompare_17(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_87 returns:
+// deriveCompare_89 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_87(this, that [16]*uint32) int {
+func deriveCompare_89(this, that [16]*uint32) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9350,18 +8234,18 @@ func deriveCompare_87(this, that [16]*uint32) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_16(this[i], that[i]); c != 0 {
+		if c := deriveCompare_18(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_88 returns:
+// deriveCompare_90 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_88(this, that [17]*uint64) int {
+func deriveCompare_90(this, that [17]*uint64) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9369,18 +8253,18 @@ func deriveCompare_88(this, that [17]*uint64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_17(this[i], that[i]); c != 0 {
+		if c := deriveCompare_19(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_89 returns:
+// deriveCompare_91 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_89(this, that [18]*uint8) int {
+func deriveCompare_91(this, that [18]*uint8) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9388,18 +8272,18 @@ func deriveCompare_89(this, that [18]*uint8) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_3(this[i], that[i]); c != 0 {
+		if c := deriveCompare_5(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_90 returns:
+// deriveCompare_92 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_90(this, that [19]*uintptr) int {
+func deriveCompare_92(this, that [19]*uintptr) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9407,18 +8291,18 @@ func deriveCompare_90(this, that [19]*uintptr) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_18(this[i], that[i]); c != 0 {
+		if c := deriveCompare_20(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_91 returns:
+// deriveCompare_93 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_91(this, that [10]*bool) int {
+func deriveCompare_93(this, that [10]*bool) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9426,18 +8310,18 @@ func deriveCompare_91(this, that [10]*bool) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_2(this[i], that[i]); c != 0 {
+		if c := deriveCompare_4(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_92 returns:
+// deriveCompare_94 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_92(this, that map[string]uint32) int {
+func deriveCompare_94(this, that map[string]uint32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9453,8 +8337,8 @@ func deriveCompare_92(this, that map[string]uint32) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSortedStrings(deriveKeys_(this))
-	thatkeys := deriveSortedStrings(deriveKeys_(that))
+	thiskeys := deriveSort(deriveKeys(this))
+	thatkeys := deriveSort(deriveKeys(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
@@ -9472,11 +8356,11 @@ func deriveCompare_92(this, that map[string]uint32) int {
 	return 0
 }
 
-// deriveCompare_93 returns:
+// deriveCompare_95 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_93(this, that map[uint8]int64) int {
+func deriveCompare_95(this, that map[uint8]int64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9492,8 +8376,8 @@ func deriveCompare_93(this, that map[uint8]int64) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSort(deriveKeys_1(this))
-	thatkeys := deriveSort(deriveKeys_1(that))
+	thiskeys := deriveSort_(deriveKeys_(this))
+	thatkeys := deriveSort_(deriveKeys_(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
@@ -9511,11 +8395,11 @@ func deriveCompare_93(this, that map[uint8]int64) int {
 	return 0
 }
 
-// deriveCompare_94 returns:
+// deriveCompare_96 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_94(this, that map[bool]string) int {
+func deriveCompare_96(this, that map[bool]string) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9531,8 +8415,8 @@ func deriveCompare_94(this, that map[bool]string) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSort_(deriveKeys_2(this))
-	thatkeys := deriveSort_(deriveKeys_2(that))
+	thiskeys := deriveSort_1(deriveKeys_1(this))
+	thatkeys := deriveSort_1(deriveKeys_1(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
@@ -9550,11 +8434,11 @@ func deriveCompare_94(this, that map[bool]string) int {
 	return 0
 }
 
-// deriveCompare_95 returns:
+// deriveCompare_97 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_95(this, that map[string]bool) int {
+func deriveCompare_97(this, that map[string]bool) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9570,8 +8454,8 @@ func deriveCompare_95(this, that map[string]bool) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSortedStrings(deriveKeys_3(this))
-	thatkeys := deriveSortedStrings(deriveKeys_3(that))
+	thiskeys := deriveSort(deriveKeys_2(this))
+	thatkeys := deriveSort(deriveKeys_2(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
@@ -9589,11 +8473,11 @@ func deriveCompare_95(this, that map[string]bool) int {
 	return 0
 }
 
-// deriveCompare_96 returns:
+// deriveCompare_98 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_96(this, that map[complex128]complex64) int {
+func deriveCompare_98(this, that map[complex128]complex64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9609,18 +8493,18 @@ func deriveCompare_96(this, that map[complex128]complex64) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSort_1(deriveKeys_4(this))
-	thatkeys := deriveSort_1(deriveKeys_4(that))
+	thiskeys := deriveSort_2(deriveKeys_3(this))
+	thatkeys := deriveSort_2(deriveKeys_3(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
 			thisvalue := this[thiskey]
 			thatvalue := that[thatkey]
-			if c := deriveCompareComplex32(thisvalue, thatvalue); c != 0 {
+			if c := deriveCompare_2(thisvalue, thatvalue); c != 0 {
 				return c
 			}
 		} else {
-			if c := deriveCompareComplex64(thiskey, thatkey); c != 0 {
+			if c := deriveCompare_1(thiskey, thatkey); c != 0 {
 				return c
 			}
 		}
@@ -9628,11 +8512,11 @@ func deriveCompare_96(this, that map[complex128]complex64) int {
 	return 0
 }
 
-// deriveCompare_97 returns:
+// deriveCompare_99 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_97(this, that map[float64]uint32) int {
+func deriveCompare_99(this, that map[float64]uint32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9648,8 +8532,8 @@ func deriveCompare_97(this, that map[float64]uint32) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSort_2(deriveKeys_5(this))
-	thatkeys := deriveSort_2(deriveKeys_5(that))
+	thiskeys := deriveSort_3(deriveKeys_4(this))
+	thatkeys := deriveSort_3(deriveKeys_4(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
@@ -9667,11 +8551,11 @@ func deriveCompare_97(this, that map[float64]uint32) int {
 	return 0
 }
 
-// deriveCompare_98 returns:
+// deriveCompare_100 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_98(this, that map[uint16]uint8) int {
+func deriveCompare_100(this, that map[uint16]uint8) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9687,8 +8571,8 @@ func deriveCompare_98(this, that map[uint16]uint8) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSort_3(deriveKeys_6(this))
-	thatkeys := deriveSort_3(deriveKeys_6(that))
+	thiskeys := deriveSort_4(deriveKeys_5(this))
+	thatkeys := deriveSort_4(deriveKeys_5(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
@@ -9706,11 +8590,11 @@ func deriveCompare_98(this, that map[uint16]uint8) int {
 	return 0
 }
 
-// deriveCompare_99 returns:
+// deriveCompare_101 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_99(this, that [][]int) int {
+func deriveCompare_101(this, that [][]int) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9727,18 +8611,18 @@ func deriveCompare_99(this, that [][]int) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_24(this[i], that[i]); c != 0 {
+		if c := deriveCompare_26(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_100 returns:
+// deriveCompare_102 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_100(this, that [][]string) int {
+func deriveCompare_102(this, that [][]string) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9755,18 +8639,18 @@ func deriveCompare_100(this, that [][]string) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_29(this[i], that[i]); c != 0 {
+		if c := deriveCompare_31(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_101 returns:
+// deriveCompare_103 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_101(this, that [][]*int) int {
+func deriveCompare_103(this, that [][]*int) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9783,18 +8667,18 @@ func deriveCompare_101(this, that [][]*int) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_41(this[i], that[i]); c != 0 {
+		if c := deriveCompare_43(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_102 returns:
+// deriveCompare_104 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_102(this, that *[]int) int {
+func deriveCompare_104(this, that *[]int) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9804,14 +8688,14 @@ func deriveCompare_102(this, that *[]int) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_24(*this, *that)
+	return deriveCompare_26(*this, *that)
 }
 
-// deriveCompare_103 returns:
+// deriveCompare_105 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_103(this, that *[4]int) int {
+func deriveCompare_105(this, that *[4]int) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9821,14 +8705,14 @@ func deriveCompare_103(this, that *[4]int) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_130(*this, *that)
+	return deriveCompare_135(*this, *that)
 }
 
-// deriveCompare_104 returns:
+// deriveCompare_106 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_104(this, that *map[int]int) int {
+func deriveCompare_106(this, that *map[int]int) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9838,14 +8722,14 @@ func deriveCompare_104(this, that *map[int]int) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_131(*this, *that)
+	return deriveCompare_136(*this, *that)
 }
 
-// deriveCompare_105 returns:
+// deriveCompare_107 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_105(this, that []Name) int {
+func deriveCompare_107(this, that []Name) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9869,11 +8753,11 @@ func deriveCompare_105(this, that []Name) int {
 	return 0
 }
 
-// deriveCompare_106 returns:
+// deriveCompare_108 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_106(this, that []*Name) int {
+func deriveCompare_108(this, that []*Name) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9897,11 +8781,11 @@ func deriveCompare_106(this, that []*Name) int {
 	return 0
 }
 
-// deriveCompare_107 returns:
+// deriveCompare_109 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_107(this, that map[Name]string) int {
+func deriveCompare_109(this, that map[Name]string) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9917,8 +8801,8 @@ func deriveCompare_107(this, that map[Name]string) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSort_4(deriveKeys_7(this))
-	thatkeys := deriveSort_4(deriveKeys_7(that))
+	thiskeys := deriveSort_5(deriveKeys_6(this))
+	thatkeys := deriveSort_5(deriveKeys_6(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
@@ -9936,11 +8820,11 @@ func deriveCompare_107(this, that map[Name]string) int {
 	return 0
 }
 
-// deriveCompare_108 returns:
+// deriveCompare_110 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_108(this, that map[string]Name) int {
+func deriveCompare_110(this, that map[string]Name) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9956,8 +8840,8 @@ func deriveCompare_108(this, that map[string]Name) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSortedStrings(deriveKeys_8(this))
-	thatkeys := deriveSortedStrings(deriveKeys_8(that))
+	thiskeys := deriveSort(deriveKeys_7(this))
+	thatkeys := deriveSort(deriveKeys_7(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
@@ -9975,11 +8859,11 @@ func deriveCompare_108(this, that map[string]Name) int {
 	return 0
 }
 
-// deriveCompare_109 returns:
+// deriveCompare_111 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_109(this, that map[string]*Name) int {
+func deriveCompare_111(this, that map[string]*Name) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9995,8 +8879,8 @@ func deriveCompare_109(this, that map[string]*Name) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSortedStrings(deriveKeys_9(this))
-	thatkeys := deriveSortedStrings(deriveKeys_9(that))
+	thiskeys := deriveSort(deriveKeys_8(this))
+	thatkeys := deriveSort(deriveKeys_8(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
@@ -10014,11 +8898,11 @@ func deriveCompare_109(this, that map[string]*Name) int {
 	return 0
 }
 
-// deriveCompare_110 returns:
+// deriveCompare_112 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_110(this, that map[string][]Name) int {
+func deriveCompare_112(this, that map[string][]Name) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10034,14 +8918,14 @@ func deriveCompare_110(this, that map[string][]Name) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSortedStrings(deriveKeys_10(this))
-	thatkeys := deriveSortedStrings(deriveKeys_10(that))
+	thiskeys := deriveSort(deriveKeys_9(this))
+	thatkeys := deriveSort(deriveKeys_9(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
 			thisvalue := this[thiskey]
 			thatvalue := that[thatkey]
-			if c := deriveCompare_105(thisvalue, thatvalue); c != 0 {
+			if c := deriveCompare_107(thisvalue, thatvalue); c != 0 {
 				return c
 			}
 		} else {
@@ -10053,11 +8937,11 @@ func deriveCompare_110(this, that map[string][]Name) int {
 	return 0
 }
 
-// deriveCompare_111 returns:
+// deriveCompare_113 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_111(this, that map[string][]*Name) int {
+func deriveCompare_113(this, that map[string][]*Name) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10073,14 +8957,14 @@ func deriveCompare_111(this, that map[string][]*Name) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSortedStrings(deriveKeys_11(this))
-	thatkeys := deriveSortedStrings(deriveKeys_11(that))
+	thiskeys := deriveSort(deriveKeys_10(this))
+	thatkeys := deriveSort(deriveKeys_10(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
 			thisvalue := this[thiskey]
 			thatvalue := that[thatkey]
-			if c := deriveCompare_106(thisvalue, thatvalue); c != 0 {
+			if c := deriveCompare_108(thisvalue, thatvalue); c != 0 {
 				return c
 			}
 		} else {
@@ -10092,11 +8976,11 @@ func deriveCompare_111(this, that map[string][]*Name) int {
 	return 0
 }
 
-// deriveCompare_112 returns:
+// deriveCompare_114 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_112(this, that map[int]RecursiveType) int {
+func deriveCompare_114(this, that map[int]RecursiveType) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10112,8 +8996,8 @@ func deriveCompare_112(this, that map[int]RecursiveType) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSortedInts(deriveKeys_12(this))
-	thatkeys := deriveSortedInts(deriveKeys_12(that))
+	thiskeys := deriveSort_6(deriveKeys_11(this))
+	thatkeys := deriveSort_6(deriveKeys_11(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
@@ -10131,11 +9015,11 @@ func deriveCompare_112(this, that map[int]RecursiveType) int {
 	return 0
 }
 
-// deriveCompare_113 returns:
+// deriveCompare_115 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_113(this, that *StructWithoutEqualMethod) int {
+func deriveCompare_115(this, that *StructWithoutEqualMethod) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10151,11 +9035,11 @@ func deriveCompare_113(this, that *StructWithoutEqualMethod) int {
 	return 0
 }
 
-// deriveCompare_114 returns:
+// deriveCompare_116 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_114(this, that *extra.StructWithoutEqualMethod) int {
+func deriveCompare_116(this, that *extra.StructWithoutEqualMethod) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10171,11 +9055,11 @@ func deriveCompare_114(this, that *extra.StructWithoutEqualMethod) int {
 	return 0
 }
 
-// deriveCompare_115 returns:
+// deriveCompare_117 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_115(this, that *extra.PrivateFieldAndNoEqualMethod) int {
+func deriveCompare_117(this, that *extra.PrivateFieldAndNoEqualMethod) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10190,16 +9074,16 @@ func deriveCompare_115(this, that *extra.PrivateFieldAndNoEqualMethod) int {
 	if c := deriveCompare_int6(*(*int64)(unsafe.Pointer(thisv.FieldByName("number").UnsafeAddr())), *(*int64)(unsafe.Pointer(thatv.FieldByName("number").UnsafeAddr()))); c != 0 {
 		return c
 	}
-	if c := deriveCompare_27(*(*[]int64)(unsafe.Pointer(thisv.FieldByName("numbers").UnsafeAddr())), *(*[]int64)(unsafe.Pointer(thatv.FieldByName("numbers").UnsafeAddr()))); c != 0 {
+	if c := deriveCompare_29(*(*[]int64)(unsafe.Pointer(thisv.FieldByName("numbers").UnsafeAddr())), *(*[]int64)(unsafe.Pointer(thatv.FieldByName("numbers").UnsafeAddr()))); c != 0 {
 		return c
 	}
-	if c := deriveCompare_11(*(**int64)(unsafe.Pointer(thisv.FieldByName("ptr").UnsafeAddr())), *(**int64)(unsafe.Pointer(thatv.FieldByName("ptr").UnsafeAddr()))); c != 0 {
+	if c := deriveCompare_13(*(**int64)(unsafe.Pointer(thisv.FieldByName("ptr").UnsafeAddr())), *(**int64)(unsafe.Pointer(thatv.FieldByName("ptr").UnsafeAddr()))); c != 0 {
 		return c
 	}
-	if c := deriveCompare_44(*(*[]*int64)(unsafe.Pointer(thisv.FieldByName("numberpts").UnsafeAddr())), *(*[]*int64)(unsafe.Pointer(thatv.FieldByName("numberpts").UnsafeAddr()))); c != 0 {
+	if c := deriveCompare_46(*(*[]*int64)(unsafe.Pointer(thisv.FieldByName("numberpts").UnsafeAddr())), *(*[]*int64)(unsafe.Pointer(thatv.FieldByName("numberpts").UnsafeAddr()))); c != 0 {
 		return c
 	}
-	if c := deriveCompare_114(*(**extra.StructWithoutEqualMethod)(unsafe.Pointer(thisv.FieldByName("strct").UnsafeAddr())), *(**extra.StructWithoutEqualMethod)(unsafe.Pointer(thatv.FieldByName("strct").UnsafeAddr()))); c != 0 {
+	if c := deriveCompare_116(*(**extra.StructWithoutEqualMethod)(unsafe.Pointer(thisv.FieldByName("strct").UnsafeAddr())), *(**extra.StructWithoutEqualMethod)(unsafe.Pointer(thatv.FieldByName("strct").UnsafeAddr()))); c != 0 {
 		return c
 	}
 	return 0
@@ -10220,11 +9104,11 @@ func deriveCompare_M(this, that MyEnum) int {
 	return 0
 }
 
-// deriveCompare_116 returns:
+// deriveCompare_118 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_116(this, that *MyEnum) int {
+func deriveCompare_118(this, that *MyEnum) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10237,11 +9121,11 @@ func deriveCompare_116(this, that *MyEnum) int {
 	return deriveCompare_M(*this, *that)
 }
 
-// deriveCompare_117 returns:
+// deriveCompare_119 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_117(this, that []MyEnum) int {
+func deriveCompare_119(this, that []MyEnum) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10265,11 +9149,11 @@ func deriveCompare_117(this, that []MyEnum) int {
 	return 0
 }
 
-// deriveCompare_118 returns:
+// deriveCompare_120 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_118(this, that []*MyEnum) int {
+func deriveCompare_120(this, that []*MyEnum) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10286,18 +9170,18 @@ func deriveCompare_118(this, that []*MyEnum) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_116(this[i], that[i]); c != 0 {
+		if c := deriveCompare_118(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_119 returns:
+// deriveCompare_121 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_119(this, that map[int32]MyEnum) int {
+func deriveCompare_121(this, that map[int32]MyEnum) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10313,8 +9197,8 @@ func deriveCompare_119(this, that map[int32]MyEnum) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSort_5(deriveKeys_13(this))
-	thatkeys := deriveSort_5(deriveKeys_13(that))
+	thiskeys := deriveSort_7(deriveKeys_12(this))
+	thatkeys := deriveSort_7(deriveKeys_12(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
@@ -10332,11 +9216,11 @@ func deriveCompare_119(this, that map[int32]MyEnum) int {
 	return 0
 }
 
-// deriveCompare_120 returns:
+// deriveCompare_122 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_120(this, that map[MyEnum]int32) int {
+func deriveCompare_122(this, that map[MyEnum]int32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10352,8 +9236,8 @@ func deriveCompare_120(this, that map[MyEnum]int32) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSort_6(deriveKeys_14(this))
-	thatkeys := deriveSort_6(deriveKeys_14(that))
+	thiskeys := deriveSort_8(deriveKeys_13(this))
+	thatkeys := deriveSort_8(deriveKeys_13(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
@@ -10371,11 +9255,11 @@ func deriveCompare_120(this, that map[MyEnum]int32) int {
 	return 0
 }
 
-// deriveCompare_121 returns:
+// deriveCompare_123 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_121(this, that [2]MyEnum) int {
+func deriveCompare_123(this, that [2]MyEnum) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -10390,11 +9274,11 @@ func deriveCompare_121(this, that [2]MyEnum) int {
 	return 0
 }
 
-// deriveCompare_122 returns:
+// deriveCompare_124 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_122(this, that *MySlice) int {
+func deriveCompare_124(this, that *MySlice) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10404,14 +9288,14 @@ func deriveCompare_122(this, that *MySlice) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_27(*this, *that)
+	return deriveCompare_29(*this, *that)
 }
 
-// deriveCompare_123 returns:
+// deriveCompare_125 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_123(this, that []MySlice) int {
+func deriveCompare_125(this, that []MySlice) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10428,7 +9312,7 @@ func deriveCompare_123(this, that []MySlice) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_27(this[i], that[i]); c != 0 {
+		if c := deriveCompare_29(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
@@ -10450,11 +9334,28 @@ func deriveCompare_D(this, that time.Duration) int {
 	return 0
 }
 
-// deriveCompare_124 returns:
+// deriveCompare_126 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_126(this, that *time.Duration) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	return deriveCompare_D(*this, *that)
+}
+
+// deriveCompare_127 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_124(this, that *time.Duration) int {
+func deriveCompare_127(this, that []time.Duration) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10464,14 +9365,151 @@ func deriveCompare_124(this, that *time.Duration) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_D(*this, *that)
+	if len(this) != len(that) {
+		if len(this) < len(that) {
+			return -1
+		}
+		return 1
+	}
+	for i := 0; i < len(this); i++ {
+		if c := deriveCompare_D(this[i], that[i]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// deriveCompare_128 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_128(this, that []*time.Duration) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if len(this) != len(that) {
+		if len(this) < len(that) {
+			return -1
+		}
+		return 1
+	}
+	for i := 0; i < len(this); i++ {
+		if c := deriveCompare_126(this[i], that[i]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// deriveCompare_129 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_129(this, that map[int]time.Duration) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if len(this) != len(that) {
+		if len(this) < len(that) {
+			return -1
+		}
+		return 1
+	}
+	thiskeys := deriveSort_6(deriveKeys_14(this))
+	thatkeys := deriveSort_6(deriveKeys_14(that))
+	for i, thiskey := range thiskeys {
+		thatkey := thatkeys[i]
+		if thiskey == thatkey {
+			thisvalue := this[thiskey]
+			thatvalue := that[thatkey]
+			if c := deriveCompare_D(thisvalue, thatvalue); c != 0 {
+				return c
+			}
+		} else {
+			if c := deriveCompare_i(thiskey, thatkey); c != 0 {
+				return c
+			}
+		}
+	}
+	return 0
+}
+
+// deriveCompare_130 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_130(this, that map[string][]*pickle.Rick) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if len(this) != len(that) {
+		if len(this) < len(that) {
+			return -1
+		}
+		return 1
+	}
+	thiskeys := deriveSort(deriveKeys_15(this))
+	thatkeys := deriveSort(deriveKeys_15(that))
+	for i, thiskey := range thiskeys {
+		thatkey := thatkeys[i]
+		if thiskey == thatkey {
+			thisvalue := this[thiskey]
+			thatvalue := that[thatkey]
+			if c := deriveCompare_137(thisvalue, thatvalue); c != 0 {
+				return c
+			}
+		} else {
+			if c := strings.Compare(thiskey, thatkey); c != 0 {
+				return c
+			}
+		}
+	}
+	return 0
+}
+
+// deriveCompare_131 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_131(this, that *privateStruct) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := deriveCompare_10(this.ptrfield, that.ptrfield); c != 0 {
+		return c
+	}
+	return 0
 }
 
-// deriveCompare_125 returns:
+// deriveCompare_132 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_125(this, that []time.Duration) int {
+func deriveCompare_132(this, that *TaggedVersion) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10481,25 +9519,17 @@ func deriveCompare_125(this, that []time.Duration) int {
 	if that == nil {
 		return 1
 	}
-	if len(this) != len(that) {
-		if len(this) < len(that) {
-			return -1
-		}
-		return 1
-	}
-	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_D(this[i], that[i]); c != 0 {
-			return c
-		}
+	if c := deriveCompare_i(this.Major, that.Major); c != 0 {
+		return c
 	}
 	return 0
 }
 
-// deriveCompare_126 returns:
+// deriveCompare_S returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_126(this, that []*time.Duration) int {
+func deriveCompare_S(this, that Shape) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10509,25 +9539,36 @@ func deriveCompare_126(this, that []*time.Duration) int {
 	if that == nil {
 		return 1
 	}
-	if len(this) != len(that) {
-		if len(this) < len(that) {
-			return -1
-		}
-		return 1
+	if thisType, thatType := reflect.TypeOf(this), reflect.TypeOf(that); thisType != thatType {
+		return strings.Compare(thisType.String(), thatType.String())
 	}
-	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_124(this[i], that[i]); c != 0 {
-			return c
+	switch this := this.(type) {
+	case *Circle:
+		that := that.(*Circle)
+		return deriveCompare_138(this, that)
+	case Square:
+		that := that.(Square)
+		return deriveCompare_139(&this, &that)
+	case *Square:
+		that := that.(*Square)
+		return deriveCompare_139(this, that)
+	}
+	if cmp := reflect.ValueOf(this).MethodByName("Compare"); cmp.IsValid() {
+		if t := cmp.Type(); t.NumIn() == 1 && t.In(0) == reflect.TypeOf(that) && t.NumOut() == 1 && t.Out(0).Kind() == reflect.Int {
+			return int(cmp.Call([]reflect.Value{reflect.ValueOf(that)})[0].Int())
 		}
 	}
-	return 0
+	if reflect.DeepEqual(this, that) {
+		return 0
+	}
+	return strings.Compare(fmt.Sprintf("%#v", this), fmt.Sprintf("%#v", that))
 }
 
-// deriveCompare_127 returns:
+// deriveCompare_133 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_127(this, that map[int]time.Duration) int {
+func deriveCompare_133(this, that []Shape) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10543,30 +9584,19 @@ func deriveCompare_127(this, that map[int]time.Duration) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSortedInts(deriveKeys_15(this))
-	thatkeys := deriveSortedInts(deriveKeys_15(that))
-	for i, thiskey := range thiskeys {
-		thatkey := thatkeys[i]
-		if thiskey == thatkey {
-			thisvalue := this[thiskey]
-			thatvalue := that[thatkey]
-			if c := deriveCompare_D(thisvalue, thatvalue); c != 0 {
-				return c
-			}
-		} else {
-			if c := deriveCompare_i(thiskey, thatkey); c != 0 {
-				return c
-			}
+	for i := 0; i < len(this); i++ {
+		if c := deriveCompare_S(this[i], that[i]); c != 0 {
+			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_128 returns:
+// deriveCompare_e returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_128(this, that map[string][]*pickle.Rick) int {
+func deriveCompare_e(this, that error) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10576,36 +9606,25 @@ func deriveCompare_128(this, that map[string][]*pickle.Rick) int {
 	if that == nil {
 		return 1
 	}
-	if len(this) != len(that) {
-		if len(this) < len(that) {
-			return -1
-		}
-		return 1
+	if thisType, thatType := reflect.TypeOf(this), reflect.TypeOf(that); thisType != thatType {
+		return strings.Compare(thisType.String(), thatType.String())
 	}
-	thiskeys := deriveSortedStrings(deriveKeys_16(this))
-	thatkeys := deriveSortedStrings(deriveKeys_16(that))
-	for i, thiskey := range thiskeys {
-		thatkey := thatkeys[i]
-		if thiskey == thatkey {
-			thisvalue := this[thiskey]
-			thatvalue := that[thatkey]
-			if c := deriveCompare_132(thisvalue, thatvalue); c != 0 {
-				return c
-			}
-		} else {
-			if c := strings.Compare(thiskey, thatkey); c != 0 {
-				return c
-			}
+	if cmp := reflect.ValueOf(this).MethodByName("Compare"); cmp.IsValid() {
+		if t := cmp.Type(); t.NumIn() == 1 && t.In(0) == reflect.TypeOf(that) && t.NumOut() == 1 && t.Out(0).Kind() == reflect.Int {
+			return int(cmp.Call([]reflect.Value{reflect.ValueOf(that)})[0].Int())
 		}
 	}
-	return 0
+	if reflect.DeepEqual(this, that) {
+		return 0
+	}
+	return strings.Compare(fmt.Sprintf("%#v", this), fmt.Sprintf("%#v", that))
 }
 
-// deriveCompare_129 returns:
+// deriveCompare_134 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_129(this, that *privateStruct) int {
+func deriveCompare_134(this, that interface{}) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10615,34 +9634,28 @@ func deriveCompare_129(this, that *privateStruct) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_8(this.ptrfield, that.ptrfield); c != 0 {
-		return c
+	if thisType, thatType := reflect.TypeOf(this), reflect.TypeOf(that); thisType != thatType {
+		return strings.Compare(thisType.String(), thatType.String())
 	}
-	return 0
-}
-
-// deriveTuple returns a function, which returns the input values.
-// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
-func deriveTuple(v0 int, v1 error) func() (int, error) {
-	return func() (int, error) {
-		return v0, v1
+	if cmp := reflect.ValueOf(this).MethodByName("Compare"); cmp.IsValid() {
+		if t := cmp.Type(); t.NumIn() == 1 && t.In(0) == reflect.TypeOf(that) && t.NumOut() == 1 && t.Out(0).Kind() == reflect.Int {
+			return int(cmp.Call([]reflect.Value{reflect.ValueOf(that)})[0].Int())
+		}
 	}
-}
-
-// deriveTuple_ returns a function, which returns the input values.
-// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
-func deriveTuple_(v0 int, v1 string, v2 error) func() (int, string, error) {
-	return func() (int, string, error) {
-		return v0, v1, v2
+	if reflect.DeepEqual(this, that) {
+		return 0
 	}
+	return strings.Compare(fmt.Sprintf("%#v", this), fmt.Sprintf("%#v", that))
 }
 
-// deriveTuple_i returns a function, which returns the input values.
-// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
-func deriveTuple_i(v0 int64, v1 error) func() (int64, error) {
-	return func() (int64, error) {
-		return v0, v1
+// deriveString_ appends a compact and human readable representation of this to buf.
+func deriveString_(this *Loggable, buf []byte) []byte {
+	if this == nil {
+		return append(buf, "nil"...)
 	}
+	buf = append(buf, '&')
+	buf = deriveString_L(*this, buf)
+	return buf
 }
 
 // deriveEqual_ returns whether this and that are equal.
@@ -10726,7 +9739,7 @@ func deriveEqual_4(this, that []float32) bool {
 }
 
 // deriveEqual_5 returns whether this and that are equal.
-func deriveEqual_5(this, that []int16) bool {
+func deriveEqual_5(this, that []int) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -10742,7 +9755,7 @@ func deriveEqual_5(this, that []int16) bool {
 }
 
 // deriveEqual_6 returns whether this and that are equal.
-func deriveEqual_6(this, that []int32) bool {
+func deriveEqual_6(this, that []int16) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -10758,7 +9771,7 @@ func deriveEqual_6(this, that []int32) bool {
 }
 
 // deriveEqual_7 returns whether this and that are equal.
-func deriveEqual_7(this, that []int64) bool {
+func deriveEqual_7(this, that []int32) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -10774,7 +9787,7 @@ func deriveEqual_7(this, that []int64) bool {
 }
 
 // deriveEqual_8 returns whether this and that are equal.
-func deriveEqual_8(this, that []int8) bool {
+func deriveEqual_8(this, that []int64) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -10790,7 +9803,7 @@ func deriveEqual_8(this, that []int8) bool {
 }
 
 // deriveEqual_9 returns whether this and that are equal.
-func deriveEqual_9(this, that []string) bool {
+func deriveEqual_9(this, that []int8) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -10806,7 +9819,7 @@ func deriveEqual_9(this, that []string) bool {
 }
 
 // deriveEqual_10 returns whether this and that are equal.
-func deriveEqual_10(this, that []uint) bool {
+func deriveEqual_10(this, that []string) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -10822,7 +9835,7 @@ func deriveEqual_10(this, that []uint) bool {
 }
 
 // deriveEqual_11 returns whether this and that are equal.
-func deriveEqual_11(this, that []uint16) bool {
+func deriveEqual_11(this, that []uint) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -10838,7 +9851,7 @@ func deriveEqual_11(this, that []uint16) bool {
 }
 
 // deriveEqual_12 returns whether this and that are equal.
-func deriveEqual_12(this, that []uint32) bool {
+func deriveEqual_12(this, that []uint16) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -10854,7 +9867,7 @@ func deriveEqual_12(this, that []uint32) bool {
 }
 
 // deriveEqual_13 returns whether this and that are equal.
-func deriveEqual_13(this, that []uint64) bool {
+func deriveEqual_13(this, that []uint32) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -10870,7 +9883,7 @@ func deriveEqual_13(this, that []uint64) bool {
 }
 
 // deriveEqual_14 returns whether this and that are equal.
-func deriveEqual_14(this, that []uintptr) bool {
+func deriveEqual_14(this, that []uint64) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -10886,7 +9899,7 @@ func deriveEqual_14(this, that []uintptr) bool {
 }
 
 // deriveEqual_15 returns whether this and that are equal.
-func deriveEqual_15(this, that []*bool) bool {
+func deriveEqual_15(this, that []uintptr) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -10894,7 +9907,7 @@ func deriveEqual_15(this, that []*bool) bool {
 		return false
 	}
 	for i := 0; i < len(this); i++ {
-		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
+		if !(this[i] == that[i]) {
 			return false
 		}
 	}
@@ -10902,7 +9915,7 @@ func deriveEqual_15(this, that []*bool) bool {
 }
 
 // deriveEqual_16 returns whether this and that are equal.
-func deriveEqual_16(this, that []*byte) bool {
+func deriveEqual_16(this, that []*bool) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -10918,7 +9931,7 @@ func deriveEqual_16(this, that []*byte) bool {
 }
 
 // deriveEqual_17 returns whether this and that are equal.
-func deriveEqual_17(this, that []*complex128) bool {
+func deriveEqual_17(this, that []*byte) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -10934,7 +9947,7 @@ func deriveEqual_17(this, that []*complex128) bool {
 }
 
 // deriveEqual_18 returns whether this and that are equal.
-func deriveEqual_18(this, that []*complex64) bool {
+func deriveEqual_18(this, that []*complex128) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -10950,7 +9963,7 @@ func deriveEqual_18(this, that []*complex64) bool {
 }
 
 // deriveEqual_19 returns whether this and that are equal.
-func deriveEqual_19(this, that []*float64) bool {
+func deriveEqual_19(this, that []*complex64) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -10966,7 +9979,7 @@ func deriveEqual_19(this, that []*float64) bool {
 }
 
 // deriveEqual_20 returns whether this and that are equal.
-func deriveEqual_20(this, that []*float32) bool {
+func deriveEqual_20(this, that []*float64) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -10982,7 +9995,7 @@ func deriveEqual_20(this, that []*float32) bool {
 }
 
 // deriveEqual_21 returns whether this and that are equal.
-func deriveEqual_21(this, that []*int) bool {
+func deriveEqual_21(this, that []*float32) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -10998,7 +10011,7 @@ func deriveEqual_21(this, that []*int) bool {
 }
 
 // deriveEqual_22 returns whether this and that are equal.
-func deriveEqual_22(this, that []*int16) bool {
+func deriveEqual_22(this, that []*int) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11014,7 +10027,7 @@ func deriveEqual_22(this, that []*int16) bool {
 }
 
 // deriveEqual_23 returns whether this and that are equal.
-func deriveEqual_23(this, that []*int32) bool {
+func deriveEqual_23(this, that []*int16) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11030,7 +10043,7 @@ func deriveEqual_23(this, that []*int32) bool {
 }
 
 // deriveEqual_24 returns whether this and that are equal.
-func deriveEqual_24(this, that []*int64) bool {
+func deriveEqual_24(this, that []*int32) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11046,7 +10059,7 @@ func deriveEqual_24(this, that []*int64) bool {
 }
 
 // deriveEqual_25 returns whether this and that are equal.
-func deriveEqual_25(this, that []*int8) bool {
+func deriveEqual_25(this, that []*int64) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11062,7 +10075,7 @@ func deriveEqual_25(this, that []*int8) bool {
 }
 
 // deriveEqual_26 returns whether this and that are equal.
-func deriveEqual_26(this, that []*string) bool {
+func deriveEqual_26(this, that []*int8) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11078,7 +10091,7 @@ func deriveEqual_26(this, that []*string) bool {
 }
 
 // deriveEqual_27 returns whether this and that are equal.
-func deriveEqual_27(this, that []*uint) bool {
+func deriveEqual_27(this, that []*string) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11094,7 +10107,7 @@ func deriveEqual_27(this, that []*uint) bool {
 }
 
 // deriveEqual_28 returns whether this and that are equal.
-func deriveEqual_28(this, that []*uint16) bool {
+func deriveEqual_28(this, that []*uint) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11110,7 +10123,7 @@ func deriveEqual_28(this, that []*uint16) bool {
 }
 
 // deriveEqual_29 returns whether this and that are equal.
-func deriveEqual_29(this, that []*uint32) bool {
+func deriveEqual_29(this, that []*uint16) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11126,7 +10139,7 @@ func deriveEqual_29(this, that []*uint32) bool {
 }
 
 // deriveEqual_30 returns whether this and that are equal.
-func deriveEqual_30(this, that []*uint64) bool {
+func deriveEqual_30(this, that []*uint32) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11142,7 +10155,7 @@ func deriveEqual_30(this, that []*uint64) bool {
 }
 
 // deriveEqual_31 returns whether this and that are equal.
-func deriveEqual_31(this, that []*uintptr) bool {
+func deriveEqual_31(this, that []*uint64) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11158,7 +10171,13 @@ func deriveEqual_31(this, that []*uintptr) bool {
 }
 
 // deriveEqual_32 returns whether this and that are equal.
-func deriveEqual_32(this, that [1]*bool) bool {
+func deriveEqual_32(this, that []*uintptr) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if len(this) != len(that) {
+		return false
+	}
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -11168,7 +10187,7 @@ func deriveEqual_32(this, that [1]*bool) bool {
 }
 
 // deriveEqual_33 returns whether this and that are equal.
-func deriveEqual_33(this, that [2]*byte) bool {
+func deriveEqual_33(this, that [1]*bool) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -11178,7 +10197,7 @@ func deriveEqual_33(this, that [2]*byte) bool {
 }
 
 // deriveEqual_34 returns whether this and that are equal.
-func deriveEqual_34(this, that [3]*complex128) bool {
+func deriveEqual_34(this, that [2]*byte) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -11188,7 +10207,7 @@ func deriveEqual_34(this, that [3]*complex128) bool {
 }
 
 // deriveEqual_35 returns whether this and that are equal.
-func deriveEqual_35(this, that [4]*complex64) bool {
+func deriveEqual_35(this, that [3]*complex128) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -11198,7 +10217,7 @@ func deriveEqual_35(this, that [4]*complex64) bool {
 }
 
 // deriveEqual_36 returns whether this and that are equal.
-func deriveEqual_36(this, that [5]*float64) bool {
+func deriveEqual_36(this, that [4]*complex64) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -11208,7 +10227,7 @@ func deriveEqual_36(this, that [5]*float64) bool {
 }
 
 // deriveEqual_37 returns whether this and that are equal.
-func deriveEqual_37(this, that [6]*float32) bool {
+func deriveEqual_37(this, that [5]*float64) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -11218,7 +10237,7 @@ func deriveEqual_37(this, that [6]*float32) bool {
 }
 
 // deriveEqual_38 returns whether this and that are equal.
-func deriveEqual_38(this, that [7]*int) bool {
+func deriveEqual_38(this, that [6]*float32) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -11228,7 +10247,7 @@ func deriveEqual_38(this, that [7]*int) bool {
 }
 
 // deriveEqual_39 returns whether this and that are equal.
-func deriveEqual_39(this, that [8]*int16) bool {
+func deriveEqual_39(this, that [7]*int) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -11238,7 +10257,7 @@ func deriveEqual_39(this, that [8]*int16) bool {
 }
 
 // deriveEqual_40 returns whether this and that are equal.
-func deriveEqual_40(this, that [9]*int32) bool {
+func deriveEqual_40(this, that [8]*int16) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -11248,7 +10267,7 @@ func deriveEqual_40(this, that [9]*int32) bool {
 }
 
 // deriveEqual_41 returns whether this and that are equal.
-func deriveEqual_41(this, that [10]*int64) bool {
+func deriveEqual_41(this, that [9]*int32) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -11258,7 +10277,7 @@ func deriveEqual_41(this, that [10]*int64) bool {
 }
 
 // deriveEqual_42 returns whether this and that are equal.
-func deriveEqual_42(this, that [11]*int8) bool {
+func deriveEqual_42(this, that [10]*int64) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -11268,7 +10287,7 @@ func deriveEqual_42(this, that [11]*int8) bool {
 }
 
 // deriveEqual_43 returns whether this and that are equal.
-func deriveEqual_43(this, that [12]*rune) bool {
+func deriveEqual_43(this, that [11]*int8) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -11278,7 +10297,7 @@ func deriveEqual_43(this, that [12]*rune) bool {
 }
 
 // deriveEqual_44 returns whether this and that are equal.
-func deriveEqual_44(this, that [13]*string) bool {
+func deriveEqual_44(this, that [12]*rune) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -11288,7 +10307,7 @@ func deriveEqual_44(this, that [13]*string) bool {
 }
 
 // deriveEqual_45 returns whether this and that are equal.
-func deriveEqual_45(this, that [14]*uint) bool {
+func deriveEqual_45(this, that [13]*string) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -11298,7 +10317,7 @@ func deriveEqual_45(this, that [14]*uint) bool {
 }
 
 // deriveEqual_46 returns whether this and that are equal.
-func deriveEqual_46(this, that [15]*uint16) bool {
+func deriveEqual_46(this, that [14]*uint) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -11308,7 +10327,7 @@ func deriveEqual_46(this, that [15]*uint16) bool {
 }
 
 // deriveEqual_47 returns whether this and that are equal.
-func deriveEqual_47(this, that [16]*uint32) bool {
+func deriveEqual_47(this, that [15]*uint16) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -11318,7 +10337,7 @@ func deriveEqual_47(this, that [16]*uint32) bool {
 }
 
 // deriveEqual_48 returns whether this and that are equal.
-func deriveEqual_48(this, that [17]*uint64) bool {
+func deriveEqual_48(this, that [16]*uint32) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -11328,7 +10347,7 @@ func deriveEqual_48(this, that [17]*uint64) bool {
 }
 
 // deriveEqual_49 returns whether this and that are equal.
-func deriveEqual_49(this, that [18]*uint8) bool {
+func deriveEqual_49(this, that [17]*uint64) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -11338,7 +10357,7 @@ func deriveEqual_49(this, that [18]*uint8) bool {
 }
 
 // deriveEqual_50 returns whether this and that are equal.
-func deriveEqual_50(this, that [19]*uintptr) bool {
+func deriveEqual_50(this, that [18]*uint8) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -11348,7 +10367,7 @@ func deriveEqual_50(this, that [19]*uintptr) bool {
 }
 
 // deriveEqual_51 returns whether this and that are equal.
-func deriveEqual_51(this, that [10]*bool) bool {
+func deriveEqual_51(this, that [19]*uintptr) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -11358,7 +10377,17 @@ func deriveEqual_51(this, that [10]*bool) bool {
 }
 
 // deriveEqual_52 returns whether this and that are equal.
-func deriveEqual_52(this, that map[string]uint32) bool {
+func deriveEqual_52(this, that [10]*bool) bool {
+	for i := 0; i < len(this); i++ {
+		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveEqual_53 returns whether this and that are equal.
+func deriveEqual_53(this, that map[string]uint32) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11377,8 +10406,8 @@ func deriveEqual_52(this, that map[string]uint32) bool {
 	return true
 }
 
-// deriveEqual_53 returns whether this and that are equal.
-func deriveEqual_53(this, that map[uint8]int64) bool {
+// deriveEqual_54 returns whether this and that are equal.
+func deriveEqual_54(this, that map[uint8]int64) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11397,8 +10426,8 @@ func deriveEqual_53(this, that map[uint8]int64) bool {
 	return true
 }
 
-// deriveEqual_54 returns whether this and that are equal.
-func deriveEqual_54(this, that map[bool]string) bool {
+// deriveEqual_55 returns whether this and that are equal.
+func deriveEqual_55(this, that map[bool]string) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11417,8 +10446,8 @@ func deriveEqual_54(this, that map[bool]string) bool {
 	return true
 }
 
-// deriveEqual_55 returns whether this and that are equal.
-func deriveEqual_55(this, that map[string]bool) bool {
+// deriveEqual_56 returns whether this and that are equal.
+func deriveEqual_56(this, that map[string]bool) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11437,8 +10466,8 @@ func deriveEqual_55(this, that map[string]bool) bool {
 	return true
 }
 
-// deriveEqual_56 returns whether this and that are equal.
-func deriveEqual_56(this, that map[complex128]complex64) bool {
+// deriveEqual_57 returns whether this and that are equal.
+func deriveEqual_57(this, that map[complex128]complex64) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11457,8 +10486,8 @@ func deriveEqual_56(this, that map[complex128]complex64) bool {
 	return true
 }
 
-// deriveEqual_57 returns whether this and that are equal.
-func deriveEqual_57(this, that map[float64]uint32) bool {
+// deriveEqual_58 returns whether this and that are equal.
+func deriveEqual_58(this, that map[float64]uint32) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11477,8 +10506,8 @@ func deriveEqual_57(this, that map[float64]uint32) bool {
 	return true
 }
 
-// deriveEqual_58 returns whether this and that are equal.
-func deriveEqual_58(this, that map[uint16]uint8) bool {
+// deriveEqual_59 returns whether this and that are equal.
+func deriveEqual_59(this, that map[uint16]uint8) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11497,8 +10526,8 @@ func deriveEqual_58(this, that map[uint16]uint8) bool {
 	return true
 }
 
-// deriveEqual_59 returns whether this and that are equal.
-func deriveEqual_59(this, that [][]int) bool {
+// deriveEqual_60 returns whether this and that are equal.
+func deriveEqual_60(this, that [][]int) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11506,15 +10535,15 @@ func deriveEqual_59(this, that [][]int) bool {
 		return false
 	}
 	for i := 0; i < len(this); i++ {
-		if !(deriveEqualSliceOfint(this[i], that[i])) {
+		if !(deriveEqual_5(this[i], that[i])) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveEqual_60 returns whether this and that are equal.
-func deriveEqual_60(this, that [][]string) bool {
+// deriveEqual_61 returns whether this and that are equal.
+func deriveEqual_61(this, that [][]string) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11522,15 +10551,15 @@ func deriveEqual_60(this, that [][]string) bool {
 		return false
 	}
 	for i := 0; i < len(this); i++ {
-		if !(deriveEqual_9(this[i], that[i])) {
+		if !(deriveEqual_10(this[i], that[i])) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveEqual_61 returns whether this and that are equal.
-func deriveEqual_61(this, that [][]*int) bool {
+// deriveEqual_62 returns whether this and that are equal.
+func deriveEqual_62(this, that [][]*int) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11538,15 +10567,35 @@ func deriveEqual_61(this, that [][]*int) bool {
 		return false
 	}
 	for i := 0; i < len(this); i++ {
-		if !(deriveEqual_21(this[i], that[i])) {
+		if !(deriveEqual_22(this[i], that[i])) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveEqual_62 returns whether this and that are equal.
-func deriveEqual_62(this, that []Name) bool {
+// deriveEqual_63 returns whether this and that are equal.
+func deriveEqual_63(this, that map[int]int) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if len(this) != len(that) {
+		return false
+	}
+	for k, v := range this {
+		thatv, ok := that[k]
+		if !ok {
+			return false
+		}
+		if !(v == thatv) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveEqual_64 returns whether this and that are equal.
+func deriveEqual_64(this, that []Name) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11561,8 +10610,8 @@ func deriveEqual_62(this, that []Name) bool {
 	return true
 }
 
-// deriveEqual_63 returns whether this and that are equal.
-func deriveEqual_63(this, that []*Name) bool {
+// deriveEqual_65 returns whether this and that are equal.
+func deriveEqual_65(this, that []*Name) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11577,8 +10626,8 @@ func deriveEqual_63(this, that []*Name) bool {
 	return true
 }
 
-// deriveEqual_64 returns whether this and that are equal.
-func deriveEqual_64(this, that map[Name]string) bool {
+// deriveEqual_66 returns whether this and that are equal.
+func deriveEqual_66(this, that map[Name]string) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11597,8 +10646,8 @@ func deriveEqual_64(this, that map[Name]string) bool {
 	return true
 }
 
-// deriveEqual_65 returns whether this and that are equal.
-func deriveEqual_65(this, that map[string]Name) bool {
+// deriveEqual_67 returns whether this and that are equal.
+func deriveEqual_67(this, that map[string]Name) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11617,8 +10666,8 @@ func deriveEqual_65(this, that map[string]Name) bool {
 	return true
 }
 
-// deriveEqual_66 returns whether this and that are equal.
-func deriveEqual_66(this, that map[string]*Name) bool {
+// deriveEqual_68 returns whether this and that are equal.
+func deriveEqual_68(this, that map[string]*Name) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11637,8 +10686,8 @@ func deriveEqual_66(this, that map[string]*Name) bool {
 	return true
 }
 
-// deriveEqual_67 returns whether this and that are equal.
-func deriveEqual_67(this, that map[string][]Name) bool {
+// deriveEqual_69 returns whether this and that are equal.
+func deriveEqual_69(this, that map[string][]Name) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11650,15 +10699,15 @@ func deriveEqual_67(this, that map[string][]Name) bool {
 		if !ok {
 			return false
 		}
-		if !(deriveEqual_62(v, thatv)) {
+		if !(deriveEqual_64(v, thatv)) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveEqual_68 returns whether this and that are equal.
-func deriveEqual_68(this, that map[string][]*Name) bool {
+// deriveEqual_70 returns whether this and that are equal.
+func deriveEqual_70(this, that map[string][]*Name) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11670,15 +10719,15 @@ func deriveEqual_68(this, that map[string][]*Name) bool {
 		if !ok {
 			return false
 		}
-		if !(deriveEqual_63(v, thatv)) {
+		if !(deriveEqual_65(v, thatv)) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveEqual_69 returns whether this and that are equal.
-func deriveEqual_69(this, that map[int]RecursiveType) bool {
+// deriveEqual_71 returns whether this and that are equal.
+func deriveEqual_71(this, that map[int]RecursiveType) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11697,35 +10746,35 @@ func deriveEqual_69(this, that map[int]RecursiveType) bool {
 	return true
 }
 
-// deriveEqual_70 returns whether this and that are equal.
-func deriveEqual_70(this, that *StructWithoutEqualMethod) bool {
+// deriveEqual_72 returns whether this and that are equal.
+func deriveEqual_72(this, that *StructWithoutEqualMethod) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
 			this.Num == that.Num
 }
 
-// deriveEqual_71 returns whether this and that are equal.
-func deriveEqual_71(this, that *extra.StructWithoutEqualMethod) bool {
+// deriveEqual_73 returns whether this and that are equal.
+func deriveEqual_73(this, that *extra.StructWithoutEqualMethod) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
 			this.Number == that.Number
 }
 
-// deriveEqual_72 returns whether this and that are equal.
-func deriveEqual_72(this, that *extra.PrivateFieldAndNoEqualMethod) bool {
+// deriveEqual_74 returns whether this and that are equal.
+func deriveEqual_74(this, that *extra.PrivateFieldAndNoEqualMethod) bool {
 	thisv := reflect.Indirect(reflect.ValueOf(this))
 	thatv := reflect.Indirect(reflect.ValueOf(that))
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
 			*(*int64)(unsafe.Pointer(thisv.FieldByName("number").UnsafeAddr())) == *(*int64)(unsafe.Pointer(thatv.FieldByName("number").UnsafeAddr())) &&
-			deriveEqual_7(*(*[]int64)(unsafe.Pointer(thisv.FieldByName("numbers").UnsafeAddr())), *(*[]int64)(unsafe.Pointer(thatv.FieldByName("numbers").UnsafeAddr()))) &&
+			deriveEqual_8(*(*[]int64)(unsafe.Pointer(thisv.FieldByName("numbers").UnsafeAddr())), *(*[]int64)(unsafe.Pointer(thatv.FieldByName("numbers").UnsafeAddr()))) &&
 			((*(**int64)(unsafe.Pointer(thisv.FieldByName("ptr").UnsafeAddr())) == nil && *(**int64)(unsafe.Pointer(thatv.FieldByName("ptr").UnsafeAddr())) == nil) || (*(**int64)(unsafe.Pointer(thisv.FieldByName("ptr").UnsafeAddr())) != nil && *(**int64)(unsafe.Pointer(thatv.FieldByName("ptr").UnsafeAddr())) != nil && *(*(**int64)(unsafe.Pointer(thisv.FieldByName("ptr").UnsafeAddr()))) == *(*(**int64)(unsafe.Pointer(thatv.FieldByName("ptr").UnsafeAddr()))))) &&
-			deriveEqual_24(*(*[]*int64)(unsafe.Pointer(thisv.FieldByName("numberpts").UnsafeAddr())), *(*[]*int64)(unsafe.Pointer(thatv.FieldByName("numberpts").UnsafeAddr()))) &&
-			deriveEqual_71(*(**extra.StructWithoutEqualMethod)(unsafe.Pointer(thisv.FieldByName("strct").UnsafeAddr())), *(**extra.StructWithoutEqualMethod)(unsafe.Pointer(thatv.FieldByName("strct").UnsafeAddr())))
+			deriveEqual_25(*(*[]*int64)(unsafe.Pointer(thisv.FieldByName("numberpts").UnsafeAddr())), *(*[]*int64)(unsafe.Pointer(thatv.FieldByName("numberpts").UnsafeAddr()))) &&
+			deriveEqual_73(*(**extra.StructWithoutEqualMethod)(unsafe.Pointer(thisv.FieldByName("strct").UnsafeAddr())), *(**extra.StructWithoutEqualMethod)(unsafe.Pointer(thatv.FieldByName("strct").UnsafeAddr())))
 }
 
-// deriveEqual_73 returns whether this and that are equal.
-func deriveEqual_73(this, that *MyEnum) bool {
+// deriveEqual_75 returns whether this and that are equal.
+func deriveEqual_75(this, that *MyEnum) bool {
 	if this == nil && that == nil {
 		return true
 	}
@@ -11735,8 +10784,8 @@ func deriveEqual_73(this, that *MyEnum) bool {
 	return false
 }
 
-// deriveEqual_74 returns whether this and that are equal.
-func deriveEqual_74(this, that []MyEnum) bool {
+// deriveEqual_76 returns whether this and that are equal.
+func deriveEqual_76(this, that []MyEnum) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11751,8 +10800,8 @@ func deriveEqual_74(this, that []MyEnum) bool {
 	return true
 }
 
-// deriveEqual_75 returns whether this and that are equal.
-func deriveEqual_75(this, that []*MyEnum) bool {
+// deriveEqual_77 returns whether this and that are equal.
+func deriveEqual_77(this, that []*MyEnum) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11760,15 +10809,15 @@ func deriveEqual_75(this, that []*MyEnum) bool {
 		return false
 	}
 	for i := 0; i < len(this); i++ {
-		if !(deriveEqual_73(this[i], that[i])) {
+		if !(deriveEqual_75(this[i], that[i])) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveEqual_76 returns whether this and that are equal.
-func deriveEqual_76(this, that map[int32]MyEnum) bool {
+// deriveEqual_78 returns whether this and that are equal.
+func deriveEqual_78(this, that map[int32]MyEnum) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11787,8 +10836,8 @@ func deriveEqual_76(this, that map[int32]MyEnum) bool {
 	return true
 }
 
-// deriveEqual_77 returns whether this and that are equal.
-func deriveEqual_77(this, that map[MyEnum]int32) bool {
+// deriveEqual_79 returns whether this and that are equal.
+func deriveEqual_79(this, that map[MyEnum]int32) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11807,8 +10856,8 @@ func deriveEqual_77(this, that map[MyEnum]int32) bool {
 	return true
 }
 
-// deriveEqual_78 returns whether this and that are equal.
-func deriveEqual_78(this, that *MySlice) bool {
+// deriveEqual_80 returns whether this and that are equal.
+func deriveEqual_80(this, that *MySlice) bool {
 	if this == nil && that == nil {
 		return true
 	}
@@ -11829,8 +10878,8 @@ func deriveEqual_78(this, that *MySlice) bool {
 	return false
 }
 
-// deriveEqual_79 returns whether this and that are equal.
-func deriveEqual_79(this, that []MySlice) bool {
+// deriveEqual_81 returns whether this and that are equal.
+func deriveEqual_81(this, that []MySlice) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11838,15 +10887,15 @@ func deriveEqual_79(this, that []MySlice) bool {
 		return false
 	}
 	for i := 0; i < len(this); i++ {
-		if !(deriveEqual_7(this[i], that[i])) {
+		if !(deriveEqual_8(this[i], that[i])) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveEqual_80 returns whether this and that are equal.
-func deriveEqual_80(this, that *time.Duration) bool {
+// deriveEqual_82 returns whether this and that are equal.
+func deriveEqual_82(this, that *time.Duration) bool {
 	if this == nil && that == nil {
 		return true
 	}
@@ -11856,8 +10905,8 @@ func deriveEqual_80(this, that *time.Duration) bool {
 	return false
 }
 
-// deriveEqual_81 returns whether this and that are equal.
-func deriveEqual_81(this, that []time.Duration) bool {
+// deriveEqual_83 returns whether this and that are equal.
+func deriveEqual_83(this, that []time.Duration) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11872,8 +10921,8 @@ func deriveEqual_81(this, that []time.Duration) bool {
 	return true
 }
 
-// deriveEqual_82 returns whether this and that are equal.
-func deriveEqual_82(this, that []*time.Duration) bool {
+// deriveEqual_84 returns whether this and that are equal.
+func deriveEqual_84(this, that []*time.Duration) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11881,15 +10930,15 @@ func deriveEqual_82(this, that []*time.Duration) bool {
 		return false
 	}
 	for i := 0; i < len(this); i++ {
-		if !(deriveEqual_80(this[i], that[i])) {
+		if !(deriveEqual_82(this[i], that[i])) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveEqual_83 returns whether this and that are equal.
-func deriveEqual_83(this, that map[int]time.Duration) bool {
+// deriveEqual_85 returns whether this and that are equal.
+func deriveEqual_85(this, that map[int]time.Duration) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11908,8 +10957,8 @@ func deriveEqual_83(this, that map[int]time.Duration) bool {
 	return true
 }
 
-// deriveEqual_84 returns whether this and that are equal.
-func deriveEqual_84(this, that map[string][]*pickle.Rick) bool {
+// deriveEqual_86 returns whether this and that are equal.
+func deriveEqual_86(this, that map[string][]*pickle.Rick) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11921,22 +10970,56 @@ func deriveEqual_84(this, that map[string][]*pickle.Rick) bool {
 		if !ok {
 			return false
 		}
-		if !(deriveEqual_88(v, thatv)) {
+		if !(deriveEqual_91(v, thatv)) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveEqual_85 returns whether this and that are equal.
-func deriveEqual_85(this, that *privateStruct) bool {
+// deriveEqual_87 returns whether this and that are equal.
+func deriveEqual_87(this, that *privateStruct) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
 			((this.ptrfield == nil && that.ptrfield == nil) || (this.ptrfield != nil && that.ptrfield != nil && *(this.ptrfield) == *(that.ptrfield)))
 }
 
-// deriveEqual_86 returns whether this and that are equal.
-func deriveEqual_86(this, that []*vendortest.AVendoredObject) bool {
+// deriveEqual_88 returns whether this and that are equal.
+func deriveEqual_88(this, that *TaggedVersion) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Major == that.Major
+}
+
+// deriveEqual_S returns whether this and that are equal.
+func deriveEqual_S(this, that Shape) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if reflect.TypeOf(this) != reflect.TypeOf(that) {
+		return false
+	}
+	switch this := this.(type) {
+	case *Circle:
+		that := that.(*Circle)
+		return deriveEqual_92(this, that)
+	case Square:
+		that := that.(Square)
+		return deriveEqual_93(&this, &that)
+	case *Square:
+		that := that.(*Square)
+		return deriveEqual_93(this, that)
+	}
+	if eq := reflect.ValueOf(this).MethodByName("Equal"); eq.IsValid() {
+		if t := eq.Type(); t.NumIn() == 1 && t.In(0) == reflect.TypeOf(that) && t.NumOut() == 1 && t.Out(0).Kind() == reflect.Bool {
+			return eq.Call([]reflect.Value{reflect.ValueOf(that)})[0].Bool()
+		}
+	}
+	return reflect.DeepEqual(this, that)
+}
+
+// deriveEqual_89 returns whether this and that are equal.
+func deriveEqual_89(this, that []Shape) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11944,73 +11027,108 @@ func deriveEqual_86(this, that []*vendortest.AVendoredObject) bool {
 		return false
 	}
 	for i := 0; i < len(this); i++ {
-		if !(deriveEqual_89(this[i], that[i])) {
+		if !(deriveEqual_S(this[i], that[i])) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveEqual_87 returns whether this and that are equal.
-func deriveEqual_87(this, that struct {
-	Param0 *BuiltInTypes
-	Param1 int
-}) bool {
-	return this.Param0.Equal(that.Param0) &&
-		this.Param1 == that.Param1
+// deriveEqual_e returns whether this and that are equal.
+func deriveEqual_e(this, that error) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if reflect.TypeOf(this) != reflect.TypeOf(that) {
+		return false
+	}
+	if eq := reflect.ValueOf(this).MethodByName("Equal"); eq.IsValid() {
+		if t := eq.Type(); t.NumIn() == 1 && t.In(0) == reflect.TypeOf(that) && t.NumOut() == 1 && t.Out(0).Kind() == reflect.Bool {
+			return eq.Call([]reflect.Value{reflect.ValueOf(that)})[0].Bool()
+		}
+	}
+	return reflect.DeepEqual(this, that)
+}
+
+// deriveEqual_90 returns whether this and that are equal.
+func deriveEqual_90(this, that interface{}) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if reflect.TypeOf(this) != reflect.TypeOf(that) {
+		return false
+	}
+	if eq := reflect.ValueOf(this).MethodByName("Equal"); eq.IsValid() {
+		if t := eq.Type(); t.NumIn() == 1 && t.In(0) == reflect.TypeOf(that) && t.NumOut() == 1 && t.Out(0).Kind() == reflect.Bool {
+			return eq.Call([]reflect.Value{reflect.ValueOf(that)})[0].Bool()
+		}
+	}
+	return reflect.DeepEqual(this, that)
 }
 
 // deriveSort sorts the slice inplace and also returns it.
-func deriveSort(list []uint8) []uint8 {
-	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+func deriveSort(list []string) []string {
+	sort.Strings(list)
 	return list
 }
 
 // deriveSort_ sorts the slice inplace and also returns it.
-func deriveSort_(list []bool) []bool {
-	sort.Slice(list, func(i, j int) bool { return deriveCompare(list[i], list[j]) < 0 })
+func deriveSort_(list []uint8) []uint8 {
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
 	return list
 }
 
 // deriveSort_1 sorts the slice inplace and also returns it.
-func deriveSort_1(list []complex128) []complex128 {
-	sort.Slice(list, func(i, j int) bool { return deriveCompareComplex64(list[i], list[j]) < 0 })
+func deriveSort_1(list []bool) []bool {
+	sort.Slice(list, func(i, j int) bool { return deriveCompare(list[i], list[j]) < 0 })
 	return list
 }
 
 // deriveSort_2 sorts the slice inplace and also returns it.
-func deriveSort_2(list []float64) []float64 {
-	sort.Float64s(list)
+func deriveSort_2(list []complex128) []complex128 {
+	sort.Slice(list, func(i, j int) bool { return deriveCompare_1(list[i], list[j]) < 0 })
 	return list
 }
 
 // deriveSort_3 sorts the slice inplace and also returns it.
-func deriveSort_3(list []uint16) []uint16 {
-	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+func deriveSort_3(list []float64) []float64 {
+	sort.Float64s(list)
 	return list
 }
 
 // deriveSort_4 sorts the slice inplace and also returns it.
-func deriveSort_4(list []Name) []Name {
-	sort.Slice(list, func(i, j int) bool { return deriveCompare_N(list[i], list[j]) < 0 })
+func deriveSort_4(list []uint16) []uint16 {
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
 	return list
 }
 
 // deriveSort_5 sorts the slice inplace and also returns it.
-func deriveSort_5(list []int32) []int32 {
-	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+func deriveSort_5(list []Name) []Name {
+	sort.Slice(list, func(i, j int) bool { return deriveCompare_N(list[i], list[j]) < 0 })
 	return list
 }
 
 // deriveSort_6 sorts the slice inplace and also returns it.
-func deriveSort_6(list []MyEnum) []MyEnum {
+func deriveSort_6(list []int) []int {
+	sort.Ints(list)
+	return list
+}
+
+// deriveSort_7 sorts the slice inplace and also returns it.
+func deriveSort_7(list []int32) []int32 {
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+	return list
+}
+
+// deriveSort_8 sorts the slice inplace and also returns it.
+func deriveSort_8(list []MyEnum) []MyEnum {
 	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
 	return list
 }
 
 // deriveKeys returns the keys of the input map as a slice.
-func deriveKeys(m map[int]int) []int {
-	keys := make([]int, 0, len(m))
+func deriveKeys(m map[string]uint32) []string {
+	keys := make([]string, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
 	}
@@ -12018,8 +11136,8 @@ func deriveKeys(m map[int]int) []int {
 }
 
 // deriveKeys_ returns the keys of the input map as a slice.
-func deriveKeys_(m map[string]uint32) []string {
-	keys := make([]string, 0, len(m))
+func deriveKeys_(m map[uint8]int64) []uint8 {
+	keys := make([]uint8, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
 	}
@@ -12027,8 +11145,8 @@ func deriveKeys_(m map[string]uint32) []string {
 }
 
 // deriveKeys_1 returns the keys of the input map as a slice.
-func deriveKeys_1(m map[uint8]int64) []uint8 {
-	keys := make([]uint8, 0, len(m))
+func deriveKeys_1(m map[bool]string) []bool {
+	keys := make([]bool, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
 	}
@@ -12036,8 +11154,8 @@ func deriveKeys_1(m map[uint8]int64) []uint8 {
 }
 
 // deriveKeys_2 returns the keys of the input map as a slice.
-func deriveKeys_2(m map[bool]string) []bool {
-	keys := make([]bool, 0, len(m))
+func deriveKeys_2(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
 	}
@@ -12045,8 +11163,8 @@ func deriveKeys_2(m map[bool]string) []bool {
 }
 
 // deriveKeys_3 returns the keys of the input map as a slice.
-func deriveKeys_3(m map[string]bool) []string {
-	keys := make([]string, 0, len(m))
+func deriveKeys_3(m map[complex128]complex64) []complex128 {
+	keys := make([]complex128, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
 	}
@@ -12054,8 +11172,8 @@ func deriveKeys_3(m map[string]bool) []string {
 }
 
 // deriveKeys_4 returns the keys of the input map as a slice.
-func deriveKeys_4(m map[complex128]complex64) []complex128 {
-	keys := make([]complex128, 0, len(m))
+func deriveKeys_4(m map[float64]uint32) []float64 {
+	keys := make([]float64, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
 	}
@@ -12063,8 +11181,8 @@ func deriveKeys_4(m map[complex128]complex64) []complex128 {
 }
 
 // deriveKeys_5 returns the keys of the input map as a slice.
-func deriveKeys_5(m map[float64]uint32) []float64 {
-	keys := make([]float64, 0, len(m))
+func deriveKeys_5(m map[uint16]uint8) []uint16 {
+	keys := make([]uint16, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
 	}
@@ -12072,8 +11190,8 @@ func deriveKeys_5(m map[float64]uint32) []float64 {
 }
 
 // deriveKeys_6 returns the keys of the input map as a slice.
-func deriveKeys_6(m map[uint16]uint8) []uint16 {
-	keys := make([]uint16, 0, len(m))
+func deriveKeys_6(m map[Name]string) []Name {
+	keys := make([]Name, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
 	}
@@ -12081,8 +11199,8 @@ func deriveKeys_6(m map[uint16]uint8) []uint16 {
 }
 
 // deriveKeys_7 returns the keys of the input map as a slice.
-func deriveKeys_7(m map[Name]string) []Name {
-	keys := make([]Name, 0, len(m))
+func deriveKeys_7(m map[string]Name) []string {
+	keys := make([]string, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
 	}
@@ -12090,7 +11208,7 @@ func deriveKeys_7(m map[Name]string) []Name {
 }
 
 // deriveKeys_8 returns the keys of the input map as a slice.
-func deriveKeys_8(m map[string]Name) []string {
+func deriveKeys_8(m map[string]*Name) []string {
 	keys := make([]string, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -12099,7 +11217,7 @@ func deriveKeys_8(m map[string]Name) []string {
 }
 
 // deriveKeys_9 returns the keys of the input map as a slice.
-func deriveKeys_9(m map[string]*Name) []string {
+func deriveKeys_9(m map[string][]Name) []string {
 	keys := make([]string, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -12108,16 +11226,7 @@ func deriveKeys_9(m map[string]*Name) []string {
 }
 
 // deriveKeys_10 returns the keys of the input map as a slice.
-func deriveKeys_10(m map[string][]Name) []string {
-	keys := make([]string, 0, len(m))
-	for key := range m {
-		keys = append(keys, key)
-	}
-	return keys
-}
-
-// deriveKeys_11 returns the keys of the input map as a slice.
-func deriveKeys_11(m map[string][]*Name) []string {
+func deriveKeys_10(m map[string][]*Name) []string {
 	keys := make([]string, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -12125,8 +11234,8 @@ func deriveKeys_11(m map[string][]*Name) []string {
 	return keys
 }
 
-// deriveKeys_12 returns the keys of the input map as a slice.
-func deriveKeys_12(m map[int]RecursiveType) []int {
+// deriveKeys_11 returns the keys of the input map as a slice.
+func deriveKeys_11(m map[int]RecursiveType) []int {
 	keys := make([]int, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -12134,8 +11243,8 @@ func deriveKeys_12(m map[int]RecursiveType) []int {
 	return keys
 }
 
-// deriveKeys_13 returns the keys of the input map as a slice.
-func deriveKeys_13(m map[int32]MyEnum) []int32 {
+// deriveKeys_12 returns the keys of the input map as a slice.
+func deriveKeys_12(m map[int32]MyEnum) []int32 {
 	keys := make([]int32, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -12143,8 +11252,8 @@ func deriveKeys_13(m map[int32]MyEnum) []int32 {
 	return keys
 }
 
-// deriveKeys_14 returns the keys of the input map as a slice.
-func deriveKeys_14(m map[MyEnum]int32) []MyEnum {
+// deriveKeys_13 returns the keys of the input map as a slice.
+func deriveKeys_13(m map[MyEnum]int32) []MyEnum {
 	keys := make([]MyEnum, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -12152,8 +11261,8 @@ func deriveKeys_14(m map[MyEnum]int32) []MyEnum {
 	return keys
 }
 
-// deriveKeys_15 returns the keys of the input map as a slice.
-func deriveKeys_15(m map[int]time.Duration) []int {
+// deriveKeys_14 returns the keys of the input map as a slice.
+func deriveKeys_14(m map[int]time.Duration) []int {
 	keys := make([]int, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -12161,8 +11270,8 @@ func deriveKeys_15(m map[int]time.Duration) []int {
 	return keys
 }
 
-// deriveKeys_16 returns the keys of the input map as a slice.
-func deriveKeys_16(m map[string][]*pickle.Rick) []string {
+// deriveKeys_15 returns the keys of the input map as a slice.
+func deriveKeys_15(m map[string][]*pickle.Rick) []string {
 	keys := make([]string, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -12236,7 +11345,7 @@ func deriveHash_6(object *float32) uint64 {
 }
 
 // deriveHash_7 returns the hash of the object.
-func deriveHash_7(object *int16) uint64 {
+func deriveHash_7(object *int) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -12244,7 +11353,7 @@ func deriveHash_7(object *int16) uint64 {
 }
 
 // deriveHash_8 returns the hash of the object.
-func deriveHash_8(object *int32) uint64 {
+func deriveHash_8(object *int16) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -12252,7 +11361,7 @@ func deriveHash_8(object *int32) uint64 {
 }
 
 // deriveHash_9 returns the hash of the object.
-func deriveHash_9(object *int64) uint64 {
+func deriveHash_9(object *int32) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -12260,7 +11369,7 @@ func deriveHash_9(object *int64) uint64 {
 }
 
 // deriveHash_10 returns the hash of the object.
-func deriveHash_10(object *int8) uint64 {
+func deriveHash_10(object *int64) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -12268,23 +11377,23 @@ func deriveHash_10(object *int8) uint64 {
 }
 
 // deriveHash_11 returns the hash of the object.
-func deriveHash_11(object *string) uint64 {
+func deriveHash_11(object *int8) uint64 {
 	if object == nil {
 		return 0
 	}
-	return (31 * 17) + deriveHash_(*object)
+	return (31 * 17) + uint64(*object)
 }
 
 // deriveHash_12 returns the hash of the object.
-func deriveHash_12(object *uint) uint64 {
+func deriveHash_12(object *string) uint64 {
 	if object == nil {
 		return 0
 	}
-	return (31 * 17) + uint64(*object)
+	return (31 * 17) + deriveHash_(*object)
 }
 
 // deriveHash_13 returns the hash of the object.
-func deriveHash_13(object *uint16) uint64 {
+func deriveHash_13(object *uint) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -12292,7 +11401,7 @@ func deriveHash_13(object *uint16) uint64 {
 }
 
 // deriveHash_14 returns the hash of the object.
-func deriveHash_14(object *uint32) uint64 {
+func deriveHash_14(object *uint16) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -12300,107 +11409,103 @@ func deriveHash_14(object *uint32) uint64 {
 }
 
 // deriveHash_15 returns the hash of the object.
-func deriveHash_15(object *uint64) uint64 {
+func deriveHash_15(object *uint32) uint64 {
 	if object == nil {
 		return 0
 	}
-	return (31 * 17) + *object
+	return (31 * 17) + uint64(*object)
 }
 
 // deriveHash_16 returns the hash of the object.
-func deriveHash_16(object *uintptr) uint64 {
+func deriveHash_16(object *uint64) uint64 {
 	if object == nil {
 		return 0
 	}
-	return (31 * 17) + uint64(*object)
+	return (31 * 17) + *object
 }
 
 // deriveHash_17 returns the hash of the object.
-func deriveHash_17(object []bool) uint64 {
+func deriveHash_17(object *uintptr) uint64 {
 	if object == nil {
 		return 0
 	}
-	h := uint64(17)
-	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash(object[i])
-	}
-	return h
+	return (31 * 17) + uint64(*object)
 }
 
 // deriveHash_18 returns the hash of the object.
-func deriveHash_18(object []byte) uint64 {
+func deriveHash_18(object []bool) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + uint64(object[i])
+		h = 31*h + deriveHash(object[i])
 	}
 	return h
 }
 
 // deriveHash_19 returns the hash of the object.
-func deriveHash_19(object []complex128) uint64 {
+func deriveHash_19(object []byte) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + (31 * ((31 * 17) + math.Float64bits(real(object[i])))) + math.Float64bits(imag(object[i]))
+		h = 31*h + uint64(object[i])
 	}
 	return h
 }
 
 // deriveHash_20 returns the hash of the object.
-func deriveHash_20(object []complex64) uint64 {
+func deriveHash_20(object []complex128) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + (31 * ((31 * 17) + uint64(math.Float32bits(real(object[i]))))) + uint64(math.Float32bits(imag(object[i])))
+		h = 31*h + (31 * ((31 * 17) + math.Float64bits(real(object[i])))) + math.Float64bits(imag(object[i]))
 	}
 	return h
 }
 
 // deriveHash_21 returns the hash of the object.
-func deriveHash_21(object []float64) uint64 {
+func deriveHash_21(object []complex64) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + math.Float64bits(object[i])
+		h = 31*h + (31 * ((31 * 17) + uint64(math.Float32bits(real(object[i]))))) + uint64(math.Float32bits(imag(object[i])))
 	}
 	return h
 }
 
 // deriveHash_22 returns the hash of the object.
-func deriveHash_22(object []float32) uint64 {
+func deriveHash_22(object []float64) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + uint64(math.Float32bits(object[i]))
+		h = 31*h + math.Float64bits(object[i])
 	}
 	return h
 }
 
 // deriveHash_23 returns the hash of the object.
-func deriveHash_23(object []int16) uint64 {
+func deriveHash_23(object []float32) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + uint64(object[i])
+		h = 31*h + uint64(math.Float32bits(object[i]))
 	}
 	return h
 }
 
 // deriveHash_24 returns the hash of the object.
-func deriveHash_24(object []int32) uint64 {
+func deriveHash_24(object []int) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -12412,7 +11517,7 @@ func deriveHash_24(object []int32) uint64 {
 }
 
 // deriveHash_25 returns the hash of the object.
-func deriveHash_25(object []int64) uint64 {
+func deriveHash_25(object []int16) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -12424,7 +11529,7 @@ func deriveHash_25(object []int64) uint64 {
 }
 
 // deriveHash_26 returns the hash of the object.
-func deriveHash_26(object []int8) uint64 {
+func deriveHash_26(object []int32) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -12436,19 +11541,19 @@ func deriveHash_26(object []int8) uint64 {
 }
 
 // deriveHash_27 returns the hash of the object.
-func deriveHash_27(object []string) uint64 {
+func deriveHash_27(object []int64) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_(object[i])
+		h = 31*h + uint64(object[i])
 	}
 	return h
 }
 
 // deriveHash_28 returns the hash of the object.
-func deriveHash_28(object []uint) uint64 {
+func deriveHash_28(object []int8) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -12460,19 +11565,19 @@ func deriveHash_28(object []uint) uint64 {
 }
 
 // deriveHash_29 returns the hash of the object.
-func deriveHash_29(object []uint16) uint64 {
+func deriveHash_29(object []string) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + uint64(object[i])
+		h = 31*h + deriveHash_(object[i])
 	}
 	return h
 }
 
 // deriveHash_30 returns the hash of the object.
-func deriveHash_30(object []uint32) uint64 {
+func deriveHash_30(object []uint) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -12484,19 +11589,19 @@ func deriveHash_30(object []uint32) uint64 {
 }
 
 // deriveHash_31 returns the hash of the object.
-func deriveHash_31(object []uint64) uint64 {
+func deriveHash_31(object []uint16) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + object[i]
+		h = 31*h + uint64(object[i])
 	}
 	return h
 }
 
 // deriveHash_32 returns the hash of the object.
-func deriveHash_32(object []uintptr) uint64 {
+func deriveHash_32(object []uint32) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -12508,283 +11613,289 @@ func deriveHash_32(object []uintptr) uint64 {
 }
 
 // deriveHash_33 returns the hash of the object.
-func deriveHash_33(object []*bool) uint64 {
+func deriveHash_33(object []uint64) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_1(object[i])
+		h = 31*h + object[i]
 	}
 	return h
 }
 
 // deriveHash_34 returns the hash of the object.
-func deriveHash_34(object []*byte) uint64 {
+func deriveHash_34(object []uintptr) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_2(object[i])
+		h = 31*h + uint64(object[i])
 	}
 	return h
 }
 
 // deriveHash_35 returns the hash of the object.
-func deriveHash_35(object []*complex128) uint64 {
+func deriveHash_35(object []*bool) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_3(object[i])
+		h = 31*h + deriveHash_1(object[i])
 	}
 	return h
 }
 
 // deriveHash_36 returns the hash of the object.
-func deriveHash_36(object []*complex64) uint64 {
+func deriveHash_36(object []*byte) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_4(object[i])
+		h = 31*h + deriveHash_2(object[i])
 	}
 	return h
 }
 
 // deriveHash_37 returns the hash of the object.
-func deriveHash_37(object []*float64) uint64 {
+func deriveHash_37(object []*complex128) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_5(object[i])
+		h = 31*h + deriveHash_3(object[i])
 	}
 	return h
 }
 
 // deriveHash_38 returns the hash of the object.
-func deriveHash_38(object []*float32) uint64 {
+func deriveHash_38(object []*complex64) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_6(object[i])
+		h = 31*h + deriveHash_4(object[i])
 	}
 	return h
 }
 
 // deriveHash_39 returns the hash of the object.
-func deriveHash_39(object []*int) uint64 {
+func deriveHash_39(object []*float64) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHashPtrToint(object[i])
+		h = 31*h + deriveHash_5(object[i])
 	}
 	return h
 }
 
 // deriveHash_40 returns the hash of the object.
-func deriveHash_40(object []*int16) uint64 {
+func deriveHash_40(object []*float32) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_7(object[i])
+		h = 31*h + deriveHash_6(object[i])
 	}
 	return h
 }
 
 // deriveHash_41 returns the hash of the object.
-func deriveHash_41(object []*int32) uint64 {
+func deriveHash_41(object []*int) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_8(object[i])
+		h = 31*h + deriveHash_7(object[i])
 	}
 	return h
 }
 
 // deriveHash_42 returns the hash of the object.
-func deriveHash_42(object []*int64) uint64 {
+func deriveHash_42(object []*int16) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_9(object[i])
+		h = 31*h + deriveHash_8(object[i])
 	}
 	return h
 }
 
 // deriveHash_43 returns the hash of the object.
-func deriveHash_43(object []*int8) uint64 {
+func deriveHash_43(object []*int32) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_10(object[i])
+		h = 31*h + deriveHash_9(object[i])
 	}
 	return h
 }
 
 // deriveHash_44 returns the hash of the object.
-func deriveHash_44(object []*string) uint64 {
+func deriveHash_44(object []*int64) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_11(object[i])
+		h = 31*h + deriveHash_10(object[i])
 	}
 	return h
 }
 
 // deriveHash_45 returns the hash of the object.
-func deriveHash_45(object []*uint) uint64 {
+func deriveHash_45(object []*int8) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_12(object[i])
+		h = 31*h + deriveHash_11(object[i])
 	}
 	return h
 }
 
 // deriveHash_46 returns the hash of the object.
-func deriveHash_46(object []*uint16) uint64 {
+func deriveHash_46(object []*string) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_13(object[i])
+		h = 31*h + deriveHash_12(object[i])
 	}
 	return h
 }
 
 // deriveHash_47 returns the hash of the object.
-func deriveHash_47(object []*uint32) uint64 {
+func deriveHash_47(object []*uint) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_14(object[i])
+		h = 31*h + deriveHash_13(object[i])
 	}
 	return h
 }
 
 // deriveHash_48 returns the hash of the object.
-func deriveHash_48(object []*uint64) uint64 {
+func deriveHash_48(object []*uint16) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_15(object[i])
+		h = 31*h + deriveHash_14(object[i])
 	}
 	return h
 }
 
 // deriveHash_49 returns the hash of the object.
-func deriveHash_49(object []*uintptr) uint64 {
+func deriveHash_49(object []*uint32) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_16(object[i])
+		h = 31*h + deriveHash_15(object[i])
 	}
 	return h
 }
 
 // deriveHash_50 returns the hash of the object.
-func deriveHash_50(object [1]bool) uint64 {
+func deriveHash_50(object []*uint64) uint64 {
+	if object == nil {
+		return 0
+	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash(object[i])
+		h = 31*h + deriveHash_16(object[i])
 	}
 	return h
 }
 
 // deriveHash_51 returns the hash of the object.
-func deriveHash_51(object [2]byte) uint64 {
+func deriveHash_51(object []*uintptr) uint64 {
+	if object == nil {
+		return 0
+	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + uint64(object[i])
+		h = 31*h + deriveHash_17(object[i])
 	}
 	return h
 }
 
 // deriveHash_52 returns the hash of the object.
-func deriveHash_52(object [3]complex128) uint64 {
+func deriveHash_52(object [1]bool) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + (31 * ((31 * 17) + math.Float64bits(real(object[i])))) + math.Float64bits(imag(object[i]))
+		h = 31*h + deriveHash(object[i])
 	}
 	return h
 }
 
 // deriveHash_53 returns the hash of the object.
-func deriveHash_53(object [4]complex64) uint64 {
+func deriveHash_53(object [2]byte) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + (31 * ((31 * 17) + uint64(math.Float32bits(real(object[i]))))) + uint64(math.Float32bits(imag(object[i])))
+		h = 31*h + uint64(object[i])
 	}
 	return h
 }
 
 // deriveHash_54 returns the hash of the object.
-func deriveHash_54(object [5]float64) uint64 {
+func deriveHash_54(object [3]complex128) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + math.Float64bits(object[i])
+		h = 31*h + (31 * ((31 * 17) + math.Float64bits(real(object[i])))) + math.Float64bits(imag(object[i]))
 	}
 	return h
 }
 
 // deriveHash_55 returns the hash of the object.
-func deriveHash_55(object [6]float32) uint64 {
+func deriveHash_55(object [4]complex64) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + uint64(math.Float32bits(object[i]))
+		h = 31*h + (31 * ((31 * 17) + uint64(math.Float32bits(real(object[i]))))) + uint64(math.Float32bits(imag(object[i])))
 	}
 	return h
 }
 
 // deriveHash_56 returns the hash of the object.
-func deriveHash_56(object [7]int) uint64 {
+func deriveHash_56(object [5]float64) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + uint64(object[i])
+		h = 31*h + math.Float64bits(object[i])
 	}
 	return h
 }
 
 // deriveHash_57 returns the hash of the object.
-func deriveHash_57(object [8]int16) uint64 {
+func deriveHash_57(object [6]float32) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + uint64(object[i])
+		h = 31*h + uint64(math.Float32bits(object[i]))
 	}
 	return h
 }
 
 // deriveHash_58 returns the hash of the object.
-func deriveHash_58(object [9]int32) uint64 {
+func deriveHash_58(object [7]int) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(object[i])
@@ -12793,7 +11904,7 @@ func deriveHash_58(object [9]int32) uint64 {
 }
 
 // deriveHash_59 returns the hash of the object.
-func deriveHash_59(object [10]int64) uint64 {
+func deriveHash_59(object [8]int16) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(object[i])
@@ -12802,7 +11913,7 @@ func deriveHash_59(object [10]int64) uint64 {
 }
 
 // deriveHash_60 returns the hash of the object.
-func deriveHash_60(object [11]int8) uint64 {
+func deriveHash_60(object [9]int32) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(object[i])
@@ -12811,7 +11922,7 @@ func deriveHash_60(object [11]int8) uint64 {
 }
 
 // deriveHash_61 returns the hash of the object.
-func deriveHash_61(object [12]rune) uint64 {
+func deriveHash_61(object [10]int64) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(object[i])
@@ -12820,16 +11931,16 @@ func deriveHash_61(object [12]rune) uint64 {
 }
 
 // deriveHash_62 returns the hash of the object.
-func deriveHash_62(object [13]string) uint64 {
+func deriveHash_62(object [11]int8) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_(object[i])
+		h = 31*h + uint64(object[i])
 	}
 	return h
 }
 
 // deriveHash_63 returns the hash of the object.
-func deriveHash_63(object [14]uint) uint64 {
+func deriveHash_63(object [12]rune) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(object[i])
@@ -12838,16 +11949,16 @@ func deriveHash_63(object [14]uint) uint64 {
 }
 
 // deriveHash_64 returns the hash of the object.
-func deriveHash_64(object [15]uint16) uint64 {
+func deriveHash_64(object [13]string) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + uint64(object[i])
+		h = 31*h + deriveHash_(object[i])
 	}
 	return h
 }
 
 // deriveHash_65 returns the hash of the object.
-func deriveHash_65(object [16]uint32) uint64 {
+func deriveHash_65(object [14]uint) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(object[i])
@@ -12856,16 +11967,16 @@ func deriveHash_65(object [16]uint32) uint64 {
 }
 
 // deriveHash_66 returns the hash of the object.
-func deriveHash_66(object [17]uint64) uint64 {
+func deriveHash_66(object [15]uint16) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + object[i]
+		h = 31*h + uint64(object[i])
 	}
 	return h
 }
 
 // deriveHash_67 returns the hash of the object.
-func deriveHash_67(object [18]uint8) uint64 {
+func deriveHash_67(object [16]uint32) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(object[i])
@@ -12874,133 +11985,133 @@ func deriveHash_67(object [18]uint8) uint64 {
 }
 
 // deriveHash_68 returns the hash of the object.
-func deriveHash_68(object [19]uintptr) uint64 {
+func deriveHash_68(object [17]uint64) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + uint64(object[i])
+		h = 31*h + object[i]
 	}
 	return h
 }
 
 // deriveHash_69 returns the hash of the object.
-func deriveHash_69(object [10]bool) uint64 {
+func deriveHash_69(object [18]uint8) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash(object[i])
+		h = 31*h + uint64(object[i])
 	}
 	return h
 }
 
 // deriveHash_70 returns the hash of the object.
-func deriveHash_70(object [1]*bool) uint64 {
+func deriveHash_70(object [19]uintptr) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_1(object[i])
+		h = 31*h + uint64(object[i])
 	}
 	return h
 }
 
 // deriveHash_71 returns the hash of the object.
-func deriveHash_71(object [2]*byte) uint64 {
+func deriveHash_71(object [10]bool) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_2(object[i])
+		h = 31*h + deriveHash(object[i])
 	}
 	return h
 }
 
 // deriveHash_72 returns the hash of the object.
-func deriveHash_72(object [3]*complex128) uint64 {
+func deriveHash_72(object [1]*bool) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_3(object[i])
+		h = 31*h + deriveHash_1(object[i])
 	}
 	return h
 }
 
 // deriveHash_73 returns the hash of the object.
-func deriveHash_73(object [4]*complex64) uint64 {
+func deriveHash_73(object [2]*byte) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_4(object[i])
+		h = 31*h + deriveHash_2(object[i])
 	}
 	return h
 }
 
 // deriveHash_74 returns the hash of the object.
-func deriveHash_74(object [5]*float64) uint64 {
+func deriveHash_74(object [3]*complex128) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_5(object[i])
+		h = 31*h + deriveHash_3(object[i])
 	}
 	return h
 }
 
 // deriveHash_75 returns the hash of the object.
-func deriveHash_75(object [6]*float32) uint64 {
+func deriveHash_75(object [4]*complex64) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_6(object[i])
+		h = 31*h + deriveHash_4(object[i])
 	}
 	return h
 }
 
 // deriveHash_76 returns the hash of the object.
-func deriveHash_76(object [7]*int) uint64 {
+func deriveHash_76(object [5]*float64) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHashPtrToint(object[i])
+		h = 31*h + deriveHash_5(object[i])
 	}
 	return h
 }
 
 // deriveHash_77 returns the hash of the object.
-func deriveHash_77(object [8]*int16) uint64 {
+func deriveHash_77(object [6]*float32) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_7(object[i])
+		h = 31*h + deriveHash_6(object[i])
 	}
 	return h
 }
 
 // deriveHash_78 returns the hash of the object.
-func deriveHash_78(object [9]*int32) uint64 {
+func deriveHash_78(object [7]*int) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_8(object[i])
+		h = 31*h + deriveHash_7(object[i])
 	}
 	return h
 }
 
 // deriveHash_79 returns the hash of the object.
-func deriveHash_79(object [10]*int64) uint64 {
+func deriveHash_79(object [8]*int16) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_9(object[i])
+		h = 31*h + deriveHash_8(object[i])
 	}
 	return h
 }
 
 // deriveHash_80 returns the hash of the object.
-func deriveHash_80(object [11]*int8) uint64 {
+func deriveHash_80(object [9]*int32) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_10(object[i])
+		h = 31*h + deriveHash_9(object[i])
 	}
 	return h
 }
 
 // deriveHash_81 returns the hash of the object.
-func deriveHash_81(object [12]*rune) uint64 {
+func deriveHash_81(object [10]*int64) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_8(object[i])
+		h = 31*h + deriveHash_10(object[i])
 	}
 	return h
 }
 
 // deriveHash_82 returns the hash of the object.
-func deriveHash_82(object [13]*string) uint64 {
+func deriveHash_82(object [11]*int8) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + deriveHash_11(object[i])
@@ -13009,52 +12120,52 @@ func deriveHash_82(object [13]*string) uint64 {
 }
 
 // deriveHash_83 returns the hash of the object.
-func deriveHash_83(object [14]*uint) uint64 {
+func deriveHash_83(object [12]*rune) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_12(object[i])
+		h = 31*h + deriveHash_9(object[i])
 	}
 	return h
 }
 
 // deriveHash_84 returns the hash of the object.
-func deriveHash_84(object [15]*uint16) uint64 {
+func deriveHash_84(object [13]*string) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_13(object[i])
+		h = 31*h + deriveHash_12(object[i])
 	}
 	return h
 }
 
 // deriveHash_85 returns the hash of the object.
-func deriveHash_85(object [16]*uint32) uint64 {
+func deriveHash_85(object [14]*uint) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_14(object[i])
+		h = 31*h + deriveHash_13(object[i])
 	}
 	return h
 }
 
 // deriveHash_86 returns the hash of the object.
-func deriveHash_86(object [17]*uint64) uint64 {
+func deriveHash_86(object [15]*uint16) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_15(object[i])
+		h = 31*h + deriveHash_14(object[i])
 	}
 	return h
 }
 
 // deriveHash_87 returns the hash of the object.
-func deriveHash_87(object [18]*uint8) uint64 {
+func deriveHash_87(object [16]*uint32) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_2(object[i])
+		h = 31*h + deriveHash_15(object[i])
 	}
 	return h
 }
 
 // deriveHash_88 returns the hash of the object.
-func deriveHash_88(object [19]*uintptr) uint64 {
+func deriveHash_88(object [17]*uint64) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + deriveHash_16(object[i])
@@ -13063,147 +12174,181 @@ func deriveHash_88(object [19]*uintptr) uint64 {
 }
 
 // deriveHash_89 returns the hash of the object.
-func deriveHash_89(object [10]*bool) uint64 {
+func deriveHash_89(object [18]*uint8) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_1(object[i])
+		h = 31*h + deriveHash_2(object[i])
 	}
 	return h
 }
 
 // deriveHash_90 returns the hash of the object.
-func deriveHash_90(object map[string]uint32) uint64 {
+func deriveHash_90(object [19]*uintptr) uint64 {
+	h := uint64(17)
+	for i := 0; i < len(object); i++ {
+		h = 31*h + deriveHash_17(object[i])
+	}
+	return h
+}
+
+// deriveHash_91 returns the hash of the object.
+func deriveHash_91(object [10]*bool) uint64 {
+	h := uint64(17)
+	for i := 0; i < len(object); i++ {
+		h = 31*h + deriveHash_1(object[i])
+	}
+	return h
+}
+
+// deriveHash_92 returns the hash of the object.
+func deriveHash_92(object map[string]uint32) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSortedStrings(deriveKeys_(object)) {
+	for _, k := range deriveSort(deriveKeys(object)) {
 		h = 31*h + deriveHash_(k)
 		h = 31*h + uint64(object[k])
 	}
 	return h
 }
 
-// deriveHash_91 returns the hash of the object.
-func deriveHash_91(object map[uint8]int64) uint64 {
+// deriveHash_93 returns the hash of the object.
+func deriveHash_93(object map[uint8]int64) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSort(deriveKeys_1(object)) {
+	for _, k := range deriveSort_(deriveKeys_(object)) {
 		h = 31*h + uint64(k)
 		h = 31*h + uint64(object[k])
 	}
 	return h
 }
 
-// deriveHash_92 returns the hash of the object.
-func deriveHash_92(object map[bool]string) uint64 {
+// deriveHash_94 returns the hash of the object.
+func deriveHash_94(object map[bool]string) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSort_(deriveKeys_2(object)) {
+	for _, k := range deriveSort_1(deriveKeys_1(object)) {
 		h = 31*h + deriveHash(k)
 		h = 31*h + deriveHash_(object[k])
 	}
 	return h
 }
 
-// deriveHash_93 returns the hash of the object.
-func deriveHash_93(object map[string]bool) uint64 {
+// deriveHash_95 returns the hash of the object.
+func deriveHash_95(object map[string]bool) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSortedStrings(deriveKeys_3(object)) {
+	for _, k := range deriveSort(deriveKeys_2(object)) {
 		h = 31*h + deriveHash_(k)
 		h = 31*h + deriveHash(object[k])
 	}
 	return h
 }
 
-// deriveHash_94 returns the hash of the object.
-func deriveHash_94(object map[complex128]complex64) uint64 {
+// deriveHash_96 returns the hash of the object.
+func deriveHash_96(object map[complex128]complex64) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSort_1(deriveKeys_4(object)) {
+	for _, k := range deriveSort_2(deriveKeys_3(object)) {
 		h = 31*h + (31 * ((31 * 17) + math.Float64bits(real(k)))) + math.Float64bits(imag(k))
 		h = 31*h + (31 * ((31 * 17) + uint64(math.Float32bits(real(object[k]))))) + uint64(math.Float32bits(imag(object[k])))
 	}
 	return h
 }
 
-// deriveHash_95 returns the hash of the object.
-func deriveHash_95(object map[float64]uint32) uint64 {
+// deriveHash_97 returns the hash of the object.
+func deriveHash_97(object map[float64]uint32) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSort_2(deriveKeys_5(object)) {
+	for _, k := range deriveSort_3(deriveKeys_4(object)) {
 		h = 31*h + math.Float64bits(k)
 		h = 31*h + uint64(object[k])
 	}
 	return h
 }
 
-// deriveHash_96 returns the hash of the object.
-func deriveHash_96(object map[uint16]uint8) uint64 {
+// deriveHash_98 returns the hash of the object.
+func deriveHash_98(object map[uint16]uint8) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSort_3(deriveKeys_6(object)) {
+	for _, k := range deriveSort_4(deriveKeys_5(object)) {
 		h = 31*h + uint64(k)
 		h = 31*h + uint64(object[k])
 	}
 	return h
 }
 
-// deriveHash_97 returns the hash of the object.
-func deriveHash_97(object [][]int) uint64 {
+// deriveHash_99 returns the hash of the object.
+func deriveHash_99(object [][]int) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHashSliceOfint(object[i])
+		h = 31*h + deriveHash_24(object[i])
 	}
 	return h
 }
 
-// deriveHash_98 returns the hash of the object.
-func deriveHash_98(object [][]string) uint64 {
+// deriveHash_100 returns the hash of the object.
+func deriveHash_100(object [][]string) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_27(object[i])
+		h = 31*h + deriveHash_29(object[i])
 	}
 	return h
 }
 
-// deriveHash_99 returns the hash of the object.
-func deriveHash_99(object [][]*int) uint64 {
+// deriveHash_101 returns the hash of the object.
+func deriveHash_101(object [][]*int) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_39(object[i])
+		h = 31*h + deriveHash_41(object[i])
 	}
 	return h
 }
 
-// deriveHash_100 returns the hash of the object.
-func deriveHash_100(object *[4]int) uint64 {
+// deriveHash_102 returns the hash of the object.
+func deriveHash_102(object *[]int) uint64 {
+	if object == nil {
+		return 0
+	}
+	return (31 * 17) + deriveHash_24(*object)
+}
+
+// deriveHash_103 returns the hash of the object.
+func deriveHash_103(object *[4]int) uint64 {
+	if object == nil {
+		return 0
+	}
+	return (31 * 17) + deriveHash_130(*object)
+}
+
+// deriveHash_104 returns the hash of the object.
+func deriveHash_104(object *map[int]int) uint64 {
 	if object == nil {
 		return 0
 	}
-	return (31 * 17) + deriveHash_126(*object)
+	return (31 * 17) + deriveHash_131(*object)
 }
 
 // deriveHash_N returns the hash of the object.
@@ -13211,8 +12356,8 @@ func deriveHash_N(object Name) uint64 {
 	return deriveHashName(&object)
 }
 
-// deriveHash_101 returns the hash of the object.
-func deriveHash_101(object []Name) uint64 {
+// deriveHash_105 returns the hash of the object.
+func deriveHash_105(object []Name) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -13223,8 +12368,8 @@ func deriveHash_101(object []Name) uint64 {
 	return h
 }
 
-// deriveHash_102 returns the hash of the object.
-func deriveHash_102(object []*Name) uint64 {
+// deriveHash_106 returns the hash of the object.
+func deriveHash_106(object []*Name) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -13235,78 +12380,78 @@ func deriveHash_102(object []*Name) uint64 {
 	return h
 }
 
-// deriveHash_103 returns the hash of the object.
-func deriveHash_103(object map[Name]string) uint64 {
+// deriveHash_107 returns the hash of the object.
+func deriveHash_107(object map[Name]string) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSort_4(deriveKeys_7(object)) {
+	for _, k := range deriveSort_5(deriveKeys_6(object)) {
 		h = 31*h + deriveHash_N(k)
 		h = 31*h + deriveHash_(object[k])
 	}
 	return h
 }
 
-// deriveHash_104 returns the hash of the object.
-func deriveHash_104(object map[string]Name) uint64 {
+// deriveHash_108 returns the hash of the object.
+func deriveHash_108(object map[string]Name) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSortedStrings(deriveKeys_8(object)) {
+	for _, k := range deriveSort(deriveKeys_7(object)) {
 		h = 31*h + deriveHash_(k)
 		h = 31*h + deriveHash_N(object[k])
 	}
 	return h
 }
 
-// deriveHash_105 returns the hash of the object.
-func deriveHash_105(object map[string]*Name) uint64 {
+// deriveHash_109 returns the hash of the object.
+func deriveHash_109(object map[string]*Name) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSortedStrings(deriveKeys_9(object)) {
+	for _, k := range deriveSort(deriveKeys_8(object)) {
 		h = 31*h + deriveHash_(k)
 		h = 31*h + deriveHashName(object[k])
 	}
 	return h
 }
 
-// deriveHash_106 returns the hash of the object.
-func deriveHash_106(object map[string][]Name) uint64 {
+// deriveHash_110 returns the hash of the object.
+func deriveHash_110(object map[string][]Name) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSortedStrings(deriveKeys_10(object)) {
+	for _, k := range deriveSort(deriveKeys_9(object)) {
 		h = 31*h + deriveHash_(k)
-		h = 31*h + deriveHash_101(object[k])
+		h = 31*h + deriveHash_105(object[k])
 	}
 	return h
 }
 
-// deriveHash_107 returns the hash of the object.
-func deriveHash_107(object map[string][]*Name) uint64 {
+// deriveHash_111 returns the hash of the object.
+func deriveHash_111(object map[string][]*Name) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSortedStrings(deriveKeys_11(object)) {
+	for _, k := range deriveSort(deriveKeys_10(object)) {
 		h = 31*h + deriveHash_(k)
-		h = 31*h + deriveHash_102(object[k])
+		h = 31*h + deriveHash_106(object[k])
 	}
 	return h
 }
 
-// deriveHash_108 returns the hash of the object.
-func deriveHash_108(object map[int]RecursiveType) uint64 {
+// deriveHash_112 returns the hash of the object.
+func deriveHash_112(object map[int]RecursiveType) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSortedInts(deriveKeys_12(object)) {
+	for _, k := range deriveSort_6(deriveKeys_11(object)) {
 		h = 31*h + uint64(k)
 		h = 31*h + deriveHash_R(object[k])
 	}
@@ -13318,8 +12463,8 @@ func deriveHash_S(object Structs) uint64 {
 	return deriveHashStructs(&object)
 }
 
-// deriveHash_109 returns the hash of the object.
-func deriveHash_109(object *StructWithoutEqualMethod) uint64 {
+// deriveHash_113 returns the hash of the object.
+func deriveHash_113(object *StructWithoutEqualMethod) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -13330,11 +12475,11 @@ func deriveHash_109(object *StructWithoutEqualMethod) uint64 {
 
 // deriveHash_St returns the hash of the object.
 func deriveHash_St(object StructWithoutEqualMethod) uint64 {
-	return deriveHash_109(&object)
+	return deriveHash_113(&object)
 }
 
-// deriveHash_110 returns the hash of the object.
-func deriveHash_110(object *extra.StructWithoutEqualMethod) uint64 {
+// deriveHash_114 returns the hash of the object.
+func deriveHash_114(object *extra.StructWithoutEqualMethod) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -13345,19 +12490,19 @@ func deriveHash_110(object *extra.StructWithoutEqualMethod) uint64 {
 
 // deriveHash_Str returns the hash of the object.
 func deriveHash_Str(object extra.StructWithoutEqualMethod) uint64 {
-	return deriveHash_110(&object)
+	return deriveHash_114(&object)
 }
 
-// deriveHash_111 returns the hash of the object.
-func deriveHash_111(object *MyEnum) uint64 {
+// deriveHash_115 returns the hash of the object.
+func deriveHash_115(object *MyEnum) uint64 {
 	if object == nil {
 		return 0
 	}
 	return (31 * 17) + uint64(*object)
 }
 
-// deriveHash_112 returns the hash of the object.
-func deriveHash_112(object []MyEnum) uint64 {
+// deriveHash_116 returns the hash of the object.
+func deriveHash_116(object []MyEnum) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -13368,46 +12513,46 @@ func deriveHash_112(object []MyEnum) uint64 {
 	return h
 }
 
-// deriveHash_113 returns the hash of the object.
-func deriveHash_113(object []*MyEnum) uint64 {
+// deriveHash_117 returns the hash of the object.
+func deriveHash_117(object []*MyEnum) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_111(object[i])
+		h = 31*h + deriveHash_115(object[i])
 	}
 	return h
 }
 
-// deriveHash_114 returns the hash of the object.
-func deriveHash_114(object map[int32]MyEnum) uint64 {
+// deriveHash_118 returns the hash of the object.
+func deriveHash_118(object map[int32]MyEnum) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSort_5(deriveKeys_13(object)) {
+	for _, k := range deriveSort_7(deriveKeys_12(object)) {
 		h = 31*h + uint64(k)
 		h = 31*h + uint64(object[k])
 	}
 	return h
 }
 
-// deriveHash_115 returns the hash of the object.
-func deriveHash_115(object map[MyEnum]int32) uint64 {
+// deriveHash_119 returns the hash of the object.
+func deriveHash_119(object map[MyEnum]int32) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSort_6(deriveKeys_14(object)) {
+	for _, k := range deriveSort_8(deriveKeys_13(object)) {
 		h = 31*h + uint64(k)
 		h = 31*h + uint64(object[k])
 	}
 	return h
 }
 
-// deriveHash_116 returns the hash of the object.
-func deriveHash_116(object [2]MyEnum) uint64 {
+// deriveHash_120 returns the hash of the object.
+func deriveHash_120(object [2]MyEnum) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(object[i])
@@ -13415,36 +12560,36 @@ func deriveHash_116(object [2]MyEnum) uint64 {
 	return h
 }
 
-// deriveHash_117 returns the hash of the object.
-func deriveHash_117(object *MySlice) uint64 {
+// deriveHash_121 returns the hash of the object.
+func deriveHash_121(object *MySlice) uint64 {
 	if object == nil {
 		return 0
 	}
-	return (31 * 17) + deriveHash_25(*object)
+	return (31 * 17) + deriveHash_27(*object)
 }
 
-// deriveHash_118 returns the hash of the object.
-func deriveHash_118(object []MySlice) uint64 {
+// deriveHash_122 returns the hash of the object.
+func deriveHash_122(object []MySlice) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_25(object[i])
+		h = 31*h + deriveHash_27(object[i])
 	}
 	return h
 }
 
-// deriveHash_119 returns the hash of the object.
-func deriveHash_119(object *time.Duration) uint64 {
+// deriveHash_123 returns the hash of the object.
+func deriveHash_123(object *time.Duration) uint64 {
 	if object == nil {
 		return 0
 	}
 	return (31 * 17) + uint64(*object)
 }
 
-// deriveHash_120 returns the hash of the object.
-func deriveHash_120(object []time.Duration) uint64 {
+// deriveHash_124 returns the hash of the object.
+func deriveHash_124(object []time.Duration) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -13455,71 +12600,109 @@ func deriveHash_120(object []time.Duration) uint64 {
 	return h
 }
 
-// deriveHash_121 returns the hash of the object.
-func deriveHash_121(object []*time.Duration) uint64 {
+// deriveHash_125 returns the hash of the object.
+func deriveHash_125(object []*time.Duration) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_119(object[i])
+		h = 31*h + deriveHash_123(object[i])
 	}
 	return h
 }
 
-// deriveHash_122 returns the hash of the object.
-func deriveHash_122(object map[int]time.Duration) uint64 {
+// deriveHash_126 returns the hash of the object.
+func deriveHash_126(object map[int]time.Duration) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSortedInts(deriveKeys_15(object)) {
+	for _, k := range deriveSort_6(deriveKeys_14(object)) {
 		h = 31*h + uint64(k)
 		h = 31*h + uint64(object[k])
 	}
 	return h
 }
 
-// deriveHash_123 returns the hash of the object.
-func deriveHash_123(object map[string][]*pickle.Rick) uint64 {
+// deriveHash_127 returns the hash of the object.
+func deriveHash_127(object map[string][]*pickle.Rick) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSortedStrings(deriveKeys_16(object)) {
+	for _, k := range deriveSort(deriveKeys_15(object)) {
 		h = 31*h + deriveHash_(k)
-		h = 31*h + deriveHash_127(object[k])
+		h = 31*h + deriveHash_132(object[k])
 	}
 	return h
 }
 
 // deriveHash_p returns the hash of the object.
 func deriveHash_p(object privateStruct) uint64 {
-	return deriveHash_128(&object)
+	return deriveHash_133(&object)
 }
 
-// deriveHash_124 returns the hash of the object.
-func deriveHash_124(object [10]int) uint64 {
+// deriveHash_T returns the hash of the object.
+func deriveHash_T(object TaggedVersion) uint64 {
+	return deriveHash_134(&object)
+}
+
+// deriveHash_Sh returns the hash of the object.
+func deriveHash_Sh(object Shape) uint64 {
+	if object == nil {
+		return 0
+	}
+	switch object := object.(type) {
+	case *Circle:
+		return deriveHash_135(object)
+	case Square:
+		return deriveHash_Sq(object)
+	case *Square:
+		return deriveHash_136(object)
+	}
+	if h, ok := object.(interface{ Hash() uint64 }); ok {
+		return h.Hash()
+	}
+	return deriveHash_(reflect.TypeOf(object).String())
+}
+
+// deriveHash_128 returns the hash of the object.
+func deriveHash_128(object []Shape) uint64 {
+	if object == nil {
+		return 0
+	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + uint64(object[i])
+		h = 31*h + deriveHash_Sh(object[i])
+	}
+	return h
+}
+
+// deriveHash_e returns the hash of the object.
+func deriveHash_e(object error) uint64 {
+	if object == nil {
+		return 0
 	}
-	return h
+	if h, ok := object.(interface{ Hash() uint64 }); ok {
+		return h.Hash()
+	}
+	return deriveHash_(reflect.TypeOf(object).String())
 }
 
-// deriveHash_125 returns the hash of the object.
-func deriveHash_125(object struct {
-	Param0 *BuiltInTypes
-	Param1 int
-}) uint64 {
-	h := uint64(17)
-	h = 31*h + deriveHashBuiltInTypes(object.Param0)
-	h = 31*h + uint64(object.Param1)
-	return h
+// deriveHash_129 returns the hash of the object.
+func deriveHash_129(object interface{}) uint64 {
+	if object == nil {
+		return 0
+	}
+	if h, ok := object.(interface{ Hash() uint64 }); ok {
+		return h.Hash()
+	}
+	return deriveHash_(reflect.TypeOf(object).String())
 }
 
-// deriveGoString_63 returns a recursive representation of this as a valid go string.
-func deriveGoString_63(this *bool) string {
+// deriveGoString_65 returns a recursive representation of this as a valid go string.
+func deriveGoString_65(this *bool) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *bool {\n")
 	if this == nil {
@@ -13533,8 +12716,8 @@ func deriveGoString_63(this *bool) string {
 	return buf.String()
 }
 
-// deriveGoString_64 returns a recursive representation of this as a valid go string.
-func deriveGoString_64(this *byte) string {
+// deriveGoString_66 returns a recursive representation of this as a valid go string.
+func deriveGoString_66(this *byte) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *byte {\n")
 	if this == nil {
@@ -13548,8 +12731,8 @@ func deriveGoString_64(this *byte) string {
 	return buf.String()
 }
 
-// deriveGoString_65 returns a recursive representation of this as a valid go string.
-func deriveGoString_65(this *complex128) string {
+// deriveGoString_67 returns a recursive representation of this as a valid go string.
+func deriveGoString_67(this *complex128) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *complex128 {\n")
 	if this == nil {
@@ -13563,8 +12746,8 @@ func deriveGoString_65(this *complex128) string {
 	return buf.String()
 }
 
-// deriveGoString_66 returns a recursive representation of this as a valid go string.
-func deriveGoString_66(this *complex64) string {
+// deriveGoString_68 returns a recursive representation of this as a valid go string.
+func deriveGoString_68(this *complex64) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *complex64 {\n")
 	if this == nil {
@@ -13578,8 +12761,8 @@ func deriveGoString_66(this *complex64) string {
 	return buf.String()
 }
 
-// deriveGoString_67 returns a recursive representation of this as a valid go string.
-func deriveGoString_67(this *float64) string {
+// deriveGoString_69 returns a recursive representation of this as a valid go string.
+func deriveGoString_69(this *float64) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *float64 {\n")
 	if this == nil {
@@ -13593,8 +12776,8 @@ func deriveGoString_67(this *float64) string {
 	return buf.String()
 }
 
-// deriveGoString_68 returns a recursive representation of this as a valid go string.
-func deriveGoString_68(this *float32) string {
+// deriveGoString_70 returns a recursive representation of this as a valid go string.
+func deriveGoString_70(this *float32) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *float32 {\n")
 	if this == nil {
@@ -13608,8 +12791,23 @@ func deriveGoString_68(this *float32) string {
 	return buf.String()
 }
 
-// deriveGoString_69 returns a recursive representation of this as a valid go string.
-func deriveGoString_69(this *int16) string {
+// deriveGoString_71 returns a recursive representation of this as a valid go string.
+func deriveGoString_71(this *int) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() *int {\n")
+	if this == nil {
+		fmt.Fprintf(buf, "return nil\n")
+	} else {
+		fmt.Fprintf(buf, "this := new(int)\n")
+		fmt.Fprintf(buf, "*this = %#v\n", *this)
+		fmt.Fprintf(buf, "return this\n")
+	}
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
+// deriveGoString_72 returns a recursive representation of this as a valid go string.
+func deriveGoString_72(this *int16) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *int16 {\n")
 	if this == nil {
@@ -13623,8 +12821,8 @@ func deriveGoString_69(this *int16) string {
 	return buf.String()
 }
 
-// deriveGoString_70 returns a recursive representation of this as a valid go string.
-func deriveGoString_70(this *int32) string {
+// deriveGoString_73 returns a recursive representation of this as a valid go string.
+func deriveGoString_73(this *int32) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *int32 {\n")
 	if this == nil {
@@ -13638,8 +12836,8 @@ func deriveGoString_70(this *int32) string {
 	return buf.String()
 }
 
-// deriveGoString_71 returns a recursive representation of this as a valid go string.
-func deriveGoString_71(this *int64) string {
+// deriveGoString_74 returns a recursive representation of this as a valid go string.
+func deriveGoString_74(this *int64) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *int64 {\n")
 	if this == nil {
@@ -13653,8 +12851,8 @@ func deriveGoString_71(this *int64) string {
 	return buf.String()
 }
 
-// deriveGoString_72 returns a recursive representation of this as a valid go string.
-func deriveGoString_72(this *int8) string {
+// deriveGoString_75 returns a recursive representation of this as a valid go string.
+func deriveGoString_75(this *int8) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *int8 {\n")
 	if this == nil {
@@ -13668,8 +12866,8 @@ func deriveGoString_72(this *int8) string {
 	return buf.String()
 }
 
-// deriveGoString_73 returns a recursive representation of this as a valid go string.
-func deriveGoString_73(this *string) string {
+// deriveGoString_76 returns a recursive representation of this as a valid go string.
+func deriveGoString_76(this *string) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *string {\n")
 	if this == nil {
@@ -13683,8 +12881,8 @@ func deriveGoString_73(this *string) string {
 	return buf.String()
 }
 
-// deriveGoString_74 returns a recursive representation of this as a valid go string.
-func deriveGoString_74(this *uint) string {
+// deriveGoString_77 returns a recursive representation of this as a valid go string.
+func deriveGoString_77(this *uint) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *uint {\n")
 	if this == nil {
@@ -13698,8 +12896,8 @@ func deriveGoString_74(this *uint) string {
 	return buf.String()
 }
 
-// deriveGoString_75 returns a recursive representation of this as a valid go string.
-func deriveGoString_75(this *uint16) string {
+// deriveGoString_78 returns a recursive representation of this as a valid go string.
+func deriveGoString_78(this *uint16) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *uint16 {\n")
 	if this == nil {
@@ -13713,8 +12911,8 @@ func deriveGoString_75(this *uint16) string {
 	return buf.String()
 }
 
-// deriveGoString_76 returns a recursive representation of this as a valid go string.
-func deriveGoString_76(this *uint32) string {
+// deriveGoString_79 returns a recursive representation of this as a valid go string.
+func deriveGoString_79(this *uint32) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *uint32 {\n")
 	if this == nil {
@@ -13728,8 +12926,8 @@ func deriveGoString_76(this *uint32) string {
 	return buf.String()
 }
 
-// deriveGoString_77 returns a recursive representation of this as a valid go string.
-func deriveGoString_77(this *uint64) string {
+// deriveGoString_80 returns a recursive representation of this as a valid go string.
+func deriveGoString_80(this *uint64) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *uint64 {\n")
 	if this == nil {
@@ -13743,8 +12941,8 @@ func deriveGoString_77(this *uint64) string {
 	return buf.String()
 }
 
-// deriveGoString_78 returns a recursive representation of this as a valid go string.
-func deriveGoString_78(this *uintptr) string {
+// deriveGoString_81 returns a recursive representation of this as a valid go string.
+func deriveGoString_81(this *uintptr) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *uintptr {\n")
 	if this == nil {
@@ -13758,8 +12956,21 @@ func deriveGoString_78(this *uintptr) string {
 	return buf.String()
 }
 
-// deriveGoString_79 returns a recursive representation of this as a valid go string.
-func deriveGoString_79(this []string) string {
+// deriveGoString_82 returns a recursive representation of this as a valid go string.
+func deriveGoString_82(this []int) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() []int {\n")
+	if this == nil {
+		fmt.Fprintf(buf, "return nil\n")
+	} else {
+		fmt.Fprintf(buf, "return %#v\n", this)
+	}
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
+// deriveGoString_83 returns a recursive representation of this as a valid go string.
+func deriveGoString_83(this []string) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []string {\n")
 	if this == nil {
@@ -13789,7 +13000,7 @@ func deriveGoString_R(this RecursiveType) string {
 		fmt.Fprintf(buf, "this.Bytes = %#v\n", this.Bytes)
 	}
 	if this.N != nil {
-		fmt.Fprintf(buf, "this.N = %s\n", deriveGoString_47(this.N))
+		fmt.Fprintf(buf, "this.N = %s\n", deriveGoString_49(this.N))
 	}
 	fmt.Fprintf(buf, "return *this\n")
 	fmt.Fprintf(buf, "}()\n")
@@ -13836,8 +13047,8 @@ func deriveGoString_D(this time.Duration) string {
 	return buf.String()
 }
 
-// deriveGoString_80 returns a recursive representation of this as a valid go string.
-func deriveGoString_80(this []*pickle.Rick) string {
+// deriveGoString_84 returns a recursive representation of this as a valid go string.
+func deriveGoString_84(this []*pickle.Rick) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*pickle.Rick {\n")
 	if this == nil {
@@ -13845,7 +13056,7 @@ func deriveGoString_80(this []*pickle.Rick) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*pickle.Rick, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_81(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_85(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -13853,6 +13064,13 @@ func deriveGoString_80(this []*pickle.Rick) string {
 	return buf.String()
 }
 
+// deriveDeepCopy_48 recursively copies the contents of src into dst.
+func deriveDeepCopy_48(dst, src map[int]int) {
+	for src_key, src_value := range src {
+		dst[src_key] = src_value
+	}
+}
+
 // deriveDeepCopy_49 recursively copies the contents of src into dst.
 func deriveDeepCopy_49(dst, src []*pickle.Rick) {
 	for src_i, src_value := range src {
@@ -13865,6 +13083,29 @@ func deriveDeepCopy_49(dst, src []*pickle.Rick) {
 	}
 }
 
+// deriveDeepCopy_50 recursively copies the contents of src into dst.
+func deriveDeepCopy_50(dst, src *Square) {
+	dst.Side = src.Side
+	if src.Color == nil {
+		dst.Color = nil
+	} else {
+		if dst.Color != nil {
+			if len(src.Color) > len(dst.Color) {
+				if cap(dst.Color) >= len(src.Color) {
+					dst.Color = (dst.Color)[:len(src.Color)]
+				} else {
+					dst.Color = make([]string, len(src.Color))
+				}
+			} else if len(src.Color) < len(dst.Color) {
+				dst.Color = (dst.Color)[:len(src.Color)]
+			}
+		} else {
+			dst.Color = make([]string, len(src.Color))
+		}
+		copy(dst.Color, src.Color)
+	}
+}
+
 // deriveCompare_s returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
@@ -13873,11 +13114,11 @@ func deriveCompare_s(this, that string) int {
 	return strings.Compare(this, that)
 }
 
-// deriveCompare_130 returns:
+// deriveCompare_135 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_130(this, that [4]int) int {
+func deriveCompare_135(this, that [4]int) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -13892,11 +13133,11 @@ func deriveCompare_130(this, that [4]int) int {
 	return 0
 }
 
-// deriveCompare_131 returns:
+// deriveCompare_136 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_131(this, that map[int]int) int {
+func deriveCompare_136(this, that map[int]int) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -13912,8 +13153,8 @@ func deriveCompare_131(this, that map[int]int) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSortedInts(deriveKeys(this))
-	thatkeys := deriveSortedInts(deriveKeys(that))
+	thiskeys := deriveSort_6(deriveKeys_16(this))
+	thatkeys := deriveSort_6(deriveKeys_16(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
@@ -13931,11 +13172,11 @@ func deriveCompare_131(this, that map[int]int) int {
 	return 0
 }
 
-// deriveCompare_132 returns:
+// deriveCompare_137 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_132(this, that []*pickle.Rick) int {
+func deriveCompare_137(this, that []*pickle.Rick) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -13952,13 +13193,56 @@ func deriveCompare_132(this, that []*pickle.Rick) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_133(this[i], that[i]); c != 0 {
+		if c := deriveCompare_140(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
+// deriveCompare_138 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_138(this, that *Circle) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := deriveCompare_f(this.Radius, that.Radius); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// deriveCompare_139 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_139(this, that *Square) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := deriveCompare_f(this.Side, that.Side); c != 0 {
+		return c
+	}
+	if c := deriveCompare_31(this.Color, that.Color); c != 0 {
+		return c
+	}
+	return 0
+}
+
 // deriveCompare_N returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
@@ -13967,8 +13251,135 @@ func deriveCompare_N(this, that Name) int {
 	return (&this).Compare(&that)
 }
 
-// deriveEqual_88 returns whether this and that are equal.
-func deriveEqual_88(this, that []*pickle.Rick) bool {
+// deriveString_L appends a compact and human readable representation of this to buf.
+func deriveString_L(this Loggable, buf []byte) []byte {
+	buf = append(buf, "Loggable{"...)
+	n := len(buf)
+	if this.Name != "" {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Name: "...)
+		buf = strconv.AppendQuote(buf, this.Name)
+	}
+	if len(buf) > n {
+		buf = append(buf, ", "...)
+	}
+	buf = append(buf, "Password: "...)
+	buf = append(buf, "<redacted>"...)
+	if this.Count != 0 {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Count: "...)
+		buf = strconv.AppendInt(buf, int64(this.Count), 10)
+	}
+	if this.Ratio != 0 {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Ratio: "...)
+		buf = strconv.AppendFloat(buf, float64(this.Ratio), 'g', -1, 32)
+	}
+	if this.Enabled {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Enabled: "...)
+		buf = strconv.AppendBool(buf, this.Enabled)
+	}
+	if len(this.Tags) != 0 {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Tags: "...)
+		buf = deriveString_1(this.Tags, buf)
+	}
+	if len(this.Scores) != 0 {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Scores: "...)
+		buf = deriveString_2(this.Scores, buf)
+	}
+	if this.Parent != nil {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Parent: "...)
+		if this.Parent == nil {
+			buf = append(buf, "nil"...)
+		} else {
+			buf = append(buf, this.Parent.String()...)
+		}
+	}
+	if this.Shape != nil {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Shape: "...)
+		if this.Shape == nil {
+			buf = append(buf, "nil"...)
+		} else {
+			buf = fmt.Appendf(buf, "%v", this.Shape)
+		}
+	}
+	if this.Err != nil {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Err: "...)
+		if this.Err == nil {
+			buf = append(buf, "nil"...)
+		} else {
+			buf = fmt.Appendf(buf, "%v", this.Err)
+		}
+	}
+	if this.Timeout != 0 {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Timeout: "...)
+		buf = append(buf, this.Timeout.String()...)
+	}
+	if !deriveIsEmpty_(this.Created) {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Created: "...)
+		buf = append(buf, this.Created.String()...)
+	}
+	if !deriveIsEmpty_1(this.Pair) {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Pair: "...)
+		buf = deriveString_3(this.Pair, buf)
+	}
+	if this.Callback != nil {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Callback: "...)
+		if this.Callback == nil {
+			buf = append(buf, "nil"...)
+		} else {
+			buf = append(buf, "<func>"...)
+		}
+	}
+	if this.internal != 0 {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "internal: "...)
+		buf = strconv.AppendInt(buf, int64(this.internal), 10)
+	}
+	buf = append(buf, '}')
+	return buf
+}
+
+// deriveEqual_91 returns whether this and that are equal.
+func deriveEqual_91(this, that []*pickle.Rick) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -13976,22 +13387,39 @@ func deriveEqual_88(this, that []*pickle.Rick) bool {
 		return false
 	}
 	for i := 0; i < len(this); i++ {
-		if !(deriveEqual_90(this[i], that[i])) {
+		if !(deriveEqual_94(this[i], that[i])) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveEqual_89 returns whether this and that are equal.
-func deriveEqual_89(this, that *vendortest.AVendoredObject) bool {
+// deriveEqual_92 returns whether this and that are equal.
+func deriveEqual_92(this, that *Circle) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			this.Name == that.Name
+			this.Radius == that.Radius
 }
 
-// deriveHash_126 returns the hash of the object.
-func deriveHash_126(object [4]int) uint64 {
+// deriveEqual_93 returns whether this and that are equal.
+func deriveEqual_93(this, that *Square) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Side == that.Side &&
+			deriveEqual_10(this.Color, that.Color)
+}
+
+// deriveKeys_16 returns the keys of the input map as a slice.
+func deriveKeys_16(m map[int]int) []int {
+	keys := make([]int, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// deriveHash_130 returns the hash of the object.
+func deriveHash_130(object [4]int) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(object[i])
@@ -13999,35 +13427,84 @@ func deriveHash_126(object [4]int) uint64 {
 	return h
 }
 
+// deriveHash_131 returns the hash of the object.
+func deriveHash_131(object map[int]int) uint64 {
+	if object == nil {
+		return 0
+	}
+	h := uint64(17)
+	for _, k := range deriveSort_6(deriveKeys_16(object)) {
+		h = 31*h + uint64(k)
+		h = 31*h + uint64(object[k])
+	}
+	return h
+}
+
 // deriveHash_R returns the hash of the object.
 func deriveHash_R(object RecursiveType) uint64 {
 	return deriveHashRecursiveType(&object)
 }
 
-// deriveHash_127 returns the hash of the object.
-func deriveHash_127(object []*pickle.Rick) uint64 {
+// deriveHash_132 returns the hash of the object.
+func deriveHash_132(object []*pickle.Rick) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_129(object[i])
+		h = 31*h + deriveHash_137(object[i])
 	}
 	return h
 }
 
-// deriveHash_128 returns the hash of the object.
-func deriveHash_128(object *privateStruct) uint64 {
+// deriveHash_133 returns the hash of the object.
+func deriveHash_133(object *privateStruct) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHashPtrToint(object.ptrfield)
+	h = 31*h + deriveHash_7(object.ptrfield)
 	return h
 }
 
-// deriveGoString_81 returns a recursive representation of this as a valid go string.
-func deriveGoString_81(this *pickle.Rick) string {
+// deriveHash_134 returns the hash of the object.
+func deriveHash_134(object *TaggedVersion) uint64 {
+	if object == nil {
+		return 0
+	}
+	h := uint64(17)
+	h = 31*h + uint64(object.Major)
+	return h
+}
+
+// deriveHash_135 returns the hash of the object.
+func deriveHash_135(object *Circle) uint64 {
+	if object == nil {
+		return 0
+	}
+	h := uint64(17)
+	h = 31*h + math.Float64bits(object.Radius)
+	return h
+}
+
+// deriveHash_Sq returns the hash of the object.
+func deriveHash_Sq(object Square) uint64 {
+	return deriveHash_136(&object)
+}
+
+// deriveHash_136 returns the hash of the object.
+func deriveHash_136(object *Square) uint64 {
+	if object == nil {
+		return 0
+	}
+	h := uint64(17)
+	h = 31*h + math.Float64bits(object.Side)
+	h = 31*h + deriveHash_29(object.Color)
+	return h
+}
+
+// deriveGoString_85 returns a recursive representation of this as a valid go string.
+func deriveGoString_85(this *pickle.Rick) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *pickle.Rick {\n")
 	if this == nil {
@@ -14041,11 +13518,29 @@ func deriveGoString_81(this *pickle.Rick) string {
 	return buf.String()
 }
 
-// deriveCompare_133 returns:
+// deriveIsEmpty_ returns whether this is the zero value of its type, where empty slices and maps are also zero.
+func deriveIsEmpty_(this time.Time) bool {
+	thisv := reflect.Indirect(reflect.ValueOf(&this))
+	return *(*uint64)(unsafe.Pointer(thisv.FieldByName("wall").UnsafeAddr())) == 0 &&
+		*(*int64)(unsafe.Pointer(thisv.FieldByName("ext").UnsafeAddr())) == 0 &&
+		*(**time.Location)(unsafe.Pointer(thisv.FieldByName("loc").UnsafeAddr())) == nil
+}
+
+// deriveIsEmpty_1 returns whether this is the zero value of its type, where empty slices and maps are also zero.
+func deriveIsEmpty_1(this [2]int) bool {
+	for _, v := range this {
+		if !(v == 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveCompare_140 returns:
 //   * 0 if this and that are equal,
 //   * -1 is this is smaller and
 //   * +1 is this is bigger.
-func deriveCompare_133(this, that *pickle.Rick) int {
+func deriveCompare_140(this, that *pickle.Rick) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -14061,15 +13556,83 @@ func deriveCompare_133(this, that *pickle.Rick) int {
 	return 0
 }
 
-// deriveEqual_90 returns whether this and that are equal.
-func deriveEqual_90(this, that *pickle.Rick) bool {
+// deriveString_1 appends a compact and human readable representation of this to buf.
+func deriveString_1(this []string, buf []byte) []byte {
+	buf = append(buf, '[')
+	for i, v := range this {
+		if i == 10 {
+			buf = append(buf, ", ... "...)
+			buf = strconv.AppendInt(buf, int64(len(this)-i), 10)
+			buf = append(buf, " more"...)
+			break
+		}
+		if i > 0 {
+			buf = append(buf, ", "...)
+		}
+		buf = strconv.AppendQuote(buf, v)
+	}
+	buf = append(buf, ']')
+	return buf
+}
+
+// deriveString_2 appends a compact and human readable representation of this to buf.
+func deriveString_2(this map[string]uint8, buf []byte) []byte {
+	buf = append(buf, "map["...)
+	for i, k := range deriveSort(deriveKeys_17(this)) {
+		if i == 10 {
+			buf = append(buf, ", ... "...)
+			buf = strconv.AppendInt(buf, int64(len(this)-i), 10)
+			buf = append(buf, " more"...)
+			break
+		}
+		if i > 0 {
+			buf = append(buf, ", "...)
+		}
+		buf = strconv.AppendQuote(buf, k)
+		buf = append(buf, ": "...)
+		buf = strconv.AppendUint(buf, uint64(this[k]), 10)
+	}
+	buf = append(buf, ']')
+	return buf
+}
+
+// deriveString_3 appends a compact and human readable representation of this to buf.
+func deriveString_3(this [2]int, buf []byte) []byte {
+	buf = append(buf, '[')
+	for i, v := range this {
+		if i == 10 {
+			buf = append(buf, ", ... "...)
+			buf = strconv.AppendInt(buf, int64(len(this)-i), 10)
+			buf = append(buf, " more"...)
+			break
+		}
+		if i > 0 {
+			buf = append(buf, ", "...)
+		}
+		buf = strconv.AppendInt(buf, int64(v), 10)
+	}
+	buf = append(buf, ']')
+	return buf
+}
+
+// deriveEqual_94 returns whether this and that are equal.
+func deriveEqual_94(this, that *pickle.Rick) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
 			this.Portal == that.Portal
 }
 
-// deriveHash_129 returns the hash of the object.
-func deriveHash_129(object *pickle.Rick) uint64 {
+// deriveKeys_17 returns the keys of the input map as a slice.
+func deriveKeys_17(m map[string]uint8) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// deriveHash_137 returns the hash of the object.
+func deriveHash_137(object *pickle.Rick) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -0,0 +1,5613 @@
+// Code generated by goderive DO NOT EDIT.
+
+package test
+
+import (
+	"bytes"
+	list "container/list"
+	"context"
+	"fmt"
+	extra "github.com/awalterschulze/goderive/test/extra"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unsafe"
+	"vendortest"
+)
+
+// derivePipelineUnordered composes the stages into a concurrent pipeline, where each stage is run by n workers.
+// The results of each stage are sent in the order in which they are finished.
+// The returned function starts the pipeline and returns its output and a wait function.
+// The pipeline is cancelled on the first error, which is returned by wait,
+// after the output has been read until it is closed.
+func derivePipelineUnordered(n int, f0 func(ctx context.Context, line string) (int, error), f1 func(ctx context.Context, i int) (int, error)) func(context.Context, <-chan string) (<-chan int, func() error) {
+	if n < 1 {
+		n = 1
+	}
+	return func(parent context.Context, in <-chan string) (<-chan int, func() error) {
+		ctx, cancel := context.WithCancel(parent)
+		var once sync.Once
+		var err error
+		fail := func(e error) {
+			once.Do(func() {
+				err = e
+				cancel()
+			})
+		}
+		var wg sync.WaitGroup
+		out0 := make(chan int)
+		var wg0 sync.WaitGroup
+		wg0.Add(n)
+		for w := 0; w < n; w++ {
+			go func() {
+				defer wg0.Done()
+				for {
+					select {
+					case <-ctx.Done():
+						fail(ctx.Err())
+						return
+					case a, ok := <-in:
+						if !ok {
+							return
+						}
+						b, e := f0(ctx, a)
+						if e != nil {
+							fail(e)
+							return
+						}
+						select {
+						case out0 <- b:
+						case <-ctx.Done():
+							fail(ctx.Err())
+							return
+						}
+					}
+				}
+			}()
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wg0.Wait()
+			close(out0)
+		}()
+		out1 := make(chan int)
+		var wg1 sync.WaitGroup
+		wg1.Add(n)
+		for w := 0; w < n; w++ {
+			go func() {
+				defer wg1.Done()
+				for {
+					select {
+					case <-ctx.Done():
+						fail(ctx.Err())
+						return
+					case a, ok := <-out0:
+						if !ok {
+							return
+						}
+						b, e := f1(ctx, a)
+						if e != nil {
+							fail(e)
+							return
+						}
+						select {
+						case out1 <- b:
+						case <-ctx.Done():
+							fail(ctx.Err())
+							return
+						}
+					}
+				}
+			}()
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wg1.Wait()
+			close(out1)
+		}()
+		return out1, func() error {
+			wg.Wait()
+			cancel()
+			return err
+		}
+	}
+}
+
+// derivePipelineUnorderedPerStage composes the stages into a concurrent pipeline, where each stage is run by the number of workers before it.
+// The results of each stage are sent in the order in which they are finished.
+// The returned function starts the pipeline and returns its output and a wait function.
+// The pipeline is cancelled on the first error, which is returned by wait,
+// after the output has been read until it is closed.
+func derivePipelineUnorderedPerStage(n0 int, f0 func(context.Context, int) (int, error), n1 int, f1 func(context.Context, int) (int, error)) func(context.Context, <-chan int) (<-chan int, func() error) {
+	if n0 < 1 {
+		n0 = 1
+	}
+	if n1 < 1 {
+		n1 = 1
+	}
+	return func(parent context.Context, in <-chan int) (<-chan int, func() error) {
+		ctx, cancel := context.WithCancel(parent)
+		var once sync.Once
+		var err error
+		fail := func(e error) {
+			once.Do(func() {
+				err = e
+				cancel()
+			})
+		}
+		var wg sync.WaitGroup
+		out0 := make(chan int)
+		var wg0 sync.WaitGroup
+		wg0.Add(n0)
+		for w := 0; w < n0; w++ {
+			go func() {
+				defer wg0.Done()
+				for {
+					select {
+					case <-ctx.Done():
+						fail(ctx.Err())
+						return
+					case a, ok := <-in:
+						if !ok {
+							return
+						}
+						b, e := f0(ctx, a)
+						if e != nil {
+							fail(e)
+							return
+						}
+						select {
+						case out0 <- b:
+						case <-ctx.Done():
+							fail(ctx.Err())
+							return
+						}
+					}
+				}
+			}()
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wg0.Wait()
+			close(out0)
+		}()
+		out1 := make(chan int)
+		var wg1 sync.WaitGroup
+		wg1.Add(n1)
+		for w := 0; w < n1; w++ {
+			go func() {
+				defer wg1.Done()
+				for {
+					select {
+					case <-ctx.Done():
+						fail(ctx.Err())
+						return
+					case a, ok := <-out0:
+						if !ok {
+							return
+						}
+						b, e := f1(ctx, a)
+						if e != nil {
+							fail(e)
+							return
+						}
+						select {
+						case out1 <- b:
+						case <-ctx.Done():
+							fail(ctx.Err())
+							return
+						}
+					}
+				}
+			}()
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wg1.Wait()
+			close(out1)
+		}()
+		return out1, func() error {
+			wg.Wait()
+			cancel()
+			return err
+		}
+	}
+}
+
+// derivePipelineUnorderedAllStages composes the stages into a concurrent pipeline, where each stage is run by n workers.
+// The results of each stage are sent in the order in which they are finished.
+// The returned function starts the pipeline and returns its output and a wait function.
+// The pipeline is cancelled on the first error, which is returned by wait,
+// after the output has been read until it is closed.
+func derivePipelineUnorderedAllStages(n int, f0 func(context.Context, int) (int, error), f1 func(context.Context, int) (int, error)) func(context.Context, <-chan int) (<-chan int, func() error) {
+	if n < 1 {
+		n = 1
+	}
+	return func(parent context.Context, in <-chan int) (<-chan int, func() error) {
+		ctx, cancel := context.WithCancel(parent)
+		var once sync.Once
+		var err error
+		fail := func(e error) {
+			once.Do(func() {
+				err = e
+				cancel()
+			})
+		}
+		var wg sync.WaitGroup
+		out0 := make(chan int)
+		var wg0 sync.WaitGroup
+		wg0.Add(n)
+		for w := 0; w < n; w++ {
+			go func() {
+				defer wg0.Done()
+				for {
+					select {
+					case <-ctx.Done():
+						fail(ctx.Err())
+						return
+					case a, ok := <-in:
+						if !ok {
+							return
+						}
+						b, e := f0(ctx, a)
+						if e != nil {
+							fail(e)
+							return
+						}
+						select {
+						case out0 <- b:
+						case <-ctx.Done():
+							fail(ctx.Err())
+							return
+						}
+					}
+				}
+			}()
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wg0.Wait()
+			close(out0)
+		}()
+		out1 := make(chan int)
+		var wg1 sync.WaitGroup
+		wg1.Add(n)
+		for w := 0; w < n; w++ {
+			go func() {
+				defer wg1.Done()
+				for {
+					select {
+					case <-ctx.Done():
+						fail(ctx.Err())
+						return
+					case a, ok := <-out0:
+						if !ok {
+							return
+						}
+						b, e := f1(ctx, a)
+						if e != nil {
+							fail(e)
+							return
+						}
+						select {
+						case out1 <- b:
+						case <-ctx.Done():
+							fail(ctx.Err())
+							return
+						}
+					}
+				}
+			}()
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wg1.Wait()
+			close(out1)
+		}()
+		return out1, func() error {
+			wg.Wait()
+			cancel()
+			return err
+		}
+	}
+}
+
+// deriveTraverseParallel returns a list where each element of the input list has been morphed by the input function or an error,
+// where at most n elements are morphed concurrently.
+func deriveTraverseParallel(n int, f func(string) (int, error), list []string) ([]int, error) {
+	workers := n
+	if workers < 1 || workers > len(list) {
+		workers = len(list)
+	}
+	out := make([]int, len(list))
+	var mu sync.Mutex
+	var err error
+	next := 0
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				mu.Lock()
+				if err != nil || next == len(list) {
+					mu.Unlock()
+					return
+				}
+				i := next
+				next++
+				mu.Unlock()
+				v, ferr := f(list[i])
+				if ferr != nil {
+					mu.Lock()
+					if err == nil {
+						err = ferr
+					}
+					mu.Unlock()
+					return
+				}
+				out[i] = v
+			}
+		}()
+	}
+	wg.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// deriveTraverseParallelContext returns a list where each element of the input list has been morphed by the input function or an error,
+// where at most n elements are morphed concurrently, with a context that is canceled as soon as an error is returned.
+func deriveTraverseParallelContext(ctx context.Context, n int, f func(context.Context, int) (int, error), list []int) ([]int, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	workers := n
+	if workers < 1 || workers > len(list) {
+		workers = len(list)
+	}
+	out := make([]int, len(list))
+	var mu sync.Mutex
+	var err error
+	next := 0
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				mu.Lock()
+				if err == nil && next < len(list) && ctx.Err() != nil {
+					err = ctx.Err()
+				}
+				if err != nil || next == len(list) {
+					mu.Unlock()
+					return
+				}
+				i := next
+				next++
+				mu.Unlock()
+				v, ferr := f(ctx, list[i])
+				if ferr != nil {
+					mu.Lock()
+					if err == nil {
+						err = ferr
+					}
+					mu.Unlock()
+					cancel()
+					return
+				}
+				out[i] = v
+			}
+		}()
+	}
+	wg.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// deriveTraverseParallelInt8 returns a list where each element of the input list has been morphed by the input function or an error,
+// where at most n elements are morphed concurrently.
+func deriveTraverseParallelInt8(n int8, f func(int) (int, error), list []int) ([]int, error) {
+	workers := int(n)
+	if workers < 1 || workers > len(list) {
+		workers = len(list)
+	}
+	out := make([]int, len(list))
+	var mu sync.Mutex
+	var err error
+	next := 0
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				mu.Lock()
+				if err != nil || next == len(list) {
+					mu.Unlock()
+					return
+				}
+				i := next
+				next++
+				mu.Unlock()
+				v, ferr := f(list[i])
+				if ferr != nil {
+					mu.Lock()
+					if err == nil {
+						err = ferr
+					}
+					mu.Unlock()
+					return
+				}
+				out[i] = v
+			}
+		}()
+	}
+	wg.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// derivePipelineOrdered composes the stages into a concurrent pipeline, where each stage is run by n workers.
+// The results of each stage are sent in the order of their inputs.
+// The returned function starts the pipeline and returns its output and a wait function.
+// The pipeline is cancelled on the first error, which is returned by wait,
+// after the output has been read until it is closed.
+func derivePipelineOrdered(n int, f0 func(ctx context.Context, i int) (int, error), f1 func(ctx context.Context, i int) (int, error)) func(context.Context, <-chan int) (<-chan int, func() error) {
+	if n < 1 {
+		n = 1
+	}
+	return func(parent context.Context, in <-chan int) (<-chan int, func() error) {
+		ctx, cancel := context.WithCancel(parent)
+		var once sync.Once
+		var err error
+		fail := func(e error) {
+			once.Do(func() {
+				err = e
+				cancel()
+			})
+		}
+		var wg sync.WaitGroup
+		out0 := make(chan int)
+		slots0 := make(chan chan int, n-1)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			defer close(slots0)
+			for {
+				select {
+				case <-ctx.Done():
+					fail(ctx.Err())
+					return
+				case a, ok := <-in:
+					if !ok {
+						return
+					}
+					slot := make(chan int, 1)
+					select {
+					case slots0 <- slot:
+					case <-ctx.Done():
+						fail(ctx.Err())
+						return
+					}
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						b, e := f0(ctx, a)
+						if e != nil {
+							fail(e)
+							return
+						}
+						slot <- b
+					}()
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			defer close(out0)
+			for slot := range slots0 {
+				select {
+				case b := <-slot:
+					select {
+					case out0 <- b:
+					case <-ctx.Done():
+						fail(ctx.Err())
+						return
+					}
+				case <-ctx.Done():
+					fail(ctx.Err())
+					return
+				}
+			}
+		}()
+		out1 := make(chan int)
+		slots1 := make(chan chan int, n-1)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			defer close(slots1)
+			for {
+				select {
+				case <-ctx.Done():
+					fail(ctx.Err())
+					return
+				case a, ok := <-out0:
+					if !ok {
+						return
+					}
+					slot := make(chan int, 1)
+					select {
+					case slots1 <- slot:
+					case <-ctx.Done():
+						fail(ctx.Err())
+						return
+					}
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						b, e := f1(ctx, a)
+						if e != nil {
+							fail(e)
+							return
+						}
+						slot <- b
+					}()
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			defer close(out1)
+			for slot := range slots1 {
+				select {
+				case b := <-slot:
+					select {
+					case out1 <- b:
+					case <-ctx.Done():
+						fail(ctx.Err())
+						return
+					}
+				case <-ctx.Done():
+					fail(ctx.Err())
+					return
+				}
+			}
+		}()
+		return out1, func() error {
+			wg.Wait()
+			cancel()
+			return err
+		}
+	}
+}
+
+// derivePipelineOrderedCheck composes the stages into a concurrent pipeline, where each stage is run by n workers.
+// The results of each stage are sent in the order of their inputs.
+// The returned function starts the pipeline and returns its output and a wait function.
+// The pipeline is cancelled on the first error, which is returned by wait,
+// after the output has been read until it is closed.
+func derivePipelineOrderedCheck(n int, f0 func(ctx context.Context, i int) (int, error), f1 func(ctx context.Context, i int) (string, error)) func(context.Context, <-chan int) (<-chan string, func() error) {
+	if n < 1 {
+		n = 1
+	}
+	return func(parent context.Context, in <-chan int) (<-chan string, func() error) {
+		ctx, cancel := context.WithCancel(parent)
+		var once sync.Once
+		var err error
+		fail := func(e error) {
+			once.Do(func() {
+				err = e
+				cancel()
+			})
+		}
+		var wg sync.WaitGroup
+		out0 := make(chan int)
+		slots0 := make(chan chan int, n-1)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			defer close(slots0)
+			for {
+				select {
+				case <-ctx.Done():
+					fail(ctx.Err())
+					return
+				case a, ok := <-in:
+					if !ok {
+						return
+					}
+					slot := make(chan int, 1)
+					select {
+					case slots0 <- slot:
+					case <-ctx.Done():
+						fail(ctx.Err())
+						return
+					}
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						b, e := f0(ctx, a)
+						if e != nil {
+							fail(e)
+							return
+						}
+						slot <- b
+					}()
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			defer close(out0)
+			for slot := range slots0 {
+				select {
+				case b := <-slot:
+					select {
+					case out0 <- b:
+					case <-ctx.Done():
+						fail(ctx.Err())
+						return
+					}
+				case <-ctx.Done():
+					fail(ctx.Err())
+					return
+				}
+			}
+		}()
+		out1 := make(chan string)
+		slots1 := make(chan chan string, n-1)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			defer close(slots1)
+			for {
+				select {
+				case <-ctx.Done():
+					fail(ctx.Err())
+					return
+				case a, ok := <-out0:
+					if !ok {
+						return
+					}
+					slot := make(chan string, 1)
+					select {
+					case slots1 <- slot:
+					case <-ctx.Done():
+						fail(ctx.Err())
+						return
+					}
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						b, e := f1(ctx, a)
+						if e != nil {
+							fail(e)
+							return
+						}
+						slot <- b
+					}()
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			defer close(out1)
+			for slot := range slots1 {
+				select {
+				case b := <-slot:
+					select {
+					case out1 <- b:
+					case <-ctx.Done():
+						fail(ctx.Err())
+						return
+					}
+				case <-ctx.Done():
+					fail(ctx.Err())
+					return
+				}
+			}
+		}()
+		return out1, func() error {
+			wg.Wait()
+			cancel()
+			return err
+		}
+	}
+}
+
+// derivePipelineOrderedPerStage composes the stages into a concurrent pipeline, where each stage is run by the number of workers before it.
+// The results of each stage are sent in the order of their inputs.
+// The returned function starts the pipeline and returns its output and a wait function.
+// The pipeline is cancelled on the first error, which is returned by wait,
+// after the output has been read until it is closed.
+func derivePipelineOrderedPerStage(n0 int, f0 func(context.Context, int) (int, error), n1 int, f1 func(context.Context, int) (int, error)) func(context.Context, <-chan int) (<-chan int, func() error) {
+	if n0 < 1 {
+		n0 = 1
+	}
+	if n1 < 1 {
+		n1 = 1
+	}
+	return func(parent context.Context, in <-chan int) (<-chan int, func() error) {
+		ctx, cancel := context.WithCancel(parent)
+		var once sync.Once
+		var err error
+		fail := func(e error) {
+			once.Do(func() {
+				err = e
+				cancel()
+			})
+		}
+		var wg sync.WaitGroup
+		out0 := make(chan int)
+		slots0 := make(chan chan int, n0-1)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			defer close(slots0)
+			for {
+				select {
+				case <-ctx.Done():
+					fail(ctx.Err())
+					return
+				case a, ok := <-in:
+					if !ok {
+						return
+					}
+					slot := make(chan int, 1)
+					select {
+					case slots0 <- slot:
+					case <-ctx.Done():
+						fail(ctx.Err())
+						return
+					}
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						b, e := f0(ctx, a)
+						if e != nil {
+							fail(e)
+							return
+						}
+						slot <- b
+					}()
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			defer close(out0)
+			for slot := range slots0 {
+				select {
+				case b := <-slot:
+					select {
+					case out0 <- b:
+					case <-ctx.Done():
+						fail(ctx.Err())
+						return
+					}
+				case <-ctx.Done():
+					fail(ctx.Err())
+					return
+				}
+			}
+		}()
+		out1 := make(chan int)
+		slots1 := make(chan chan int, n1-1)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			defer close(slots1)
+			for {
+				select {
+				case <-ctx.Done():
+					fail(ctx.Err())
+					return
+				case a, ok := <-out0:
+					if !ok {
+						return
+					}
+					slot := make(chan int, 1)
+					select {
+					case slots1 <- slot:
+					case <-ctx.Done():
+						fail(ctx.Err())
+						return
+					}
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						b, e := f1(ctx, a)
+						if e != nil {
+							fail(e)
+							return
+						}
+						slot <- b
+					}()
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			defer close(out1)
+			for slot := range slots1 {
+				select {
+				case b := <-slot:
+					select {
+					case out1 <- b:
+					case <-ctx.Done():
+						fail(ctx.Err())
+						return
+					}
+				case <-ctx.Done():
+					fail(ctx.Err())
+					return
+				}
+			}
+		}()
+		return out1, func() error {
+			wg.Wait()
+			cancel()
+			return err
+		}
+	}
+}
+
+// deriveDeepCopyGraph recursively copies the contents of src into dst,
+// where pointers, which are shared or cyclic in src, are also shared or cyclic in dst.
+func deriveDeepCopyGraph(dst, src *GraphNode) {
+	deriveDeepCopyGraph_1(dst, src, map[interface{}]interface{}{src: dst})
+}
+
+// deriveSingleflightGet returns a version of the input function, which is safe for concurrent use,
+// where concurrent calls with the same parameters wait for the first call and share its result,
+// instead of calling the input function again.
+func deriveSingleflightGet(f func(key string) (string, error)) func(key string) (string, error) {
+	type input struct {
+		Param0 string
+	}
+	type output struct {
+		Res0 string
+		Res1 error
+	}
+	type entry struct {
+		in input
+		out output
+		done chan struct{}
+		panicked bool
+	}
+	var mu sync.Mutex
+	m := make(map[input]*entry)
+	remove := func(e *entry) {
+		if m[e.in] == e {
+			delete(m, e.in)
+		}
+	}
+	return func(param0 string) (string, error) {
+		in := input{param0}
+		for {
+			mu.Lock()
+			e := m[in]
+			if e != nil {
+				mu.Unlock()
+				<-e.done
+				if e.panicked {
+					continue
+				}
+				return e.out.Res0, e.out.Res1
+			}
+			e = &entry{in: in, done: make(chan struct{})}
+			m[in] = e
+			mu.Unlock()
+			returned := false
+			defer func() {
+				if !returned {
+					mu.Lock()
+					remove(e)
+					mu.Unlock()
+					e.panicked = true
+					close(e.done)
+				}
+			}()
+			res0, res1 := f(param0)
+			e.out = output{res0, res1}
+			returned = true
+			mu.Lock()
+			remove(e)
+			mu.Unlock()
+			close(e.done)
+			return e.out.Res0, e.out.Res1
+		}
+	}
+}
+
+// deriveSingleflightPanic returns a version of the input function, which is safe for concurrent use,
+// where concurrent calls with the same parameters wait for the first call and share its result,
+// instead of calling the input function again.
+func deriveSingleflightPanic(f func(uint) uint) func(uint) uint {
+	type input struct {
+		Param0 uint
+	}
+	type output struct {
+		Res0 uint
+	}
+	type entry struct {
+		in input
+		out output
+		done chan struct{}
+		panicked bool
+	}
+	var mu sync.Mutex
+	m := make(map[input]*entry)
+	remove := func(e *entry) {
+		if m[e.in] == e {
+			delete(m, e.in)
+		}
+	}
+	return func(param0 uint) uint {
+		in := input{param0}
+		for {
+			mu.Lock()
+			e := m[in]
+			if e != nil {
+				mu.Unlock()
+				<-e.done
+				if e.panicked {
+					continue
+				}
+				return e.out.Res0
+			}
+			e = &entry{in: in, done: make(chan struct{})}
+			m[in] = e
+			mu.Unlock()
+			returned := false
+			defer func() {
+				if !returned {
+					mu.Lock()
+					remove(e)
+					mu.Unlock()
+					e.panicked = true
+					close(e.done)
+				}
+			}()
+			res0 := f(param0)
+			e.out = output{res0}
+			returned = true
+			mu.Lock()
+			remove(e)
+			mu.Unlock()
+			close(e.done)
+			return e.out.Res0
+		}
+	}
+}
+
+// deriveEqualGraphCurried returns an equal closure, with the first parameter already filled in.
+func deriveEqualGraphCurried(this *DoublyLinked) func(*DoublyLinked) bool {
+	return func(that *DoublyLinked) bool {
+		return deriveEqualGraph_1(this, that, make(map[[2]interface{}]bool))
+	}
+}
+
+// deriveEqualGraph returns whether this and that are equal,
+// where pointers, which are compared again on a cycle, are assumed to be equal.
+func deriveEqualGraph(this, that *GraphNode) bool {
+	return deriveEqualGraph_2(this, that, make(map[[2]interface{}]bool))
+}
+
+// deriveTakeWhile returns the prefix of the list, where each item matches the predicate.
+func deriveTakeWhile(predicate func(int) bool, list []int) []int {
+	out := make([]int, 0, len(list))
+	for i, elem := range list {
+		if !predicate(elem) {
+			break
+		}
+		out = append(out, list[i])
+	}
+	return out
+}
+
+// deriveIntersectSetOfInt64s returns the intersection of the two maps' keys.
+func deriveIntersectSetOfInt64s(this, that map[int64]struct{}) map[int64]struct{} {
+	intersect := make(map[int64]struct{}, deriveMinInt(len(this), len(that)))
+	for k := range this {
+		if _, ok := that[k]; ok {
+			intersect[k] = struct{}{}
+		}
+	}
+	return intersect
+}
+
+// deriveIntersectOfInt64s returns the intersection of the two lists' values
+// It assumes that the first list only contains unique items.
+func deriveIntersectOfInt64s(this, that []int64) []int64 {
+	intersect := make([]int64, 0, deriveMinInt(len(this), len(that)))
+	for i, v := range this {
+		if deriveContainsInt64s(that, v) {
+			intersect = append(intersect, this[i])
+		}
+	}
+	return intersect
+}
+
+// deriveTraverse returns a list where each element of the input list has been morphed by the input function or an error.
+func deriveTraverse(f func(string) (int, error), list []string) ([]int, error) {
+	out := make([]int, len(list))
+	var err error
+	for i, elem := range list {
+		out[i], err = f(elem)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// deriveTraverseMap returns a map where each value of the input map has been morphed by the input function or an error.
+func deriveTraverseMap(f func(string) (int, error), m map[string]string) (map[string]int, error) {
+	out := make(map[string]int, len(m))
+	for key, elem := range m {
+		v, err := f(elem)
+		if err != nil {
+			return nil, err
+		}
+		out[key] = v
+	}
+	return out, nil
+}
+
+// deriveTraverseError returns an error if g returns one, otherwise it returns the result of applying f to g's result.
+func deriveTraverseError(f func(string) (int, error), g func() (string, error)) (int, error) {
+	v, err := g()
+	if err != nil {
+		var zero int
+		return zero, err
+	}
+	return f(v)
+}
+
+// derivePipeline composes f and g into a concurrent pipeline.
+func derivePipeline(f func(lines []string) <-chan string, g func(line string) <-chan int) func([]string) <-chan int {
+	return func(a []string) <-chan int {
+		b := f(a)
+		return deriveJoinChannels(deriveFmapChanChan(g, b))
+	}
+}
+
+// deriveHashSeed returns the FNV-1a 64 hash of the object, which starts from the offset basis xor the seed.
+// The hash is the same across processes, architectures and versions of goderive.
+func deriveHashSeed(seed uint64, object string) uint64 {
+	return deriveHashSeed_(object, seed^14695981039346656037)
+}
+
+// deriveHashSeedStableKey returns the FNV-1a 64 hash of the object, which starts from the offset basis xor the seed.
+// The hash is the same across processes, architectures and versions of goderive.
+func deriveHashSeedStableKey(seed uint64, object *StableKey) uint64 {
+	return deriveHashSeed_1(object, seed^14695981039346656037)
+}
+
+// deriveHashSeedSkippedKey returns the FNV-1a 64 hash of the object, which starts from the offset basis xor the seed.
+// The hash is the same across processes, architectures and versions of goderive.
+func deriveHashSeedSkippedKey(seed uint64, object *SkippedKey) uint64 {
+	return deriveHashSeed_2(object, seed^14695981039346656037)
+}
+
+// deriveHashSeedTime returns the FNV-1a 64 hash of the object, which starts from the offset basis xor the seed.
+// The hash is the same across processes, architectures and versions of goderive.
+func deriveHashSeedTime(seed uint64, object time.Time) uint64 {
+	return deriveHashSeed_T(object, seed^14695981039346656037)
+}
+
+// deriveGoStringGenericPair returns a recursive representation of this as a valid go string.
+func deriveGoStringGenericPair(this *GenericPair[string, int]) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() *test.GenericPair[string, int] {\n")
+	if this == nil {
+		fmt.Fprintf(buf, "return nil\n")
+	} else {
+		fmt.Fprintf(buf, "this := &test.GenericPair[string, int]{}\n")
+		fmt.Fprintf(buf, "this.Key = %#v\n", this.Key)
+		fmt.Fprintf(buf, "this.Value = %#v\n", this.Value)
+		fmt.Fprintf(buf, "return this\n")
+	}
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
+// deriveGoStringIntSlices returns a recursive representation of this as a valid go string.
+func deriveGoStringIntSlices(this []int) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() []int {\n")
+	if this == nil {
+		fmt.Fprintf(buf, "return nil\n")
+	} else {
+		fmt.Fprintf(buf, "return %#v\n", this)
+	}
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
+// deriveGoStringIntArray returns a recursive representation of this as a valid go string.
+func deriveGoStringIntArray(this [10]int) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() [10]int {\n")
+	fmt.Fprintf(buf, "return %#v\n", this)
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
+// deriveGoStringMapOfIntToInt returns a recursive representation of this as a valid go string.
+func deriveGoStringMapOfIntToInt(this map[int]int) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() map[int]int {\n")
+	if this == nil {
+		fmt.Fprintf(buf, "return nil\n")
+	} else {
+		fmt.Fprintf(buf, "return %#v\n", this)
+	}
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
+// deriveGoStringIntPtr returns a recursive representation of this as a valid go string.
+func deriveGoStringIntPtr(this *int) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() *int {\n")
+	if this == nil {
+		fmt.Fprintf(buf, "return nil\n")
+	} else {
+		fmt.Fprintf(buf, "this := new(int)\n")
+		fmt.Fprintf(buf, "*this = %#v\n", *this)
+		fmt.Fprintf(buf, "return this\n")
+	}
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
+// deriveGoStringIntPtrSlice returns a recursive representation of this as a valid go string.
+func deriveGoStringIntPtrSlice(this *[]int) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() *[]int {\n")
+	if this == nil {
+		fmt.Fprintf(buf, "return nil\n")
+	} else {
+		fmt.Fprintf(buf, "this := new([]int)\n")
+		if *this != nil {
+			fmt.Fprintf(buf, "*this = %#v\n", *this)
+		}
+		fmt.Fprintf(buf, "return this\n")
+	}
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
+// deriveGoStringIntPtrArray returns a recursive representation of this as a valid go string.
+func deriveGoStringIntPtrArray(this *[10]int) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() *[10]int {\n")
+	if this == nil {
+		fmt.Fprintf(buf, "return nil\n")
+	} else {
+		fmt.Fprintf(buf, "this := new([10]int)\n")
+		fmt.Fprintf(buf, "*this = %#v\n", *this)
+		fmt.Fprintf(buf, "return this\n")
+	}
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
+// deriveGoStringIntPtrMap returns a recursive representation of this as a valid go string.
+func deriveGoStringIntPtrMap(this *map[int]int) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() *map[int]int {\n")
+	if this == nil {
+		fmt.Fprintf(buf, "return nil\n")
+	} else {
+		fmt.Fprintf(buf, "this := new(map[int]int)\n")
+		if *this != nil {
+			fmt.Fprintf(buf, "*this = %#v\n", *this)
+		}
+		fmt.Fprintf(buf, "return this\n")
+	}
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
+// deriveGoStringNoPointerStruct returns a recursive representation of this as a valid go string.
+func deriveGoStringNoPointerStruct(this BuiltInTypes) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() test.BuiltInTypes {\n")
+	fmt.Fprintf(buf, "this := &test.BuiltInTypes{}\n")
+	fmt.Fprintf(buf, "this.Bool = %#v\n", this.Bool)
+	fmt.Fprintf(buf, "this.Byte = %#v\n", this.Byte)
+	fmt.Fprintf(buf, "this.Complex128 = %#v\n", this.Complex128)
+	fmt.Fprintf(buf, "this.Complex64 = %#v\n", this.Complex64)
+	fmt.Fprintf(buf, "this.Float64 = %#v\n", this.Float64)
+	fmt.Fprintf(buf, "this.Float32 = %#v\n", this.Float32)
+	fmt.Fprintf(buf, "this.Int = %#v\n", this.Int)
+	fmt.Fprintf(buf, "this.Int16 = %#v\n", this.Int16)
+	fmt.Fprintf(buf, "this.Int32 = %#v\n", this.Int32)
+	fmt.Fprintf(buf, "this.Int64 = %#v\n", this.Int64)
+	fmt.Fprintf(buf, "this.Int8 = %#v\n", this.Int8)
+	fmt.Fprintf(buf, "this.Rune = %#v\n", this.Rune)
+	fmt.Fprintf(buf, "this.String = %#v\n", this.String)
+	fmt.Fprintf(buf, "this.Uint = %#v\n", this.Uint)
+	fmt.Fprintf(buf, "this.Uint16 = %#v\n", this.Uint16)
+	fmt.Fprintf(buf, "this.Uint32 = %#v\n", this.Uint32)
+	fmt.Fprintf(buf, "this.Uint64 = %#v\n", this.Uint64)
+	fmt.Fprintf(buf, "this.Uint8 = %#v\n", this.Uint8)
+	fmt.Fprintf(buf, "this.UintPtr = %#v\n", this.UintPtr)
+	fmt.Fprintf(buf, "return *this\n")
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
+// deriveDeepCopyPtrToGenericList recursively copies the contents of src into dst.
+func deriveDeepCopyPtrToGenericList(dst, src *GenericList[string]) {
+	dst.Value = src.Value
+	if src.Next == nil {
+		dst.Next = nil
+	} else {
+		dst.Next = new(GenericList[string])
+		deriveDeepCopyPtrToGenericList(dst.Next, src.Next)
+	}
+}
+
+// deriveDeepCopyMergeable recursively copies the contents of src into dst.
+func deriveDeepCopyMergeable(dst, src *Mergeable) {
+	dst.Name = src.Name
+	dst.Count = src.Count
+	dst.Enabled = src.Enabled
+	if src.Ratio == nil {
+		dst.Ratio = nil
+	} else {
+		dst.Ratio = new(float64)
+		*dst.Ratio = *src.Ratio
+	}
+	if src.Tags == nil {
+		dst.Tags = nil
+	} else {
+		if dst.Tags != nil {
+			if len(src.Tags) > len(dst.Tags) {
+				if cap(dst.Tags) >= len(src.Tags) {
+					dst.Tags = (dst.Tags)[:len(src.Tags)]
+				} else {
+					dst.Tags = make([]string, len(src.Tags))
+				}
+			} else if len(src.Tags) < len(dst.Tags) {
+				dst.Tags = (dst.Tags)[:len(src.Tags)]
+			}
+		} else {
+			dst.Tags = make([]string, len(src.Tags))
+		}
+		copy(dst.Tags, src.Tags)
+	}
+	if src.History == nil {
+		dst.History = nil
+	} else {
+		if dst.History != nil {
+			if len(src.History) > len(dst.History) {
+				if cap(dst.History) >= len(src.History) {
+					dst.History = (dst.History)[:len(src.History)]
+				} else {
+					dst.History = make([]string, len(src.History))
+				}
+			} else if len(src.History) < len(dst.History) {
+				dst.History = (dst.History)[:len(src.History)]
+			}
+		} else {
+			dst.History = make([]string, len(src.History))
+		}
+		copy(dst.History, src.History)
+	}
+	if src.Labels != nil {
+		dst.Labels = make(map[string]string, len(src.Labels))
+		deriveDeepCopy_51(dst.Labels, src.Labels)
+	} else {
+		dst.Labels = nil
+	}
+	dst.Address = src.Address
+	if src.Billing == nil {
+		dst.Billing = nil
+	} else {
+		dst.Billing = new(Address)
+		*dst.Billing = *src.Billing
+	}
+	dst.Shape = deriveDeepCopy_Sh(src.Shape)
+	dst.Owner = src.Owner
+	dst.Scores = src.Scores
+	dst.Embedded = src.Embedded
+}
+
+// deriveContainsInt64s returns whether the item is contained in the list.
+func deriveContainsInt64s(list []int64, item int64) bool {
+	for _, v := range list {
+		if v == item {
+			return true
+		}
+	}
+	return false
+}
+
+// deriveContainsStruct returns whether the item is contained in the list.
+func deriveContainsStruct(list []*BuiltInTypes, item *BuiltInTypes) bool {
+	for _, v := range list {
+		if deriveEqual_95(v, item) {
+			return true
+		}
+	}
+	return false
+}
+
+// deriveUncurryMarshal combines a function that returns a function, into one function.
+func deriveUncurryMarshal(f func(data []byte) func(v any) error) func(data []byte, v any) error {
+	return func(data []byte, v any) error {
+		return f(data)(v)
+	}
+}
+
+// deriveUncurry3 combines a function that returns a function, into one function.
+func deriveUncurry3(f func(a int) func(b string, c bool) string) func(a int, b string, c bool) string {
+	return func(a int, b string, c bool) string {
+		return f(a)(b, c)
+	}
+}
+
+// deriveUncurryCurried combines a function that returns a function, into one function.
+func deriveUncurryCurried(f func(b string) func(c bool) string) func(b string, c bool) string {
+	return func(b string, c bool) string {
+		return f(b)(c)
+	}
+}
+
+// deriveMemSyncInc returns a memoized version of the input function, which is safe for concurrent use.
+// Concurrent calls with the same parameters wait for the first call, instead of calling the input function again.
+func deriveMemSyncInc(f func(n int) int) func(n int) int {
+	type input struct {
+		Param0 int
+	}
+	type output struct {
+		Res0 int
+	}
+	type entry struct {
+		in input
+		out output
+		done chan struct{}
+		panicked bool
+	}
+	var mu sync.Mutex
+	m := make(map[input]*entry)
+	remove := func(e *entry) {
+		if m[e.in] == e {
+			delete(m, e.in)
+		}
+	}
+	return func(param0 int) int {
+		in := input{param0}
+		for {
+			mu.Lock()
+			e := m[in]
+			if e != nil {
+				mu.Unlock()
+				<-e.done
+				if e.panicked {
+					continue
+				}
+				return e.out.Res0
+			}
+			e = &entry{in: in, done: make(chan struct{})}
+			m[in] = e
+			mu.Unlock()
+			returned := false
+			defer func() {
+				if !returned {
+					mu.Lock()
+					remove(e)
+					mu.Unlock()
+					e.panicked = true
+					close(e.done)
+				}
+			}()
+			res0 := f(param0)
+			e.out = output{res0}
+			returned = true
+			close(e.done)
+			return e.out.Res0
+		}
+	}
+}
+
+// deriveMemSyncSum returns a memoized version of the input function, which is safe for concurrent use.
+// Concurrent calls with the same parameters wait for the first call, instead of calling the input function again.
+func deriveMemSyncSum(f func(ns []int, offset int) (int, error)) func(ns []int, offset int) (int, error) {
+	type input struct {
+		Param0 []int
+		Param1 int
+	}
+	type output struct {
+		Res0 int
+		Res1 error
+	}
+	type entry struct {
+		in input
+		hash uint64
+		out output
+		done chan struct{}
+		panicked bool
+	}
+	var mu sync.Mutex
+	m := make(map[uint64][]*entry)
+	remove := func(e *entry) {
+		es := m[e.hash]
+		for i := range es {
+			if es[i] == e {
+				es = append(es[:i], es[i+1:]...)
+				break
+			}
+		}
+		if len(es) == 0 {
+			delete(m, e.hash)
+		} else {
+			m[e.hash] = es
+		}
+	}
+	return func(param0 []int, param1 int) (int, error) {
+		in := input{param0, param1}
+		h := deriveHash_138(in)
+		for {
+			mu.Lock()
+			var e *entry
+			for _, v := range m[h] {
+				if deriveEqual_96(v.in, in) {
+					e = v
+					break
+				}
+			}
+			if e != nil {
+				mu.Unlock()
+				<-e.done
+				if e.panicked {
+					continue
+				}
+				return e.out.Res0, e.out.Res1
+			}
+			e = &entry{in: in, hash: h, done: make(chan struct{})}
+			m[h] = append(m[h], e)
+			mu.Unlock()
+			returned := false
+			defer func() {
+				if !returned {
+					mu.Lock()
+					remove(e)
+					mu.Unlock()
+					e.panicked = true
+					close(e.done)
+				}
+			}()
+			res0, res1 := f(param0, param1)
+			e.out = output{res0, res1}
+			returned = true
+			close(e.done)
+			return e.out.Res0, e.out.Res1
+		}
+	}
+}
+
+// deriveMemSyncPanic returns a memoized version of the input function, which is safe for concurrent use.
+// Concurrent calls with the same parameters wait for the first call, instead of calling the input function again.
+func deriveMemSyncPanic(f func(uint) uint) func(uint) uint {
+	type input struct {
+		Param0 uint
+	}
+	type output struct {
+		Res0 uint
+	}
+	type entry struct {
+		in input
+		out output
+		done chan struct{}
+		panicked bool
+	}
+	var mu sync.Mutex
+	m := make(map[input]*entry)
+	remove := func(e *entry) {
+		if m[e.in] == e {
+			delete(m, e.in)
+		}
+	}
+	return func(param0 uint) uint {
+		in := input{param0}
+		for {
+			mu.Lock()
+			e := m[in]
+			if e != nil {
+				mu.Unlock()
+				<-e.done
+				if e.panicked {
+					continue
+				}
+				return e.out.Res0
+			}
+			e = &entry{in: in, done: make(chan struct{})}
+			m[in] = e
+			mu.Unlock()
+			returned := false
+			defer func() {
+				if !returned {
+					mu.Lock()
+					remove(e)
+					mu.Unlock()
+					e.panicked = true
+					close(e.done)
+				}
+			}()
+			res0 := f(param0)
+			e.out = output{res0}
+			returned = true
+			close(e.done)
+			return e.out.Res0
+		}
+	}
+}
+
+// deriveIsEmpty returns whether this is the zero value of its type, where empty slices and maps are also zero.
+func deriveIsEmpty(this Resettable) bool {
+	return this.Name == "" &&
+		this.Count == 0 &&
+		!this.Done &&
+		len(this.Values) == 0 &&
+		len(this.Index) == 0 &&
+		deriveIsEmpty_A(this.Address) &&
+		this.Billing == nil &&
+		this.Shape == nil &&
+		deriveIsEmpty_2(this.Pairs) &&
+		deriveIsEmpty_P(this.Private) &&
+		this.Callback == nil &&
+		this.Events == nil
+}
+
+// deriveCompose composes functions f0 and f1 into one function, that takes the parameters from f0 and returns the results from f1.
+func deriveCompose(f0 func() (string, error), f1 func(string) (float64, error)) func() (float64, error) {
+	return func() (float64, error) {
+		v_1_0, err0 := f0()
+		if err0 != nil {
+			return 0, err0
+		}
+		v_2_0, err1 := f1(v_1_0)
+		if err1 != nil {
+			return 0, err1
+		}
+		return v_2_0, nil
+	}
+}
+
+// deriveComposeA composes functions f0 and f1 into one function, that takes the parameters from f0 and returns the results from f1.
+func deriveComposeA(f0 func(string) (string, error), f1 func(string) (float64, error)) func(string) (float64, error) {
+	return func(v_0_0 string) (float64, error) {
+		v_1_0, err0 := f0(v_0_0)
+		if err0 != nil {
+			return 0, err0
+		}
+		v_2_0, err1 := f1(v_1_0)
+		if err1 != nil {
+			return 0, err1
+		}
+		return v_2_0, nil
+	}
+}
+
+// deriveCompose2 composes functions f0 and f1 into one function, that takes the parameters from f0 and returns the results from f1.
+func deriveCompose2(f0 func(string, string) ([]string, string, error), f1 func([]string, string) (float64, error)) func(string, string) (float64, error) {
+	return func(v_0_0 string, v_0_1 string) (float64, error) {
+		v_1_0, v_1_1, err0 := f0(v_0_0, v_0_1)
+		if err0 != nil {
+			return 0, err0
+		}
+		v_2_0, err1 := f1(v_1_0, v_1_1)
+		if err1 != nil {
+			return 0, err1
+		}
+		return v_2_0, nil
+	}
+}
+
+// deriveComposeVariadic composes functions f0, f1 and f2 into one function, that takes the parameters from f0 and returns the results from f2.
+func deriveComposeVariadic(f0 func(string) (string, error), f1 func(string) (float64, error), f2 func(float64) (int, error)) func(string) (int, error) {
+	return func(v_0_0 string) (int, error) {
+		v_1_0, err0 := f0(v_0_0)
+		if err0 != nil {
+			return 0, err0
+		}
+		v_2_0, err1 := f1(v_1_0)
+		if err1 != nil {
+			return 0, err1
+		}
+		v_3_0, err2 := f2(v_2_0)
+		if err2 != nil {
+			return 0, err2
+		}
+		return v_3_0, nil
+	}
+}
+
+// deriveCompareComplex32 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompareComplex32(this, that complex64) int {
+	if thisr, thatr := real(this), real(that); thisr == thatr {
+		if thisi, thati := imag(this), imag(that); thisi == thati {
+			return 0
+		} else if thisi < thati {
+			return -1
+		} else {
+			return 1
+		}
+	} else if thisr < thatr {
+		return -1
+	} else {
+		return 1
+	}
+}
+
+// deriveCompareComplex64 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompareComplex64(this, that complex128) int {
+	if thisr, thatr := real(this), real(that); thisr == thatr {
+		if thisi, thati := imag(this), imag(that); thisi == thati {
+			return 0
+		} else if thisi < thati {
+			return -1
+		} else {
+			return 1
+		}
+	} else if thisr < thatr {
+		return -1
+	} else {
+		return 1
+	}
+}
+
+// deriveCompareCurryComplex64 returns a curried compare function, which returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompareCurryComplex64(this complex128) func(complex128) int {
+	return func(that complex128) int {
+		if thisr, thatr := real(this), real(that); thisr == thatr {
+			if thisi, thati := imag(this), imag(that); thisi == thati {
+				return 0
+			} else if thisi < thati {
+				return -1
+			} else {
+				return 1
+			}
+		} else if thisr < thatr {
+			return -1
+		} else {
+			return 1
+		}
+	}
+}
+
+// deriveCompareDeriveTheDerived returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompareDeriveTheDerived(this, that *DeriveTheDerived) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := deriveCompare_int4(this.Field, that.Field); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// deriveCompareGeneric returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompareGeneric[T ~int | ~string](this, that T) int {
+	if this != that {
+		if this < that {
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
+
+// deriveComparePtrToGenericList returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveComparePtrToGenericList(this, that *GenericList[int]) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := deriveCompare_int4(this.Value, that.Value); c != 0 {
+		return c
+	}
+	if c := deriveComparePtrToGenericList(this.Next, that.Next); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// deriveUniqueInt64s returns a list containing only the unique items from the input list.
+// It does this by reusing the input list.
+func deriveUniqueInt64s(list []int64) []int64 {
+	if len(list) == 0 {
+		return nil
+	}
+	return deriveKeysForInt64s(deriveSetInt64s(list))
+}
+
+// deriveUniqueStructs returns a list containing only the unique items from the input list.
+// It does this by reusing the input list.
+func deriveUniqueStructs(list []*BuiltInTypes) []*BuiltInTypes {
+	if len(list) == 0 {
+		return nil
+	}
+	table := make(map[uint64][]int)
+	u := 0
+	for i := 0; i < len(list); i++ {
+		contains := false
+		hash := deriveHash_139(list[i])
+		indexes := table[hash]
+		for _, index := range indexes {
+			if deriveEqual_95(list[index], list[i]) {
+				contains = true
+				break
+			}
+		}
+		if contains {
+			continue
+		}
+		if i != u {
+			list[u] = list[i]
+		}
+		table[hash] = append(table[hash], u)
+		u++
+	}
+	return list[:u]
+}
+
+// deriveString returns a compact and human readable representation of this.
+func deriveString(this Loggable) string {
+	return string(deriveString_Lo(this, nil))
+}
+
+// deriveStringInts returns a compact and human readable representation of this.
+func deriveStringInts(this []int) string {
+	return string(deriveString_4(this, nil))
+}
+
+// deriveMemTTLInc returns a memoized version of the input function, which is safe for concurrent use
+// and only remembers a result for the ttl duration.
+// Concurrent calls with the same parameters wait for the first call, instead of calling the input function again.
+func deriveMemTTLInc(ttl time.Duration, f func(n int) int) func(n int) int {
+	type input struct {
+		Param0 int
+	}
+	type output struct {
+		Res0 int
+	}
+	type entry struct {
+		in input
+		out output
+		done chan struct{}
+		panicked bool
+		elem *list.Element
+		expires time.Time
+	}
+	var mu sync.Mutex
+	m := make(map[input]*entry)
+	expiring := list.New()
+	remove := func(e *entry) {
+		if m[e.in] == e {
+			delete(m, e.in)
+		}
+		if e.elem != nil {
+			expiring.Remove(e.elem)
+		}
+	}
+	return func(param0 int) int {
+		in := input{param0}
+		for {
+			mu.Lock()
+			now := time.Now()
+			for front := expiring.Front(); front != nil && now.After(front.Value.(*entry).expires); front = expiring.Front() {
+				remove(front.Value.(*entry))
+			}
+			e := m[in]
+			if e != nil {
+				mu.Unlock()
+				<-e.done
+				if e.panicked {
+					continue
+				}
+				return e.out.Res0
+			}
+			e = &entry{in: in, done: make(chan struct{})}
+			m[in] = e
+			mu.Unlock()
+			returned := false
+			defer func() {
+				if !returned {
+					mu.Lock()
+					remove(e)
+					mu.Unlock()
+					e.panicked = true
+					close(e.done)
+				}
+			}()
+			res0 := f(param0)
+			e.out = output{res0}
+			returned = true
+			mu.Lock()
+			e.expires = time.Now().Add(ttl)
+			e.elem = expiring.PushBack(e)
+			mu.Unlock()
+			close(e.done)
+			return e.out.Res0
+		}
+	}
+}
+
+// deriveMemTTLPanic returns a memoized version of the input function, which is safe for concurrent use
+// and only remembers a result for the ttl duration.
+// Concurrent calls with the same parameters wait for the first call, instead of calling the input function again.
+func deriveMemTTLPanic(ttl time.Duration, f func(uint) uint) func(uint) uint {
+	type input struct {
+		Param0 uint
+	}
+	type output struct {
+		Res0 uint
+	}
+	type entry struct {
+		in input
+		out output
+		done chan struct{}
+		panicked bool
+		elem *list.Element
+		expires time.Time
+	}
+	var mu sync.Mutex
+	m := make(map[input]*entry)
+	expiring := list.New()
+	remove := func(e *entry) {
+		if m[e.in] == e {
+			delete(m, e.in)
+		}
+		if e.elem != nil {
+			expiring.Remove(e.elem)
+		}
+	}
+	return func(param0 uint) uint {
+		in := input{param0}
+		for {
+			mu.Lock()
+			now := time.Now()
+			for front := expiring.Front(); front != nil && now.After(front.Value.(*entry).expires); front = expiring.Front() {
+				remove(front.Value.(*entry))
+			}
+			e := m[in]
+			if e != nil {
+				mu.Unlock()
+				<-e.done
+				if e.panicked {
+					continue
+				}
+				return e.out.Res0
+			}
+			e = &entry{in: in, done: make(chan struct{})}
+			m[in] = e
+			mu.Unlock()
+			returned := false
+			defer func() {
+				if !returned {
+					mu.Lock()
+					remove(e)
+					mu.Unlock()
+					e.panicked = true
+					close(e.done)
+				}
+			}()
+			res0 := f(param0)
+			e.out = output{res0}
+			returned = true
+			mu.Lock()
+			e.expires = time.Now().Add(ttl)
+			e.elem = expiring.PushBack(e)
+			mu.Unlock()
+			close(e.done)
+			return e.out.Res0
+		}
+	}
+}
+
+// deriveMemLRUInc returns a memoized version of the input function, which is safe for concurrent use
+// and only remembers the results of the capacity most recently used parameters.
+// Concurrent calls with the same parameters wait for the first call, instead of calling the input function again.
+func deriveMemLRUInc(capacity int, f func(n int) int) func(n int) int {
+	if capacity < 1 {
+		capacity = 1
+	}
+	type input struct {
+		Param0 int
+	}
+	type output struct {
+		Res0 int
+	}
+	type entry struct {
+		in input
+		out output
+		done chan struct{}
+		panicked bool
+		elem *list.Element
+	}
+	var mu sync.Mutex
+	m := make(map[input]*entry)
+	recent := list.New()
+	remove := func(e *entry) {
+		if m[e.in] == e {
+			delete(m, e.in)
+		}
+		recent.Remove(e.elem)
+	}
+	return func(param0 int) int {
+		in := input{param0}
+		for {
+			mu.Lock()
+			e := m[in]
+			if e != nil {
+				recent.MoveToFront(e.elem)
+				mu.Unlock()
+				<-e.done
+				if e.panicked {
+					continue
+				}
+				return e.out.Res0
+			}
+			e = &entry{in: in, done: make(chan struct{})}
+			m[in] = e
+			e.elem = recent.PushFront(e)
+			if recent.Len() > capacity {
+				remove(recent.Back().Value.(*entry))
+			}
+			mu.Unlock()
+			returned := false
+			defer func() {
+				if !returned {
+					mu.Lock()
+					remove(e)
+					mu.Unlock()
+					e.panicked = true
+					close(e.done)
+				}
+			}()
+			res0 := f(param0)
+			e.out = output{res0}
+			returned = true
+			close(e.done)
+			return e.out.Res0
+		}
+	}
+}
+
+// deriveMemLRUFirst returns a memoized version of the input function, which is safe for concurrent use
+// and only remembers the results of the capacity most recently used parameters.
+// Concurrent calls with the same parameters wait for the first call, instead of calling the input function again.
+func deriveMemLRUFirst(capacity int, f func(ns []int) int) func(ns []int) int {
+	if capacity < 1 {
+		capacity = 1
+	}
+	type input struct {
+		Param0 []int
+	}
+	type output struct {
+		Res0 int
+	}
+	type entry struct {
+		in input
+		hash uint64
+		out output
+		done chan struct{}
+		panicked bool
+		elem *list.Element
+	}
+	var mu sync.Mutex
+	m := make(map[uint64][]*entry)
+	recent := list.New()
+	remove := func(e *entry) {
+		es := m[e.hash]
+		for i := range es {
+			if es[i] == e {
+				es = append(es[:i], es[i+1:]...)
+				break
+			}
+		}
+		if len(es) == 0 {
+			delete(m, e.hash)
+		} else {
+			m[e.hash] = es
+		}
+		recent.Remove(e.elem)
+	}
+	return func(param0 []int) int {
+		in := input{param0}
+		h := deriveHash_140(in)
+		for {
+			mu.Lock()
+			var e *entry
+			for _, v := range m[h] {
+				if deriveEqual_97(v.in, in) {
+					e = v
+					break
+				}
+			}
+			if e != nil {
+				recent.MoveToFront(e.elem)
+				mu.Unlock()
+				<-e.done
+				if e.panicked {
+					continue
+				}
+				return e.out.Res0
+			}
+			e = &entry{in: in, hash: h, done: make(chan struct{})}
+			m[h] = append(m[h], e)
+			e.elem = recent.PushFront(e)
+			if recent.Len() > capacity {
+				remove(recent.Back().Value.(*entry))
+			}
+			mu.Unlock()
+			returned := false
+			defer func() {
+				if !returned {
+					mu.Lock()
+					remove(e)
+					mu.Unlock()
+					e.panicked = true
+					close(e.done)
+				}
+			}()
+			res0 := f(param0)
+			e.out = output{res0}
+			returned = true
+			close(e.done)
+			return e.out.Res0
+		}
+	}
+}
+
+// deriveMemLRUPanic returns a memoized version of the input function, which is safe for concurrent use
+// and only remembers the results of the capacity most recently used parameters.
+// Concurrent calls with the same parameters wait for the first call, instead of calling the input function again.
+func deriveMemLRUPanic(capacity int, f func(uint) uint) func(uint) uint {
+	if capacity < 1 {
+		capacity = 1
+	}
+	type input struct {
+		Param0 uint
+	}
+	type output struct {
+		Res0 uint
+	}
+	type entry struct {
+		in input
+		out output
+		done chan struct{}
+		panicked bool
+		elem *list.Element
+	}
+	var mu sync.Mutex
+	m := make(map[input]*entry)
+	recent := list.New()
+	remove := func(e *entry) {
+		if m[e.in] == e {
+			delete(m, e.in)
+		}
+		recent.Remove(e.elem)
+	}
+	return func(param0 uint) uint {
+		in := input{param0}
+		for {
+			mu.Lock()
+			e := m[in]
+			if e != nil {
+				recent.MoveToFront(e.elem)
+				mu.Unlock()
+				<-e.done
+				if e.panicked {
+					continue
+				}
+				return e.out.Res0
+			}
+			e = &entry{in: in, done: make(chan struct{})}
+			m[in] = e
+			e.elem = recent.PushFront(e)
+			if recent.Len() > capacity {
+				remove(recent.Back().Value.(*entry))
+			}
+			mu.Unlock()
+			returned := false
+			defer func() {
+				if !returned {
+					mu.Lock()
+					remove(e)
+					mu.Unlock()
+					e.panicked = true
+					close(e.done)
+				}
+			}()
+			res0 := f(param0)
+			e.out = output{res0}
+			returned = true
+			close(e.done)
+			return e.out.Res0
+		}
+	}
+}
+
+// deriveIsZero returns whether this is the zero value of its type.
+func deriveIsZero(this Resettable) bool {
+	return this.Name == "" &&
+		this.Count == 0 &&
+		!this.Done &&
+		this.Values == nil &&
+		this.Index == nil &&
+		deriveIsZero_(this.Address) &&
+		this.Billing == nil &&
+		this.Shape == nil &&
+		deriveIsZero_1(this.Pairs) &&
+		deriveIsZero_P(this.Private) &&
+		this.Callback == nil &&
+		this.Events == nil
+}
+
+// deriveIsZeroArray returns whether this is the zero value of its type.
+func deriveIsZeroArray(this [3]int) bool {
+	for _, v := range this {
+		if !(v == 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveFilter returns a list of all items in the list that matches the predicate.
+func deriveFilter(predicate func(int) bool, list []int) []int {
+	j := 0
+	for i, elem := range list {
+		if predicate(elem) {
+			if i != j {
+				list[j] = list[i]
+			}
+			j++
+		}
+	}
+	return list[:j]
+}
+
+// deriveFilterJudy returns a list of all items in the list that matches the predicate.
+func deriveFilterJudy(predicate func(string) bool, list []string) []string {
+	j := 0
+	for i, elem := range list {
+		if predicate(elem) {
+			if i != j {
+				list[j] = list[i]
+			}
+			j++
+		}
+	}
+	return list[:j]
+}
+
+// deriveFanOut distributes the messages received on c over n output channels, in a round-robin fashion.
+func deriveFanOut(n int, c chan int) []<-chan int {
+	if n < 1 {
+		n = 1
+	}
+	cs := make([]chan int, n)
+	outs := make([]<-chan int, n)
+	for i := range cs {
+		cs[i] = make(chan int, cap(c))
+		outs[i] = cs[i]
+	}
+	go func() {
+		i := 0
+		for v := range c {
+			cs[i] <- v
+			i = (i + 1) % n
+		}
+		for _, o := range cs {
+			close(o)
+		}
+	}()
+	return outs
+}
+
+// deriveUnionSetOfInt64s returns the union of two maps, with respect to the keys.
+// It does this by adding the keys to the first map.
+func deriveUnionSetOfInt64s(union, that map[int64]struct{}) map[int64]struct{} {
+	for k := range that {
+		union[k] = struct{}{}
+	}
+	return union
+}
+
+// deriveUnionOfInt64s returns the union of the items of the two input lists.
+// It does this by append items to the first list.
+func deriveUnionOfInt64s(this, that []int64) []int64 {
+	for i, v := range that {
+		if !deriveContainsInt64s(this, v) {
+			this = append(this, that[i])
+		}
+	}
+	return this
+}
+
+// deriveTuple1 returns a function, which returns the input values.
+// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
+func deriveTuple1(v0 int) func() int {
+	return func() int {
+		return v0
+	}
+}
+
+// deriveTuple2 returns a function, which returns the input values.
+// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
+func deriveTuple2(v0 int, v1 string) func() (int, string) {
+	return func() (int, string) {
+		return v0, v1
+	}
+}
+
+// deriveTuple3 returns a function, which returns the input values.
+// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
+func deriveTuple3(v0 int, v1 string, v2 *BuiltInTypes) func() (int, string, *BuiltInTypes) {
+	return func() (int, string, *BuiltInTypes) {
+		return v0, v1, v2
+	}
+}
+
+// deriveTupleError returns a function, which returns the input values.
+// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
+func deriveTupleError(v0 []byte, v1 error) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		return v0, v1
+	}
+}
+
+// deriveReset sets this to the zero value of its type, while keeping the capacity of slices and maps.
+func deriveReset(this *Resettable) {
+	this.Name = ""
+	this.Count = 0
+	this.Done = false
+	clear(this.Values)
+	this.Values = this.Values[:0]
+	clear(this.Index)
+	deriveReset_(&this.Address)
+	this.Billing = nil
+	this.Shape = nil
+	deriveReset_1(&this.Pairs)
+	deriveReset_2(&this.Private)
+	this.Callback = nil
+	this.Events = nil
+}
+
+// deriveMerge merges the fields of src, which are not zero, into dst.
+func deriveMerge(dst, src *Mergeable) {
+	if src.Name != "" {
+		dst.Name = src.Name
+	}
+	if src.Count != 0 {
+		dst.Count = src.Count
+	}
+	if src.Enabled {
+		dst.Enabled = src.Enabled
+	}
+	if src.Ratio != nil {
+		dst.Ratio = new(float64)
+		deriveDeepCopy_52(dst.Ratio, src.Ratio)
+	}
+	if src.Tags != nil {
+		dst.Tags = make([]string, len(src.Tags))
+		deriveDeepCopy_53(dst.Tags, src.Tags)
+	}
+	if src.History != nil {
+		n := len(dst.History)
+		dst.History = append(dst.History, make([]string, len(src.History))...)
+		deriveDeepCopy_53(dst.History[n:], src.History)
+	}
+	if src.Labels != nil {
+		if dst.Labels == nil {
+			dst.Labels = make(map[string]string, len(src.Labels))
+		}
+		deriveDeepCopy_51(dst.Labels, src.Labels)
+	}
+	deriveMerge_(&dst.Address, &src.Address)
+	if src.Billing != nil {
+		if dst.Billing == nil {
+			dst.Billing = new(Address)
+		}
+		deriveMerge_(dst.Billing, src.Billing)
+	}
+	if src.Shape != nil {
+		dst.Shape = deriveDeepCopy_Sh(src.Shape)
+	}
+	if src.Owner != nil {
+		dst.Owner = src.Owner
+	}
+	deriveMerge_1(&dst.Scores, &src.Scores)
+	if src.Embedded != (extra.StructWithoutEqualMethod{}) {
+		dst.Embedded = src.Embedded
+	}
+}
+
+// deriveMergeUpdate merges the fields of src, which are not zero, into dst.
+func deriveMergeUpdate(dst, src *MergeUpdate) {
+	if src.Name != "" {
+		dst.Name = src.Name
+	}
+	if !src.Updated.IsZero() {
+		dst.Updated = src.Updated
+	}
+}
+
+// deriveEqualFanOut returns whether this and that are equal.
+func deriveEqualFanOut(this, that [][]int) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if len(this) != len(that) {
+		return false
+	}
+	for i := 0; i < len(this); i++ {
+		if !(deriveEqualSliceOfint(this[i], that[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveEqualSliceOfint returns whether this and that are equal.
+func deriveEqualSliceOfint(this, that []int) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if len(this) != len(that) {
+		return false
+	}
+	for i := 0; i < len(this); i++ {
+		if !(this[i] == that[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveEqualInefficientDeriveTheDerived returns whether this and that are equal.
+func deriveEqualInefficientDeriveTheDerived(this, that int) bool {
+	return this == that
+}
+
+// deriveEqualPrivateFields returns whether this and that are equal.
+func deriveEqualPrivateFields(this, that *extra.PrivateFieldAndNoEqualMethod) bool {
+	thisv := reflect.Indirect(reflect.ValueOf(this))
+	thatv := reflect.Indirect(reflect.ValueOf(that))
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			*(*int64)(unsafe.Pointer(thisv.FieldByName("number").UnsafeAddr())) == *(*int64)(unsafe.Pointer(thatv.FieldByName("number").UnsafeAddr())) &&
+			deriveEqual_98(*(*[]int64)(unsafe.Pointer(thisv.FieldByName("numbers").UnsafeAddr())), *(*[]int64)(unsafe.Pointer(thatv.FieldByName("numbers").UnsafeAddr()))) &&
+			((*(**int64)(unsafe.Pointer(thisv.FieldByName("ptr").UnsafeAddr())) == nil && *(**int64)(unsafe.Pointer(thatv.FieldByName("ptr").UnsafeAddr())) == nil) || (*(**int64)(unsafe.Pointer(thisv.FieldByName("ptr").UnsafeAddr())) != nil && *(**int64)(unsafe.Pointer(thatv.FieldByName("ptr").UnsafeAddr())) != nil && *(*(**int64)(unsafe.Pointer(thisv.FieldByName("ptr").UnsafeAddr()))) == *(*(**int64)(unsafe.Pointer(thatv.FieldByName("ptr").UnsafeAddr()))))) &&
+			deriveEqual_99(*(*[]*int64)(unsafe.Pointer(thisv.FieldByName("numberpts").UnsafeAddr())), *(*[]*int64)(unsafe.Pointer(thatv.FieldByName("numberpts").UnsafeAddr()))) &&
+			deriveEqual_100(*(**extra.StructWithoutEqualMethod)(unsafe.Pointer(thisv.FieldByName("strct").UnsafeAddr())), *(**extra.StructWithoutEqualMethod)(unsafe.Pointer(thatv.FieldByName("strct").UnsafeAddr())))
+}
+
+// deriveEqualMapOfintToint returns whether this and that are equal.
+func deriveEqualMapOfintToint(this, that map[int]int) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if len(this) != len(that) {
+		return false
+	}
+	for k, v := range this {
+		thatv, ok := that[k]
+		if !ok {
+			return false
+		}
+		if !(v == thatv) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveEqualPtrToint returns whether this and that are equal.
+func deriveEqualPtrToint(this, that *int) bool {
+	if this == nil && that == nil {
+		return true
+	}
+	if this != nil && that != nil {
+		return *this == *that
+	}
+	return false
+}
+
+// deriveEqualPtrToSliceOfint returns whether this and that are equal.
+func deriveEqualPtrToSliceOfint(this, that *[]int) bool {
+	if this == nil && that == nil {
+		return true
+	}
+	if this != nil && that != nil {
+		if *this == nil || *that == nil {
+			return *this == nil && *that == nil
+		}
+		if len(*this) != len(*that) {
+			return false
+		}
+		for i := 0; i < len(*this); i++ {
+			if !((*this)[i] == (*that)[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// deriveEqualPtrToArray10Ofint returns whether this and that are equal.
+func deriveEqualPtrToArray10Ofint(this, that *[10]int) bool {
+	if this == nil && that == nil {
+		return true
+	}
+	if this != nil && that != nil {
+		for i := 0; i < len(*this); i++ {
+			if !((*this)[i] == (*that)[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// deriveEqualPtrToMapOfintToint returns whether this and that are equal.
+func deriveEqualPtrToMapOfintToint(this, that *map[int]int) bool {
+	if this == nil && that == nil {
+		return true
+	}
+	if this != nil && that != nil {
+		if *this == nil || *that == nil {
+			return *this == nil && *that == nil
+		}
+		if len(*this) != len(*that) {
+			return false
+		}
+		for k, v := range *this {
+			thatv, ok := (*that)[k]
+			if !ok {
+				return false
+			}
+			if !(v == thatv) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// deriveEqual1 returns whether this and that are equal.
+func deriveEqual1(this, that BuiltInTypes) bool {
+	return this == that
+}
+
+// deriveEqualCurry returns an equal closure, with the first parameter already filled in.
+func deriveEqualCurry(this *BuiltInTypes) func(*BuiltInTypes) bool {
+	return func(that *BuiltInTypes) bool {
+		return (this == nil && that == nil) ||
+			this != nil && that != nil &&
+				this.Bool == that.Bool &&
+				this.Byte == that.Byte &&
+				this.Complex128 == that.Complex128 &&
+				this.Complex64 == that.Complex64 &&
+				this.Float64 == that.Float64 &&
+				this.Float32 == that.Float32 &&
+				this.Int == that.Int &&
+				this.Int16 == that.Int16 &&
+				this.Int32 == that.Int32 &&
+				this.Int64 == that.Int64 &&
+				this.Int8 == that.Int8 &&
+				this.Rune == that.Rune &&
+				this.String == that.String &&
+				this.Uint == that.Uint &&
+				this.Uint16 == that.Uint16 &&
+				this.Uint32 == that.Uint32 &&
+				this.Uint64 == that.Uint64 &&
+				this.Uint8 == that.Uint8 &&
+				this.UintPtr == that.UintPtr
+	}
+}
+
+// deriveEqualMapTypes returns whether this and that are equal.
+func deriveEqualMapTypes(this, that *SomeJson) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Name == that.Name &&
+			this.Other.Equal(that.Other)
+}
+
+// deriveEqualPtrToGenericTree returns whether this and that are equal.
+func deriveEqualPtrToGenericTree[T comparable](this, that *GenericTree[T]) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Value == that.Value &&
+			deriveEqual_101(this.Children, that.Children)
+}
+
+// deriveEqualGenericSlice returns whether this and that are equal.
+func deriveEqualGenericSlice[T comparable](this, that []T) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if len(this) != len(that) {
+		return false
+	}
+	for i := 0; i < len(this); i++ {
+		if !(this[i] == that[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveEqualPtrToGenericPair returns whether this and that are equal.
+func deriveEqualPtrToGenericPair(this, that *GenericPair[string, []int]) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Key == that.Key &&
+			deriveEqualSliceOfint(this.Value, that.Value)
+}
+
+// deriveEqualPtrToGenericList returns whether this and that are equal.
+func deriveEqualPtrToGenericList(this, that *GenericList[int]) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Value == that.Value &&
+			deriveEqualPtrToGenericList(this.Next, that.Next)
+}
+
+// deriveEqual returns whether this and that are equal.
+func deriveEqual(this, that *UseVendor) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			deriveEqual_102(this.Vendors, that.Vendors)
+}
+
+// deriveEqual_95 returns whether this and that are equal.
+func deriveEqual_95(this, that *BuiltInTypes) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Bool == that.Bool &&
+			this.Byte == that.Byte &&
+			this.Complex128 == that.Complex128 &&
+			this.Complex64 == that.Complex64 &&
+			this.Float64 == that.Float64 &&
+			this.Float32 == that.Float32 &&
+			this.Int == that.Int &&
+			this.Int16 == that.Int16 &&
+			this.Int32 == that.Int32 &&
+			this.Int64 == that.Int64 &&
+			this.Int8 == that.Int8 &&
+			this.Rune == that.Rune &&
+			this.String == that.String &&
+			this.Uint == that.Uint &&
+			this.Uint16 == that.Uint16 &&
+			this.Uint32 == that.Uint32 &&
+			this.Uint64 == that.Uint64 &&
+			this.Uint8 == that.Uint8 &&
+			this.UintPtr == that.UintPtr
+}
+
+// deriveEqual_96 returns whether this and that are equal.
+func deriveEqual_96(this, that struct {
+	Param0 []int
+	Param1 int
+}) bool {
+	return deriveEqualSliceOfint(this.Param0, that.Param0) &&
+		this.Param1 == that.Param1
+}
+
+// deriveEqual_97 returns whether this and that are equal.
+func deriveEqual_97(this, that struct {
+	Param0 []int
+}) bool {
+	return deriveEqualSliceOfint(this.Param0, that.Param0)
+}
+
+// deriveCurryMarshal returns a function that has one parameter, which corresponds to the input functions first parameter, and a result that is a function, which takes the rest of the parameters as input and finally returns the original input function's results.
+func deriveCurryMarshal(f func(data []byte, v any) error) func(data []byte) func(v any) error {
+	return func(data []byte) func(v any) error {
+		return func(v any) error {
+			return f(data, v)
+		}
+	}
+}
+
+// deriveCurry3 returns a function that has one parameter, which corresponds to the input functions first parameter, and a result that is a function, which takes the rest of the parameters as input and finally returns the original input function's results.
+func deriveCurry3(f func(a int, b string, c bool) string) func(a int) func(b string, c bool) string {
+	return func(a int) func(b string, c bool) string {
+		return func(b string, c bool) string {
+			return f(a, b, c)
+		}
+	}
+}
+
+// deriveCurryCurried returns a function that has one parameter, which corresponds to the input functions first parameter, and a result that is a function, which takes the rest of the parameters as input and finally returns the original input function's results.
+func deriveCurryCurried(f func(b string, c bool) string) func(b string) func(c bool) string {
+	return func(b string) func(c bool) string {
+		return func(c bool) string {
+			return f(b, c)
+		}
+	}
+}
+
+// deriveCloneSliceOfint returns a clone of the src parameter.
+func deriveCloneSliceOfint(src []int) []int {
+	if src == nil {
+		return nil
+	}
+	dst := make([]int, len(src))
+	deriveDeepCopy_54(dst, src)
+	return dst
+}
+
+// deriveCloneMapOfintToint returns a clone of the src parameter.
+func deriveCloneMapOfintToint(src map[int]int) map[int]int {
+	if src == nil {
+		return nil
+	}
+	dst := make(map[int]int)
+	deriveDeepCopy_55(dst, src)
+	return dst
+}
+
+// deriveClonePtrToint returns a clone of the src parameter.
+func deriveClonePtrToint(src *int) *int {
+	if src == nil {
+		return nil
+	}
+	dst := new(int)
+	deriveDeepCopy_56(dst, src)
+	return dst
+}
+
+// deriveClonePtrToSliceOfint returns a clone of the src parameter.
+func deriveClonePtrToSliceOfint(src *[]int) *[]int {
+	if src == nil {
+		return nil
+	}
+	dst := new([]int)
+	deriveDeepCopy_57(dst, src)
+	return dst
+}
+
+// deriveClonePtrToArray10Ofint returns a clone of the src parameter.
+func deriveClonePtrToArray10Ofint(src *[10]int) *[10]int {
+	if src == nil {
+		return nil
+	}
+	dst := new([10]int)
+	deriveDeepCopy_58(dst, src)
+	return dst
+}
+
+// deriveClonePtrToMapOfintToint returns a clone of the src parameter.
+func deriveClonePtrToMapOfintToint(src *map[int]int) *map[int]int {
+	if src == nil {
+		return nil
+	}
+	dst := new(map[int]int)
+	deriveDeepCopy_59(dst, src)
+	return dst
+}
+
+// deriveClone1 returns a clone of the src parameter.
+func deriveClone1(src BuiltInTypes) BuiltInTypes {
+	dst := new(BuiltInTypes)
+	deriveDeepCopy_60(dst, &src)
+	return *dst
+}
+
+// deriveCloneGenericList returns a clone of the src parameter.
+func deriveCloneGenericList[T ~int | ~string](src *GenericList[T]) *GenericList[T] {
+	if src == nil {
+		return nil
+	}
+	dst := new(GenericList[T])
+	deriveDeepCopy_61(dst, src)
+	return dst
+}
+
+// deriveBatch groups the messages received on c into batches of at most size messages,
+// where a batch, which is not full, is sent when timeout has passed since its first message was received.
+func deriveBatch(size int, timeout time.Duration, c chan int) <-chan []int {
+	if size < 1 {
+		size = 1
+	}
+	out := make(chan []int)
+	go func() {
+		defer close(out)
+		var batch []int
+		var timer *time.Timer
+		var expired <-chan time.Time
+		flush := func() {
+			if timer != nil {
+				timer.Stop()
+				timer, expired = nil, nil
+			}
+			out <- batch
+			batch = nil
+		}
+		for {
+			select {
+			case v, ok := <-c:
+				if !ok {
+					if len(batch) > 0 {
+						flush()
+					}
+					return
+				}
+				if batch == nil {
+					batch = make([]int, 0, size)
+					if timeout > 0 {
+						timer = time.NewTimer(timeout)
+						expired = timer.C
+					}
+				}
+				batch = append(batch, v)
+				if len(batch) == size {
+					flush()
+				}
+			case <-expired:
+				flush()
+			}
+		}
+	}()
+	return out
+}
+
+// deriveWalk calls visit with every value of type Endpoint, which is nested inside this, until visit returns an error.
+func deriveWalk(this *WalkConfig, visit func(e *Endpoint) error) error {
+	if this == nil {
+		return nil
+	}
+	if err := deriveWalk_(&this.Primary, visit); err != nil {
+		return err
+	}
+	if err := deriveWalk_1(&this.Backup, visit); err != nil {
+		return err
+	}
+	if err := deriveWalk_2(&this.Replicas, visit); err != nil {
+		return err
+	}
+	if err := deriveWalk_3(&this.Regions, visit); err != nil {
+		return err
+	}
+	if err := deriveWalk_4(&this.Pair, visit); err != nil {
+		return err
+	}
+	return nil
+}
+
+// deriveWalkEndpoints calls visit with every value of type Endpoint, which is nested inside this, until visit returns an error.
+func deriveWalkEndpoints(this *map[string]Endpoint, visit func(e *Endpoint) error) error {
+	if this == nil {
+		return nil
+	}
+	for _, k := range deriveSortedStrings(deriveKeys_18(*this)) {
+		v := (*this)[k]
+		if err := deriveWalk_(&v, visit); err != nil {
+			return err
+		}
+		(*this)[k] = v
+	}
+	return nil
+}
+
+// deriveSortedInts sorts the slice inplace and also returns it.
+func deriveSortedInts(list []int) []int {
+	sort.Ints(list)
+	return list
+}
+
+// deriveSortInt64s sorts the slice inplace and also returns it.
+func deriveSortInt64s(list []int64) []int64 {
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+	return list
+}
+
+// deriveSortStructs sorts the slice inplace and also returns it.
+func deriveSortStructs(list []*BuiltInTypes) []*BuiltInTypes {
+	sort.Slice(list, func(i, j int) bool { return deriveCompare_141(list[i], list[j]) < 0 })
+	return list
+}
+
+// deriveSortedStrings sorts the slice inplace and also returns it.
+func deriveSortedStrings(list []string) []string {
+	sort.Strings(list)
+	return list
+}
+
+// deriveKeysForInt64s returns the keys of the input map as a slice.
+func deriveKeysForInt64s(m map[int64]struct{}) []int64 {
+	keys := make([]int64, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// deriveKeysForFmap returns the keys of the input map as a slice.
+func deriveKeysForFmap(m map[int]string) []int {
+	keys := make([]int, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// deriveKeysForMapStringToString returns the keys of the input map as a slice.
+func deriveKeysForMapStringToString(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// deriveKeysForMapIntToInt64 returns the keys of the input map as a slice.
+func deriveKeysForMapIntToInt64(m map[int]int64) []int {
+	keys := make([]int, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// deriveKeysForMapInt64ToInt64 returns the keys of the input map as a slice.
+func deriveKeysForMapInt64ToInt64(m map[int64]int64) []int64 {
+	keys := make([]int64, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// deriveKeys_18 returns the keys of the input map as a slice.
+func deriveKeys_18(m map[string]Endpoint) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// deriveJoinSS concatenates the list of lists into one list.
+func deriveJoinSS(listOfLists [][]string) []string {
+	if listOfLists == nil {
+		return nil
+	}
+	l := 0
+	for _, elem := range listOfLists {
+		l += len(elem)
+	}
+	res := make([]string, 0, l)
+	for _, elem := range listOfLists {
+		res = append(res, elem...)
+	}
+	return res
+}
+
+// deriveJoinEE returns the error or calls f and returns it's value and error.
+func deriveJoinEE(f func() (int64, error), err error) (int64, error) {
+	if err != nil {
+		return 0, err
+	}
+	return f()
+}
+
+// deriveJoinChannels listens on all channels resulting from the input channel and sends all their results on the output channel.
+func deriveJoinChannels(in <-chan (<-chan int)) <-chan int {
+	out := make(chan int)
+	go func() {
+		wait := sync.WaitGroup{}
+		for c := range in {
+			wait.Add(1)
+			res := c
+			go func() {
+				for r := range res {
+					out <- r
+				}
+				wait.Done()
+			}()
+		}
+		wait.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// deriveJoin concatenates the list of lists into one list.
+func deriveJoin(listOfLists [][]int) []int {
+	if listOfLists == nil {
+		return nil
+	}
+	l := 0
+	for _, elem := range listOfLists {
+		l += len(elem)
+	}
+	res := make([]int, 0, l)
+	for _, elem := range listOfLists {
+		res = append(res, elem...)
+	}
+	return res
+}
+
+// deriveJoinString concatenates the list of strings into one string.
+func deriveJoinString(list []string) string {
+	return strings.Join(list, "")
+}
+
+// deriveJoinJustError returns the error or calls f and returns it's error.
+func deriveJoinJustError(f func() error, err error) error {
+	if err != nil {
+		return err
+	}
+	return f()
+}
+
+// deriveJoinErrorAndString returns the error or calls f and returns it's value and error.
+func deriveJoinErrorAndString(f func() (string, error), err error) (string, error) {
+	if err != nil {
+		return "", err
+	}
+	return f()
+}
+
+// deriveJoinErrorAndValues returns the error or calls f and returns it's value and error.
+func deriveJoinErrorAndValues(f func() (string, int, error), err error) (string, int, error) {
+	if err != nil {
+		return "", 0, err
+	}
+	return f()
+}
+
+// deriveJoinSendRecvChannels listens on all channels resulting from the input channel and sends all their results on the output channel.
+func deriveJoinSendRecvChannels(in chan (<-chan int64)) <-chan int64 {
+	out := make(chan int64)
+	go func() {
+		wait := sync.WaitGroup{}
+		for c := range in {
+			wait.Add(1)
+			res := c
+			go func() {
+				for r := range res {
+					out <- r
+				}
+				wait.Done()
+			}()
+		}
+		wait.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// deriveJoinSliceOfRecvChannels listens on all input channels and sends all their results onto the single output channel.
+func deriveJoinSliceOfRecvChannels(in []<-chan int) <-chan int {
+	out := make(chan int)
+	go func() {
+		wait := sync.WaitGroup{}
+		for _, c := range in {
+			wait.Add(1)
+			res := c
+			go func() {
+				for r := range res {
+					out <- r
+				}
+				wait.Done()
+			}()
+		}
+		wait.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// deriveJoinSliceOfSendRecvChannels listens on all input channels and sends all their results onto the single output channel.
+func deriveJoinSliceOfSendRecvChannels(in []chan int) <-chan int {
+	out := make(chan int)
+	go func() {
+		wait := sync.WaitGroup{}
+		for _, c := range in {
+			wait.Add(1)
+			res := c
+			go func() {
+				for r := range res {
+					out <- r
+				}
+				wait.Done()
+			}()
+		}
+		wait.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// deriveJoinVariantOfSendRecvChannels listens on all input channels c0 and c1, and sends all their results onto the single output channel.
+func deriveJoinVariantOfSendRecvChannels(c0 chan int, c1 chan int) <-chan int {
+	out := make(chan int)
+	go func() {
+		for c0 != nil || c1 != nil {
+			select {
+			case v0, ok0 := <-c0:
+				if !ok0 {
+					c0 = nil
+				} else {
+					out <- v0
+				}
+			case v1, ok1 := <-c1:
+				if !ok1 {
+					c1 = nil
+				} else {
+					out <- v1
+				}
+			}
+		}
+		close(out)
+	}()
+	return out
+}
+
+// deriveJoinContextSlice listens on all channels in the input slice and sends all their results on the output channel,
+// until all channels are closed or the context is done.
+func deriveJoinContextSlice(ctx context.Context, in []<-chan int) <-chan int {
+	out := make(chan int)
+	go func() {
+		wait := sync.WaitGroup{}
+		forward := func(c <-chan int) {
+			defer wait.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case v, ok := <-c:
+					if !ok {
+						return
+					}
+					select {
+					case out <- v:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+		for _, c := range in {
+			wait.Add(1)
+			go forward(c)
+		}
+		wait.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// deriveJoinContextVariadic listens on all input channels c0 and c1, and sends all their results onto the single output channel,
+// until all channels are closed or the context is done.
+func deriveJoinContextVariadic(ctx context.Context, c0 chan int, c1 chan int) <-chan int {
+	out := make(chan int)
+	go func() {
+		wait := sync.WaitGroup{}
+		forward := func(c <-chan int) {
+			defer wait.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case v, ok := <-c:
+					if !ok {
+						return
+					}
+					select {
+					case out <- v:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+		wait.Add(2)
+		go forward(c0)
+		go forward(c1)
+		wait.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// deriveJoinContextChannels listens on all channels resulting from the input channel and sends all their results on the output channel,
+// until all channels are closed or the context is done.
+func deriveJoinContextChannels(ctx context.Context, in chan (<-chan int)) <-chan int {
+	out := make(chan int)
+	go func() {
+		wait := sync.WaitGroup{}
+		forward := func(c <-chan int) {
+			defer wait.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case v, ok := <-c:
+					if !ok {
+						return
+					}
+					select {
+					case out <- v:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	loop:
+		for {
+			select {
+			case <-ctx.Done():
+				break loop
+			case c, ok := <-in:
+				if !ok {
+					break loop
+				}
+				wait.Add(1)
+				go forward(c)
+			}
+		}
+		wait.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// deriveHashPtrToGenericPair returns the hash of the object.
+func deriveHashPtrToGenericPair(object *GenericPair[string, []int]) uint64 {
+	if object == nil {
+		return 0
+	}
+	h := uint64(17)
+	h = 31*h + deriveHash_s(object.Key)
+	h = 31*h + deriveHashSliceOfint(object.Value)
+	return h
+}
+
+// deriveHashSliceOfint returns the hash of the object.
+func deriveHashSliceOfint(object []int) uint64 {
+	if object == nil {
+		return 0
+	}
+	h := uint64(17)
+	for i := 0; i < len(object); i++ {
+		h = 31*h + uint64(object[i])
+	}
+	return h
+}
+
+// deriveHashMapOfintToint returns the hash of the object.
+func deriveHashMapOfintToint(object map[int]int) uint64 {
+	if object == nil {
+		return 0
+	}
+	h := uint64(17)
+	for _, k := range deriveSortedInts(deriveKeys_19(object)) {
+		h = 31*h + uint64(k)
+		h = 31*h + uint64(object[k])
+	}
+	return h
+}
+
+// deriveHashPtrToint returns the hash of the object.
+func deriveHashPtrToint(object *int) uint64 {
+	if object == nil {
+		return 0
+	}
+	return (31 * 17) + uint64(*object)
+}
+
+// deriveHashPtrToSliceOfint returns the hash of the object.
+func deriveHashPtrToSliceOfint(object *[]int) uint64 {
+	if object == nil {
+		return 0
+	}
+	return (31 * 17) + deriveHashSliceOfint(*object)
+}
+
+// deriveHashPtrToArray10Ofint returns the hash of the object.
+func deriveHashPtrToArray10Ofint(object *[10]int) uint64 {
+	if object == nil {
+		return 0
+	}
+	return (31 * 17) + deriveHash_141(*object)
+}
+
+// deriveHashPtrToMapOfintToint returns the hash of the object.
+func deriveHashPtrToMapOfintToint(object *map[int]int) uint64 {
+	if object == nil {
+		return 0
+	}
+	return (31 * 17) + deriveHashMapOfintToint(*object)
+}
+
+// deriveHash1 returns the hash of the object.
+func deriveHash1(object BuiltInTypes) uint64 {
+	return deriveHash_139(&object)
+}
+
+// deriveHash_138 returns the hash of the object.
+func deriveHash_138(object struct {
+	Param0 []int
+	Param1 int
+}) uint64 {
+	h := uint64(17)
+	h = 31*h + deriveHashSliceOfint(object.Param0)
+	h = 31*h + uint64(object.Param1)
+	return h
+}
+
+// deriveHash_139 returns the hash of the object.
+func deriveHash_139(object *BuiltInTypes) uint64 {
+	if object == nil {
+		return 0
+	}
+	h := uint64(17)
+	h = 31*h + deriveHash_b(object.Bool)
+	h = 31*h + uint64(object.Byte)
+	h = 31*h + (31 * ((31 * 17) + math.Float64bits(real(object.Complex128)))) + math.Float64bits(imag(object.Complex128))
+	h = 31*h + (31 * ((31 * 17) + uint64(math.Float32bits(real(object.Complex64))))) + uint64(math.Float32bits(imag(object.Complex64)))
+	h = 31*h + math.Float64bits(object.Float64)
+	h = 31*h + uint64(math.Float32bits(object.Float32))
+	h = 31*h + uint64(object.Int)
+	h = 31*h + uint64(object.Int16)
+	h = 31*h + uint64(object.Int32)
+	h = 31*h + uint64(object.Int64)
+	h = 31*h + uint64(object.Int8)
+	h = 31*h + uint64(object.Rune)
+	h = 31*h + deriveHash_s(object.String)
+	h = 31*h + uint64(object.Uint)
+	h = 31*h + uint64(object.Uint16)
+	h = 31*h + uint64(object.Uint32)
+	h = 31*h + object.Uint64
+	h = 31*h + uint64(object.Uint8)
+	h = 31*h + uint64(object.UintPtr)
+	return h
+}
+
+// deriveHash_140 returns the hash of the object.
+func deriveHash_140(object struct {
+	Param0 []int
+}) uint64 {
+	h := uint64(17)
+	h = 31*h + deriveHashSliceOfint(object.Param0)
+	return h
+}
+
+// deriveFmapForKeys returns a list where each element of the input list has been morphed by the input function.
+func deriveFmapForKeys(f func(int) string, list []int) []string {
+	out := make([]string, len(list))
+	for i, elem := range list {
+		out[i] = f(elem)
+	}
+	return out
+}
+
+// deriveFmap returns a list where each element of the input list has been morphed by the input function.
+func deriveFmap(f func(int) int, list []int) []int {
+	out := make([]int, len(list))
+	for i, elem := range list {
+		out[i] = f(elem)
+	}
+	return out
+}
+
+// deriveFmapString morphs a string into list by apply the input function to each rune.
+func deriveFmapString(f func(rune) bool, ss string) []bool {
+	out := make([]bool, len([]rune(ss)))
+	for i, elem := range ss {
+		out[i] = f(elem)
+	}
+	return out
+}
+
+// deriveFmapError returns an error if g returns one, otherwise it applies f to g's result and returns it.
+func deriveFmapError(f func(int) int64, g func() (int, error)) (int64, error) {
+	v, err := g()
+	if err != nil {
+		return 0, err
+	}
+	return f(v), nil
+}
+
+// deriveFmapEE returns an error if g returns one, otherwise it applies f to g's result and returns it.
+func deriveFmapEE(f func(string) (int, error), g func() (string, error)) (func() (int, error), error) {
+	v, err := g()
+	if err != nil {
+		return nil, err
+	}
+	return deriveTuple(f(v)), nil
+}
+
+// deriveFmapPrint returns an error if g returns one, otherwise it applies f to g's result.
+func deriveFmapPrint(f func(string), g func() (string, error)) error {
+	v, err := g()
+	if err != nil {
+		return err
+	}
+	f(v)
+	return nil
+}
+
+// deriveFmapMore returns an error if g returns one, otherwise it applies f to g's result and returns it.
+func deriveFmapMore(f func(string) (int, string, error), g func() (string, error)) (func() (int, string, error), error) {
+	v, err := g()
+	if err != nil {
+		return nil, err
+	}
+	return deriveTuple_(f(v)), nil
+}
+
+// deriveFmapChan returns an output channel where the items are the result of the input function being applied to the items on the input channel.
+func deriveFmapChan(f func(string) int, in <-chan string) <-chan int {
+	out := make(chan int, cap(in))
+	go func() {
+		for a := range in {
+			b := f(a)
+			out <- b
+		}
+		close(out)
+	}()
+	return out
+}
+
+// deriveFmapSS returns a list where each element of the input list has been morphed by the input function.
+func deriveFmapSS(f func(string) []string, list []string) [][]string {
+	out := make([][]string, len(list))
+	for i, elem := range list {
+		out[i] = f(elem)
+	}
+	return out
+}
+
+// deriveFmapEE64 returns an error if g returns one, otherwise it applies f to g's result and returns it.
+func deriveFmapEE64(f func(string) (int64, error), g func() (string, error)) (func() (int64, error), error) {
+	v, err := g()
+	if err != nil {
+		return nil, err
+	}
+	return deriveTuple_i(f(v)), nil
+}
+
+// deriveFmapChanChan returns an output channel where the items are the result of the input function being applied to the items on the input channel.
+func deriveFmapChanChan(f func(string) <-chan int, in <-chan string) <-chan (<-chan int) {
+	out := make(chan (<-chan int), cap(in))
+	go func() {
+		for a := range in {
+			b := f(a)
+			out <- b
+		}
+		close(out)
+	}()
+	return out
+}
+
+// deriveFlipMarshal returns the input function, but where first two parameters are flipped.
+func deriveFlipMarshal(f func(data []byte, v any) error) func(v any, data []byte) error {
+	return func(v any, data []byte) error {
+		return f(data, v)
+	}
+}
+
+// deriveFlip3 returns the input function, but where first two parameters are flipped.
+func deriveFlip3(f func(a int, b string, c bool) string) func(b string, a int, c bool) string {
+	return func(b string, a int, c bool) string {
+		return f(a, b, c)
+	}
+}
+
+// deriveDiff returns the differences between this and that, each prefixed by the path to the difference.
+func deriveDiff(this, that *Diffable) []string {
+	diffs := deriveDiff_(this, that, "", nil)
+	for i := range diffs {
+		diffs[i] = strings.TrimPrefix(diffs[i], ": ")
+	}
+	return diffs
+}
+
+// deriveDiffInt returns the differences between this and that, each prefixed by the path to the difference.
+func deriveDiffInt(this, that int) []string {
+	diffs := deriveDiff_i(this, that, "", nil)
+	for i := range diffs {
+		diffs[i] = strings.TrimPrefix(diffs[i], ": ")
+	}
+	return diffs
+}
+
+// deriveTee duplicates messages received on c to all n output channels.
+func deriveTee(n int, c chan int) []<-chan int {
+	if n < 1 {
+		n = 1
+	}
+	cs := make([]chan int, n)
+	outs := make([]<-chan int, n)
+	for i := range cs {
+		cs[i] = make(chan int, cap(c))
+		outs[i] = cs[i]
+	}
+	go func() {
+		for v := range c {
+			for _, o := range cs {
+				o <- v
+			}
+		}
+		for _, o := range cs {
+			close(o)
+		}
+	}()
+	return outs
+}
+
+// deriveSetInt64s returns the input list as a map with the items of the list as the keys of the map.
+func deriveSetInt64s(list []int64) map[int64]struct{} {
+	set := make(map[int64]struct{}, len(list))
+	for _, v := range list {
+		set[v] = struct{}{}
+	}
+	return set
+}
+
+// deriveMinInt64s returns the minimum value from the list, or the default value if the list is empty.
+func deriveMinInt64s(list []int64, def int64) int64 {
+	if len(list) == 0 {
+		return def
+	}
+	m := list[0]
+	list = list[1:]
+	for i, v := range list {
+		if v < m {
+			m = list[i]
+		}
+	}
+	return m
+}
+
+// deriveMinInt returns the mimimum of the two input values.
+func deriveMinInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// deriveMinStructs returns the minimum value from the list, or the default value if the list is empty.
+func deriveMinStructs(list []*BuiltInTypes, def *BuiltInTypes) *BuiltInTypes {
+	if len(list) == 0 {
+		return def
+	}
+	m := list[0]
+	list = list[1:]
+	for i, v := range list {
+		if deriveCompare_141(v, m) < 0 {
+			m = list[i]
+		}
+	}
+	return m
+}
+
+// deriveMemGet returns a memoized version of the input function.
+func deriveMemGet(f func() *BuiltInTypes) func() *BuiltInTypes {
+	memoized := false
+	var res0 *BuiltInTypes
+	return func() *BuiltInTypes {
+		if !memoized {
+			res0 = f()
+			memoized = true
+		}
+		return res0
+	}
+}
+
+// deriveMemInc returns a memoized version of the input function.
+func deriveMemInc(f func(n int) int) func(n int) int {
+	m := make(map[int]int)
+	return func(param0 int) int {
+		if v, ok := m[param0]; ok {
+			return v
+		}
+		v := f(param0)
+		m[param0] = v
+		return v
+	}
+}
+
+// deriveMemIncTo returns a memoized version of the input function.
+func deriveMemIncTo(f func(a Adder) int) func(a Adder) int {
+	m := make(map[Adder]int)
+	return func(param0 Adder) int {
+		if v, ok := m[param0]; ok {
+			return v
+		}
+		v := f(param0)
+		m[param0] = v
+		return v
+	}
+}
+
+// deriveMemAdd returns a memoized version of the input function.
+func deriveMemAdd(f func(a int, b int) int) func(a int, b int) int {
+	type input struct {
+		Param0 int
+		Param1 int
+	}
+	m := make(map[input]int)
+	return func(param0 int, param1 int) int {
+		in := input{param0, param1}
+		if v, ok := m[in]; ok {
+			return v
+		}
+		v := f(param0, param1)
+		m[in] = v
+		return v
+	}
+}
+
+// deriveMemAddTo returns a memoized version of the input function.
+func deriveMemAddTo(f func(a Adder, b int) int) func(a Adder, b int) int {
+	type input struct {
+		Param0 Adder
+		Param1 int
+	}
+	m := make(map[input]int)
+	return func(param0 Adder, param1 int) int {
+		in := input{param0, param1}
+		if v, ok := m[in]; ok {
+			return v
+		}
+		v := f(param0, param1)
+		m[in] = v
+		return v
+	}
+}
+
+// deriveMemSet returns a memoized version of the input function.
+func deriveMemSet(f func(a *BuiltInTypes, b int) *BuiltInTypes) func(a *BuiltInTypes, b int) *BuiltInTypes {
+	type input struct {
+		Param0 *BuiltInTypes
+		Param1 int
+	}
+	type mem struct {
+		in  input
+		out *BuiltInTypes
+	}
+	m := make(map[uint64][]mem)
+	return func(param0 *BuiltInTypes, param1 int) *BuiltInTypes {
+		in := input{param0, param1}
+		h := deriveHash_142(in)
+		vs, ok := m[h]
+		if ok {
+			for _, v := range vs {
+				if deriveEqual_103(v.in, in) {
+					return v.out
+				}
+			}
+		}
+		res0 := f(param0, param1)
+		m[h] = append(m[h], mem{in, res0})
+		return res0
+	}
+}
+
+// deriveMemSetErr returns a memoized version of the input function.
+func deriveMemSetErr(f func(a *BuiltInTypes, b int) (*BuiltInTypes, error)) func(a *BuiltInTypes, b int) (*BuiltInTypes, error) {
+	type input struct {
+		Param0 *BuiltInTypes
+		Param1 int
+	}
+	type output struct {
+		Res0 *BuiltInTypes
+		Res1 error
+	}
+	type mem struct {
+		in  input
+		out output
+	}
+	m := make(map[uint64][]mem)
+	return func(param0 *BuiltInTypes, param1 int) (*BuiltInTypes, error) {
+		in := input{param0, param1}
+		h := deriveHash_142(in)
+		vs, ok := m[h]
+		if ok {
+			for _, v := range vs {
+				if deriveEqual_103(v.in, in) {
+					return v.out.Res0, v.out.Res1
+				}
+			}
+		}
+		res0, res1 := f(param0, param1)
+		m[h] = append(m[h], mem{in, output{res0, res1}})
+		return res0, res1
+	}
+}
+
+// deriveMaxInt64s returns the maximum value from the input list and the default value, if the list is empty.
+func deriveMaxInt64s(list []int64, def int64) int64 {
+	if len(list) == 0 {
+		return def
+	}
+	m := list[0]
+	list = list[1:]
+	for i, v := range list {
+		if v > m {
+			m = list[i]
+		}
+	}
+	return m
+}
+
+// deriveMaxInt returns the maximum of the two input values.
+func deriveMaxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// deriveMaxStructs returns the maximum value from the input list and the default value, if the list is empty.
+func deriveMaxStructs(list []*BuiltInTypes, def *BuiltInTypes) *BuiltInTypes {
+	if len(list) == 0 {
+		return def
+	}
+	m := list[0]
+	list = list[1:]
+	for i, v := range list {
+		if deriveCompare_141(v, m) > 0 {
+			m = list[i]
+		}
+	}
+	return m
+}
+
+// deriveDup duplicates messages received on c to both c1 and c2.
+func deriveDup(c chan int) (c1, c2 <-chan int) {
+	cc1, cc2 := make(chan int, cap(c)), make(chan int, cap(c))
+	go func() {
+		for v := range c {
+			cc1 <- v
+			cc2 <- v
+		}
+		close(cc1)
+		close(cc2)
+	}()
+	return cc1, cc2
+}
+
+// deriveAny reports whether the predicate returns true for any of the elements in the given slice.
+func deriveAny(pred func(int) bool, list []int) bool {
+	for _, elem := range list {
+		if pred(elem) {
+			return true
+		}
+	}
+	return false
+}
+
+// deriveAnyEqualCurry reports whether the predicate returns true for any of the elements in the given slice.
+func deriveAnyEqualCurry(pred func(*BuiltInTypes) bool, list []*BuiltInTypes) bool {
+	for _, elem := range list {
+		if pred(elem) {
+			return true
+		}
+	}
+	return false
+}
+
+// deriveAll reports whether the predicate returns true for all of the elements in the given slice.
+func deriveAll(predicate func(int) bool, slice []int) bool {
+	for _, elem := range slice {
+		if !predicate(elem) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveDo concurrently executes the input functions f0 and f1 and when all functions are finished the first error, if any, and results are returned.
+func deriveDo(f0 func() (string, error), f1 func() (int, error)) (string, int, error) {
+	errChan := make(chan error)
+	var v0 string
+	go func() {
+		var v0err error
+		v0, v0err = f0()
+		errChan <- v0err
+	}()
+	var v1 int
+	go func() {
+		var v1err error
+		v1, v1err = f1()
+		errChan <- v1err
+	}()
+	var err error
+	for i := 0; i < 2; i++ {
+		errc := <-errChan
+		if errc != nil {
+			if err == nil {
+				err = errc
+			}
+		}
+	}
+	return v0, v1, err
+}
+
+// deriveDoContext concurrently executes the input functions f0 and f1 with a context, which is canceled as soon as one of them returns an error,
+// and when all functions are finished the first error, if any, and results are returned.
+func deriveDoContext(ctx context.Context, f0 func(context.Context) (string, error), f1 func(context.Context) (int, error)) (string, int, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	errChan := make(chan error)
+	var v0 string
+	go func() {
+		var v0err error
+		v0, v0err = f0(ctx)
+		errChan <- v0err
+	}()
+	var v1 int
+	go func() {
+		var v1err error
+		v1, v1err = f1(ctx)
+		errChan <- v1err
+	}()
+	var err error
+	for i := 0; i < 2; i++ {
+		errc := <-errChan
+		if errc != nil && err == nil {
+			err = errc
+			cancel()
+		}
+	}
+	return v0, v1, err
+}
+
+// deriveDoBounded concurrently executes the input functions f0, f1 and f2 with a context, which is canceled as soon as one of them returns an error,
+// where at most n functions are executed at the same time,
+// and when all functions are finished the first error, if any, and results are returned.
+func deriveDoBounded(ctx context.Context, n int, f0 func(context.Context) (int, error), f1 func(context.Context) (int, error), f2 func(context.Context) (int, error)) (int, int, int, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	errChan := make(chan error)
+	if n < 1 {
+		n = 3
+	}
+	sem := make(chan struct{}, n)
+	var v0 int
+	go func() {
+		select {
+		case sem <- struct{}{}:
+			defer func() { <-sem }()
+		case <-ctx.Done():
+			errChan <- ctx.Err()
+			return
+		}
+		var v0err error
+		v0, v0err = f0(ctx)
+		errChan <- v0err
+	}()
+	var v1 int
+	go func() {
+		select {
+		case sem <- struct{}{}:
+			defer func() { <-sem }()
+		case <-ctx.Done():
+			errChan <- ctx.Err()
+			return
+		}
+		var v1err error
+		v1, v1err = f1(ctx)
+		errChan <- v1err
+	}()
+	var v2 int
+	go func() {
+		select {
+		case sem <- struct{}{}:
+			defer func() { <-sem }()
+		case <-ctx.Done():
+			errChan <- ctx.Err()
+			return
+		}
+		var v2err error
+		v2, v2err = f2(ctx)
+		errChan <- v2err
+	}()
+	var err error
+	for i := 0; i < 3; i++ {
+		errc := <-errChan
+		if errc != nil && err == nil {
+			err = errc
+			cancel()
+		}
+	}
+	return v0, v1, v2, err
+}
+
+// deriveDeepCopyGraph_1 recursively copies the contents of src into dst.
+func deriveDeepCopyGraph_1(dst, src *GraphNode, visited map[interface{}]interface{}) {
+	dst.Name = src.Name
+	if src.Edges == nil {
+		dst.Edges = nil
+	} else {
+		if dst.Edges != nil {
+			if len(src.Edges) > len(dst.Edges) {
+				if cap(dst.Edges) >= len(src.Edges) {
+					dst.Edges = (dst.Edges)[:len(src.Edges)]
+				} else {
+					dst.Edges = make([]*GraphNode, len(src.Edges))
+				}
+			} else if len(src.Edges) < len(dst.Edges) {
+				dst.Edges = (dst.Edges)[:len(src.Edges)]
+			}
+		} else {
+			dst.Edges = make([]*GraphNode, len(src.Edges))
+		}
+		deriveDeepCopyGraph_2(dst.Edges, src.Edges, visited)
+	}
+	if src.Labels != nil {
+		dst.Labels = make(map[string]*GraphNode, len(src.Labels))
+		deriveDeepCopyGraph_3(dst.Labels, src.Labels, visited)
+	} else {
+		dst.Labels = nil
+	}
+	dst.Shape = deriveDeepCopyGraph_S(src.Shape, visited)
+}
+
+// deriveEqualGraph_1 returns whether this and that are equal.
+func deriveEqualGraph_1(this, that *DoublyLinked, visited map[[2]interface{}]bool) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if visited[[2]interface{}{this, that}] {
+		return true
+	}
+	visited[[2]interface{}{this, that}] = true
+	return this.Value == that.Value &&
+		deriveEqualGraph_1(this.Prev, that.Prev, visited) &&
+		deriveEqualGraph_1(this.Next, that.Next, visited)
+}
+
+// deriveEqualGraph_2 returns whether this and that are equal.
+func deriveEqualGraph_2(this, that *GraphNode, visited map[[2]interface{}]bool) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if visited[[2]interface{}{this, that}] {
+		return true
+	}
+	visited[[2]interface{}{this, that}] = true
+	return this.Name == that.Name &&
+		deriveEqualGraph_3(this.Edges, that.Edges, visited) &&
+		deriveEqualGraph_4(this.Labels, that.Labels, visited) &&
+		deriveEqualGraph_S(this.Shape, that.Shape, visited)
+}
+
+// deriveHashSeed_ writes the object to the FNV-1a 64 state h and returns the new state.
+func deriveHashSeed_(object string, h uint64) uint64 {
+	h = deriveHashSeed_u(uint64(len(object)), h)
+	for i := 0; i < len(object); i++ {
+		h = (h ^ uint64(object[i])) * 1099511628211
+	}
+	return h
+}
+
+// deriveHashSeed_1 writes the object to the FNV-1a 64 state h and returns the new state.
+func deriveHashSeed_1(object *StableKey, h uint64) uint64 {
+	if object == nil {
+		return (h ^ 0) * 1099511628211
+	}
+	h = (h ^ 1) * 1099511628211
+	h = deriveHashSeed_(string(object.Name), h)
+	h = deriveHashSeed_u(uint64(object.Version), h)
+	h = deriveHashSeed_u(math.Float64bits(float64(object.Ratio)), h)
+	h = deriveHashSeed_b(bool(object.Enabled), h)
+	h = deriveHashSeed_3(object.Tags, h)
+	h = deriveHashSeed_4(object.Attrs, h)
+	h = deriveHashSeed_1(object.Parent, h)
+	h = deriveHashSeed_S(object.Shape, h)
+	return h
+}
+
+// deriveHashSeed_2 writes the object to the FNV-1a 64 state h and returns the new state.
+func deriveHashSeed_2(object *SkippedKey, h uint64) uint64 {
+	if object == nil {
+		return (h ^ 0) * 1099511628211
+	}
+	h = (h ^ 1) * 1099511628211
+	h = deriveHashSeed_(string(object.Name), h)
+	return h
+}
+
+// deriveHashSeed_T writes the object to the FNV-1a 64 state h and returns the new state.
+func deriveHashSeed_T(object time.Time, h uint64) uint64 {
+	data, err := object.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	h = deriveHashSeed_u(uint64(len(data)), h)
+	for i := 0; i < len(data); i++ {
+		h = (h ^ uint64(data[i])) * 1099511628211
+	}
+	return h
+}
+
+// deriveDeepCopy_51 recursively copies the contents of src into dst.
+func deriveDeepCopy_51(dst, src map[string]string) {
+	for src_key, src_value := range src {
+		dst[src_key] = src_value
+	}
+}
+
+// deriveDeepCopy_Sh returns a recursive copy of src.
+func deriveDeepCopy_Sh(src Shape) Shape {
+	if src == nil {
+		return nil
+	}
+	switch src := src.(type) {
+	case *Circle:
+		var dst *Circle
+		if src == nil {
+			dst = nil
+		} else {
+			dst = new(Circle)
+			*dst = *src
+		}
+		return dst
+	case Square:
+		var dst Square
+		field := new(Square)
+		deriveDeepCopy_62(field, &src)
+		dst = *field
+		return dst
+	case *Square:
+		var dst *Square
+		if src == nil {
+			dst = nil
+		} else {
+			dst = new(Square)
+			deriveDeepCopy_62(dst, src)
+		}
+		return dst
+	}
+	if typ := reflect.TypeOf(src); typ.Kind() == reflect.Ptr {
+		if cp := reflect.ValueOf(src).MethodByName("DeepCopy"); cp.IsValid() && cp.Type().NumIn() == 1 && cp.Type().In(0) == typ {
+			dst := reflect.New(typ.Elem())
+			cp.Call([]reflect.Value{dst})
+			return dst.Interface().(Shape)
+		}
+	}
+	return src
+}
+
+// deriveDeepCopy_52 recursively copies the contents of src into dst.
+func deriveDeepCopy_52(dst, src *float64) {
+	*dst = *src
+}
+
+// deriveDeepCopy_53 recursively copies the contents of src into dst.
+func deriveDeepCopy_53(dst, src []string) {
+	copy(dst, src)
+}
+
+// deriveDeepCopy_54 recursively copies the contents of src into dst.
+func deriveDeepCopy_54(dst, src []int) {
+	copy(dst, src)
+}
+
+// deriveDeepCopy_55 recursively copies the contents of src into dst.
+func deriveDeepCopy_55(dst, src map[int]int) {
+	for src_key, src_value := range src {
+		dst[src_key] = src_value
+	}
+}
+
+// deriveDeepCopy_56 recursively copies the contents of src into dst.
+func deriveDeepCopy_56(dst, src *int) {
+	*dst = *src
+}
+
+// deriveDeepCopy_57 recursively copies the contents of src into dst.
+func deriveDeepCopy_57(dst, src *[]int) {
+	if *src == nil {
+		*dst = nil
+	} else {
+		if *dst != nil {
+			if len(*src) > len(*dst) {
+				if cap(*dst) >= len(*src) {
+					*dst = (*dst)[:len(*src)]
+				} else {
+					*dst = make([]int, len(*src))
+				}
+			} else if len(*src) < len(*dst) {
+				*dst = (*dst)[:len(*src)]
+			}
+		} else {
+			*dst = make([]int, len(*src))
+		}
+		copy(*dst, *src)
+	}
+}
+
+// deriveDeepCopy_58 recursively copies the contents of src into dst.
+func deriveDeepCopy_58(dst, src *[10]int) {
+	*dst = *src
+}
+
+// deriveDeepCopy_59 recursively copies the contents of src into dst.
+func deriveDeepCopy_59(dst, src *map[int]int) {
+	if *src != nil {
+		*dst = make(map[int]int, len(*src))
+		deriveDeepCopy_55(*dst, *src)
+	} else {
+		*dst = nil
+	}
+}
+
+// deriveDeepCopy_60 recursively copies the contents of src into dst.
+func deriveDeepCopy_60(dst, src *BuiltInTypes) {
+	dst.Bool = src.Bool
+	dst.Byte = src.Byte
+	dst.Complex128 = src.Complex128
+	dst.Complex64 = src.Complex64
+	dst.Float64 = src.Float64
+	dst.Float32 = src.Float32
+	dst.Int = src.Int
+	dst.Int16 = src.Int16
+	dst.Int32 = src.Int32
+	dst.Int64 = src.Int64
+	dst.Int8 = src.Int8
+	dst.Rune = src.Rune
+	dst.String = src.String
+	dst.Uint = src.Uint
+	dst.Uint16 = src.Uint16
+	dst.Uint32 = src.Uint32
+	dst.Uint64 = src.Uint64
+	dst.Uint8 = src.Uint8
+	dst.UintPtr = src.UintPtr
+}
+
+// deriveDeepCopy_61 recursively copies the contents of src into dst.
+func deriveDeepCopy_61[T ~int | ~string](dst, src *GenericList[T]) {
+	dst.Value = src.Value
+	if src.Next == nil {
+		dst.Next = nil
+	} else {
+		dst.Next = new(GenericList[T])
+		deriveDeepCopy_61(dst.Next, src.Next)
+	}
+}
+
+// deriveIsEmpty_A returns whether this is the zero value of its type, where empty slices and maps are also zero.
+func deriveIsEmpty_A(this Address) bool {
+	return this.Street == "" &&
+		this.City == ""
+}
+
+// deriveIsEmpty_2 returns whether this is the zero value of its type, where empty slices and maps are also zero.
+func deriveIsEmpty_2(this [2][]int) bool {
+	for _, v := range this {
+		if !(len(v) == 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveIsEmpty_P returns whether this is the zero value of its type, where empty slices and maps are also zero.
+func deriveIsEmpty_P(this extra.PrivateFieldAndNoEqualMethod) bool {
+	thisv := reflect.Indirect(reflect.ValueOf(&this))
+	return *(*int64)(unsafe.Pointer(thisv.FieldByName("number").UnsafeAddr())) == 0 &&
+		len(*(*[]int64)(unsafe.Pointer(thisv.FieldByName("numbers").UnsafeAddr()))) == 0 &&
+		*(**int64)(unsafe.Pointer(thisv.FieldByName("ptr").UnsafeAddr())) == nil &&
+		len(*(*[]*int64)(unsafe.Pointer(thisv.FieldByName("numberpts").UnsafeAddr()))) == 0 &&
+		*(**extra.StructWithoutEqualMethod)(unsafe.Pointer(thisv.FieldByName("strct").UnsafeAddr())) == nil
+}
+
+// deriveCompare_int4 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_int4(this, that int) int {
+	if this != that {
+		if this < that {
+			return -1
+		} else {
+			return 1
+		}
+	}
+	return 0
+}
+
+// deriveCompare_141 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_141(this, that *BuiltInTypes) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := deriveCompare_b(this.Bool, that.Bool); c != 0 {
+		return c
+	}
+	if c := deriveCompare_by(this.Byte, that.Byte); c != 0 {
+		return c
+	}
+	if c := deriveCompareComplex64(this.Complex128, that.Complex128); c != 0 {
+		return c
+	}
+	if c := deriveCompareComplex32(this.Complex64, that.Complex64); c != 0 {
+		return c
+	}
+	if c := deriveCompare_flo(this.Float64, that.Float64); c != 0 {
+		return c
+	}
+	if c := deriveCompare_floa(this.Float32, that.Float32); c != 0 {
+		return c
+	}
+	if c := deriveCompare_int4(this.Int, that.Int); c != 0 {
+		return c
+	}
+	if c := deriveCompare_int1(this.Int16, that.Int16); c != 0 {
+		return c
+	}
+	if c := deriveCompare_int3(this.Int32, that.Int32); c != 0 {
+		return c
+	}
+	if c := deriveCompare_int64(this.Int64, that.Int64); c != 0 {
+		return c
+	}
+	if c := deriveCompare_int85(this.Int8, that.Int8); c != 0 {
+		return c
+	}
+	if c := deriveCompare_int3(this.Rune, that.Rune); c != 0 {
+		return c
+	}
+	if c := strings.Compare(this.String, that.String); c != 0 {
+		return c
+	}
+	if c := deriveCompare_uint5(this.Uint, that.Uint); c != 0 {
+		return c
+	}
+	if c := deriveCompare_uint1(this.Uint16, that.Uint16); c != 0 {
+		return c
+	}
+	if c := deriveCompare_uint3(this.Uint32, that.Uint32); c != 0 {
+		return c
+	}
+	if c := deriveCompare_uint6(this.Uint64, that.Uint64); c != 0 {
+		return c
+	}
+	if c := deriveCompare_by(this.Uint8, that.Uint8); c != 0 {
+		return c
+	}
+	if c := deriveCompare_142(this.UintPtr, that.UintPtr); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// deriveString_Lo appends a compact and human readable representation of this to buf.
+func deriveString_Lo(this Loggable, buf []byte) []byte {
+	buf = append(buf, "Loggable{"...)
+	n := len(buf)
+	if this.Name != "" {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Name: "...)
+		buf = strconv.AppendQuote(buf, this.Name)
+	}
+	if len(buf) > n {
+		buf = append(buf, ", "...)
+	}
+	buf = append(buf, "Password: "...)
+	buf = append(buf, "<redacted>"...)
+	if this.Count != 0 {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Count: "...)
+		buf = strconv.AppendInt(buf, int64(this.Count), 10)
+	}
+	if this.Ratio != 0 {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Ratio: "...)
+		buf = strconv.AppendFloat(buf, float64(this.Ratio), 'g', -1, 32)
+	}
+	if this.Enabled {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Enabled: "...)
+		buf = strconv.AppendBool(buf, this.Enabled)
+	}
+	if len(this.Tags) != 0 {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Tags: "...)
+		buf = deriveString_5(this.Tags, buf)
+	}
+	if len(this.Scores) != 0 {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Scores: "...)
+		buf = deriveString_6(this.Scores, buf)
+	}
+	if this.Parent != nil {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Parent: "...)
+		if this.Parent == nil {
+			buf = append(buf, "nil"...)
+		} else {
+			buf = append(buf, this.Parent.String()...)
+		}
+	}
+	if this.Shape != nil {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Shape: "...)
+		if this.Shape == nil {
+			buf = append(buf, "nil"...)
+		} else {
+			buf = fmt.Appendf(buf, "%v", this.Shape)
+		}
+	}
+	if this.Err != nil {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Err: "...)
+		if this.Err == nil {
+			buf = append(buf, "nil"...)
+		} else {
+			buf = fmt.Appendf(buf, "%v", this.Err)
+		}
+	}
+	if this.Timeout != 0 {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Timeout: "...)
+		buf = append(buf, this.Timeout.String()...)
+	}
+	if !deriveIsEmpty_T(this.Created) {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Created: "...)
+		buf = append(buf, this.Created.String()...)
+	}
+	if !deriveIsEmpty_3(this.Pair) {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Pair: "...)
+		buf = deriveString_7(this.Pair, buf)
+	}
+	if this.Callback != nil {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "Callback: "...)
+		if this.Callback == nil {
+			buf = append(buf, "nil"...)
+		} else {
+			buf = append(buf, "<func>"...)
+		}
+	}
+	if this.internal != 0 {
+		if len(buf) > n {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, "internal: "...)
+		buf = strconv.AppendInt(buf, int64(this.internal), 10)
+	}
+	buf = append(buf, '}')
+	return buf
+}
+
+// deriveString_4 appends a compact and human readable representation of this to buf.
+func deriveString_4(this []int, buf []byte) []byte {
+	buf = append(buf, '[')
+	for i, v := range this {
+		if i == 10 {
+			buf = append(buf, ", ... "...)
+			buf = strconv.AppendInt(buf, int64(len(this)-i), 10)
+			buf = append(buf, " more"...)
+			break
+		}
+		if i > 0 {
+			buf = append(buf, ", "...)
+		}
+		buf = strconv.AppendInt(buf, int64(v), 10)
+	}
+	buf = append(buf, ']')
+	return buf
+}
+
+// deriveIsZero_ returns whether this is the zero value of its type.
+func deriveIsZero_(this Address) bool {
+	return this.Street == "" &&
+		this.City == ""
+}
+
+// deriveIsZero_1 returns whether this is the zero value of its type.
+func deriveIsZero_1(this [2][]int) bool {
+	for _, v := range this {
+		if !(v == nil) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveIsZero_P returns whether this is the zero value of its type.
+func deriveIsZero_P(this extra.PrivateFieldAndNoEqualMethod) bool {
+	thisv := reflect.Indirect(reflect.ValueOf(&this))
+	return *(*int64)(unsafe.Pointer(thisv.FieldByName("number").UnsafeAddr())) == 0 &&
+		*(*[]int64)(unsafe.Pointer(thisv.FieldByName("numbers").UnsafeAddr())) == nil &&
+		*(**int64)(unsafe.Pointer(thisv.FieldByName("ptr").UnsafeAddr())) == nil &&
+		*(*[]*int64)(unsafe.Pointer(thisv.FieldByName("numberpts").UnsafeAddr())) == nil &&
+		*(**extra.StructWithoutEqualMethod)(unsafe.Pointer(thisv.FieldByName("strct").UnsafeAddr())) == nil
+}
+
+// deriveTuple returns a function, which returns the input values.
+// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
+func deriveTuple(v0 int, v1 error) func() (int, error) {
+	return func() (int, error) {
+		return v0, v1
+	}
+}
+
+// deriveTuple_ returns a function, which returns the input values.
+// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
+func deriveTuple_(v0 int, v1 string, v2 error) func() (int, string, error) {
+	return func() (int, string, error) {
+		return v0, v1, v2
+	}
+}
+
+// deriveTuple_i returns a function, which returns the input values.
+// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
+func deriveTuple_i(v0 int64, v1 error) func() (int64, error) {
+	return func() (int64, error) {
+		return v0, v1
+	}
+}
+
+// deriveReset_ sets this to the zero value of its type, while keeping the capacity of slices and maps.
+func deriveReset_(this *Address) {
+	this.Street = ""
+	this.City = ""
+}
+
+// deriveReset_1 sets this to the zero value of its type, while keeping the capacity of slices and maps.
+func deriveReset_1(this *[2][]int) {
+	for i := range this {
+		clear((*this)[i])
+		(*this)[i] = (*this)[i][:0]
+	}
+}
+
+// deriveReset_2 sets this to the zero value of its type, while keeping the capacity of slices and maps.
+func deriveReset_2(this *extra.PrivateFieldAndNoEqualMethod) {
+	thisv := reflect.Indirect(reflect.ValueOf(this))
+	*(*int64)(unsafe.Pointer(thisv.FieldByName("number").UnsafeAddr())) = 0
+	clear(*(*[]int64)(unsafe.Pointer(thisv.FieldByName("numbers").UnsafeAddr())))
+	*(*[]int64)(unsafe.Pointer(thisv.FieldByName("numbers").UnsafeAddr())) = (*(*[]int64)(unsafe.Pointer(thisv.FieldByName("numbers").UnsafeAddr())))[:0]
+	*(**int64)(unsafe.Pointer(thisv.FieldByName("ptr").UnsafeAddr())) = nil
+	clear(*(*[]*int64)(unsafe.Pointer(thisv.FieldByName("numberpts").UnsafeAddr())))
+	*(*[]*int64)(unsafe.Pointer(thisv.FieldByName("numberpts").UnsafeAddr())) = (*(*[]*int64)(unsafe.Pointer(thisv.FieldByName("numberpts").UnsafeAddr())))[:0]
+	*(**extra.StructWithoutEqualMethod)(unsafe.Pointer(thisv.FieldByName("strct").UnsafeAddr())) = nil
+}
+
+// deriveMerge_ merges the fields of src, which are not zero, into dst.
+func deriveMerge_(dst, src *Address) {
+	if src.Street != "" {
+		dst.Street = src.Street
+	}
+	if src.City != "" {
+		dst.City = src.City
+	}
+}
+
+// deriveMerge_1 merges the fields of src, which are not zero, into dst.
+func deriveMerge_1(dst, src *[3]int) {
+	for i := range src {
+		if (*src)[i] != 0 {
+			(*dst)[i] = (*src)[i]
+		}
+	}
+}
+
+// deriveEqual_98 returns whether this and that are equal.
+func deriveEqual_98(this, that []int64) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if len(this) != len(that) {
+		return false
+	}
+	for i := 0; i < len(this); i++ {
+		if !(this[i] == that[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveEqual_99 returns whether this and that are equal.
+func deriveEqual_99(this, that []*int64) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if len(this) != len(that) {
+		return false
+	}
+	for i := 0; i < len(this); i++ {
+		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveEqual_100 returns whether this and that are equal.
+func deriveEqual_100(this, that *extra.StructWithoutEqualMethod) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Number == that.Number
+}
+
+// deriveEqual_101 returns whether this and that are equal.
+func deriveEqual_101[T comparable](this, that []*GenericTree[T]) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if len(this) != len(that) {
+		return false
+	}
+	for i := 0; i < len(this); i++ {
+		if !(this[i].Equal(that[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveEqual_102 returns whether this and that are equal.
+func deriveEqual_102(this, that []*vendortest.AVendoredObject) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if len(this) != len(that) {
+		return false
+	}
+	for i := 0; i < len(this); i++ {
+		if !(deriveEqual_104(this[i], that[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveEqual_103 returns whether this and that are equal.
+func deriveEqual_103(this, that struct {
+	Param0 *BuiltInTypes
+	Param1 int
+}) bool {
+	return this.Param0.Equal(that.Param0) &&
+		this.Param1 == that.Param1
+}
+
+// deriveWalk_ calls visit with every value of type Endpoint, which is nested inside this, until visit returns an error.
+func deriveWalk_(this *Endpoint, visit func(e *Endpoint) error) error {
+	if this == nil {
+		return nil
+	}
+	if err := visit(this); err != nil {
+		return err
+	}
+	if err := deriveWalk_1(&this.Fallback, visit); err != nil {
+		return err
+	}
+	return nil
+}
+
+// deriveWalk_1 calls visit with every value of type Endpoint, which is nested inside this, until visit returns an error.
+func deriveWalk_1(this **Endpoint, visit func(e *Endpoint) error) error {
+	if this == nil {
+		return nil
+	}
+	if err := deriveWalk_(*this, visit); err != nil {
+		return err
+	}
+	return nil
+}
+
+// deriveWalk_2 calls visit with every value of type Endpoint, which is nested inside this, until visit returns an error.
+func deriveWalk_2(this *[]Endpoint, visit func(e *Endpoint) error) error {
+	if this == nil {
+		return nil
+	}
+	for i := range *this {
+		if err := deriveWalk_(&(*this)[i], visit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deriveWalk_3 calls visit with every value of type Endpoint, which is nested inside this, until visit returns an error.
+func deriveWalk_3(this *map[string]*WalkRegion, visit func(e *Endpoint) error) error {
+	if this == nil {
+		return nil
+	}
+	for _, k := range deriveSortedStrings(deriveKeys_20(*this)) {
+		v := (*this)[k]
+		if err := deriveWalk_5(&v, visit); err != nil {
+			return err
+		}
+		(*this)[k] = v
+	}
+	return nil
+}
+
+// deriveWalk_4 calls visit with every value of type Endpoint, which is nested inside this, until visit returns an error.
+func deriveWalk_4(this *[2]*Endpoint, visit func(e *Endpoint) error) error {
+	if this == nil {
+		return nil
+	}
+	for i := range this {
+		if err := deriveWalk_1(&this[i], visit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deriveKeys_19 returns the keys of the input map as a slice.
+func deriveKeys_19(m map[int]int) []int {
+	keys := make([]int, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// deriveKeys_20 returns the keys of the input map as a slice.
+func deriveKeys_20(m map[string]*WalkRegion) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// deriveHash_s returns the hash of the object.
+func deriveHash_s(object string) uint64 {
+	var h uint64
+	for _, c := range object {
+		h = 31*h + uint64(c)
+	}
+	return h
+}
+
+// deriveHash_141 returns the hash of the object.
+func deriveHash_141(object [10]int) uint64 {
+	h := uint64(17)
+	for i := 0; i < len(object); i++ {
+		h = 31*h + uint64(object[i])
+	}
+	return h
+}
+
+// deriveHash_b returns the hash of the object.
+func deriveHash_b(object bool) uint64 {
+	if object {
+		return 1
+	}
+	return 0
+}
+
+// deriveHash_142 returns the hash of the object.
+func deriveHash_142(object struct {
+	Param0 *BuiltInTypes
+	Param1 int
+}) uint64 {
+	h := uint64(17)
+	h = 31*h + deriveHash_139(object.Param0)
+	h = 31*h + uint64(object.Param1)
+	return h
+}
+
+// deriveDiff_ appends the differences between this and that to diffs, where path is the path to this and that.
+func deriveDiff_(this, that *Diffable, path string, diffs []string) []string {
+	if this == nil || that == nil {
+		if (this == nil) != (that == nil) {
+			diffs = append(diffs, fmt.Sprintf("%s: %#v != %#v", path, this, that))
+		}
+		return diffs
+	}
+	if this.Name != that.Name {
+		diffs = append(diffs, fmt.Sprintf("%s.Name: %#v != %#v", path, this.Name, that.Name))
+	}
+	diffs = deriveDiff_1(this.Ages, that.Ages, path+".Ages", diffs)
+	diffs = deriveDiff_2(this.Tags, that.Tags, path+".Tags", diffs)
+	diffs = deriveDiff_(this.Parent, that.Parent, path+".Parent", diffs)
+	diffs = deriveDiff_S(this.Shape, that.Shape, path+".Shape", diffs)
+	if !this.When.Equal(that.When) {
+		diffs = append(diffs, fmt.Sprintf("%s.When: %#v != %#v", path, this.When, that.When))
+	}
+	diffs = deriveDiff_3(this.Pair, that.Pair, path+".Pair", diffs)
+	diffs = deriveDiff_4(this.Private, that.Private, path+".Private", diffs)
+	return diffs
+}
+
+// deriveDiff_i appends the differences between this and that to diffs, where path is the path to this and that.
+func deriveDiff_i(this, that int, path string, diffs []string) []string {
+	if this != that {
+		diffs = append(diffs, fmt.Sprintf("%s: %#v != %#v", path, this, that))
+	}
+	return diffs
+}
+
+// deriveDeepCopyGraph_2 recursively copies the contents of src into dst.
+func deriveDeepCopyGraph_2(dst, src []*GraphNode, visited map[interface{}]interface{}) {
+	for src_i, src_value := range src {
+		if src_value == nil {
+			dst[src_i] = nil
+		} else if v, ok := visited[src_value]; ok {
+			dst[src_i] = v.(*GraphNode)
+		} else {
+			dst[src_i] = new(GraphNode)
+			visited[src_value] = dst[src_i]
+			deriveDeepCopyGraph_1(dst[src_i], src_value, visited)
+		}
+	}
+}
+
+// deriveDeepCopyGraph_3 recursively copies the contents of src into dst.
+func deriveDeepCopyGraph_3(dst, src map[string]*GraphNode, visited map[interface{}]interface{}) {
+	for src_key, src_value := range src {
+		if src_value == nil {
+			dst[src_key] = nil
+		}
+		if src_value == nil {
+			dst[src_key] = nil
+		} else if v, ok := visited[src_value]; ok {
+			dst[src_key] = v.(*GraphNode)
+		} else {
+			dst[src_key] = new(GraphNode)
+			visited[src_value] = dst[src_key]
+			deriveDeepCopyGraph_1(dst[src_key], src_value, visited)
+		}
+	}
+}
+
+// deriveDeepCopyGraph_S returns a recursive copy of src.
+func deriveDeepCopyGraph_S(src Shape, visited map[interface{}]interface{}) Shape {
+	if src == nil {
+		return nil
+	}
+	switch src := src.(type) {
+	case *Circle:
+		var dst *Circle
+		if src == nil {
+			dst = nil
+		} else if v, ok := visited[src]; ok {
+			dst = v.(*Circle)
+		} else {
+			dst = new(Circle)
+			visited[src] = dst
+			*dst = *src
+		}
+		return dst
+	case Square:
+		var dst Square
+		field := new(Square)
+		deriveDeepCopyGraph_4(field, &src, visited)
+		dst = *field
+		return dst
+	case *Square:
+		var dst *Square
+		if src == nil {
+			dst = nil
+		} else if v, ok := visited[src]; ok {
+			dst = v.(*Square)
+		} else {
+			dst = new(Square)
+			visited[src] = dst
+			deriveDeepCopyGraph_4(dst, src, visited)
+		}
+		return dst
+	}
+	if typ := reflect.TypeOf(src); typ.Kind() == reflect.Ptr {
+		if cp := reflect.ValueOf(src).MethodByName("DeepCopy"); cp.IsValid() && cp.Type().NumIn() == 1 && cp.Type().In(0) == typ {
+			dst := reflect.New(typ.Elem())
+			cp.Call([]reflect.Value{dst})
+			return dst.Interface().(Shape)
+		}
+	}
+	return src
+}
+
+// deriveEqualGraph_3 returns whether this and that are equal.
+func deriveEqualGraph_3(this, that []*GraphNode, visited map[[2]interface{}]bool) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if len(this) != len(that) {
+		return false
+	}
+	for i := 0; i < len(this); i++ {
+		if !(deriveEqualGraph_2(this[i], that[i], visited)) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveEqualGraph_4 returns whether this and that are equal.
+func deriveEqualGraph_4(this, that map[string]*GraphNode, visited map[[2]interface{}]bool) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if len(this) != len(that) {
+		return false
+	}
+	for k, v := range this {
+		thatv, ok := that[k]
+		if !ok {
+			return false
+		}
+		if !(deriveEqualGraph_2(v, thatv, visited)) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveEqualGraph_S returns whether this and that are equal.
+func deriveEqualGraph_S(this, that Shape, visited map[[2]interface{}]bool) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if reflect.TypeOf(this) != reflect.TypeOf(that) {
+		return false
+	}
+	switch this := this.(type) {
+	case *Circle:
+		that := that.(*Circle)
+		return deriveEqualGraph_5(this, that, visited)
+	case Square:
+		that := that.(Square)
+		return deriveEqualGraph_6(&this, &that, visited)
+	case *Square:
+		that := that.(*Square)
+		return deriveEqualGraph_6(this, that, visited)
+	}
+	if eq := reflect.ValueOf(this).MethodByName("Equal"); eq.IsValid() {
+		if t := eq.Type(); t.NumIn() == 1 && t.In(0) == reflect.TypeOf(that) && t.NumOut() == 1 && t.Out(0).Kind() == reflect.Bool {
+			return eq.Call([]reflect.Value{reflect.ValueOf(that)})[0].Bool()
+		}
+	}
+	return reflect.DeepEqual(this, that)
+}
+
+// deriveHashSeed_u writes the object to the FNV-1a 64 state h and returns the new state.
+func deriveHashSeed_u(object uint64, h uint64) uint64 {
+	for i := 0; i < 8; i++ {
+		h = (h ^ (object & 0xff)) * 1099511628211
+		object >>= 8
+	}
+	return h
+}
+
+// deriveHashSeed_b writes the object to the FNV-1a 64 state h and returns the new state.
+func deriveHashSeed_b(object bool, h uint64) uint64 {
+	if object {
+		return (h ^ 1) * 1099511628211
+	}
+	return (h ^ 0) * 1099511628211
+}
+
+// deriveHashSeed_3 writes the object to the FNV-1a 64 state h and returns the new state.
+func deriveHashSeed_3(object []string, h uint64) uint64 {
+	if object == nil {
+		return (h ^ 0) * 1099511628211
+	}
+	h = (h ^ 1) * 1099511628211
+	h = deriveHashSeed_u(uint64(len(object)), h)
+	for i := 0; i < len(object); i++ {
+		h = deriveHashSeed_(string(object[i]), h)
+	}
+	return h
+}
+
+// deriveHashSeed_4 writes the object to the FNV-1a 64 state h and returns the new state.
+func deriveHashSeed_4(object map[string]int, h uint64) uint64 {
+	if object == nil {
+		return (h ^ 0) * 1099511628211
+	}
+	h = (h ^ 1) * 1099511628211
+	h = deriveHashSeed_u(uint64(len(object)), h)
+	for _, k := range deriveSortedStrings(deriveKeys_21(object)) {
+		h = deriveHashSeed_(string(k), h)
+		h = deriveHashSeed_u(uint64(object[k]), h)
+	}
+	return h
+}
+
+// deriveHashSeed_S writes the object to the FNV-1a 64 state h and returns the new state.
+func deriveHashSeed_S(object Shape, h uint64) uint64 {
+	if object == nil {
+		return (h ^ 0) * 1099511628211
+	}
+	h = (h ^ 1) * 1099511628211
+	h = deriveHashSeed_(string(reflect.TypeOf(object).String()), h)
+	switch object := object.(type) {
+	case *Circle:
+		return deriveHashSeed_5(object, h)
+	case Square:
+		return deriveHashSeed_Sq(object, h)
+	case *Square:
+		return deriveHashSeed_6(object, h)
+	}
+	return h
+}
+
+// deriveDeepCopy_62 recursively copies the contents of src into dst.
+func deriveDeepCopy_62(dst, src *Square) {
+	dst.Side = src.Side
+	if src.Color == nil {
+		dst.Color = nil
+	} else {
+		if dst.Color != nil {
+			if len(src.Color) > len(dst.Color) {
+				if cap(dst.Color) >= len(src.Color) {
+					dst.Color = (dst.Color)[:len(src.Color)]
+				} else {
+					dst.Color = make([]string, len(src.Color))
+				}
+			} else if len(src.Color) < len(dst.Color) {
+				dst.Color = (dst.Color)[:len(src.Color)]
+			}
+		} else {
+			dst.Color = make([]string, len(src.Color))
+		}
+		copy(dst.Color, src.Color)
+	}
+}
+
+// deriveIsEmpty_T returns whether this is the zero value of its type, where empty slices and maps are also zero.
+func deriveIsEmpty_T(this time.Time) bool {
+	thisv := reflect.Indirect(reflect.ValueOf(&this))
+	return *(*uint64)(unsafe.Pointer(thisv.FieldByName("wall").UnsafeAddr())) == 0 &&
+		*(*int64)(unsafe.Pointer(thisv.FieldByName("ext").UnsafeAddr())) == 0 &&
+		*(**time.Location)(unsafe.Pointer(thisv.FieldByName("loc").UnsafeAddr())) == nil
+}
+
+// deriveIsEmpty_3 returns whether this is the zero value of its type, where empty slices and maps are also zero.
+func deriveIsEmpty_3(this [2]int) bool {
+	for _, v := range this {
+		if !(v == 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveCompare_b returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_b(this, that bool) int {
+	if this == that {
+		return 0
+	}
+	if that {
+		return -1
+	}
+	return 1
+}
+
+// deriveCompare_by returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_by(this, that byte) int {
+	if this != that {
+		if this < that {
+			return -1
+		} else {
+			return 1
+		}
+	}
+	return 0
+}
+
+// deriveCompare_flo returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_flo(this, that float64) int {
+	if this != that {
+		if this < that {
+			return -1
+		} else {
+			return 1
+		}
+	}
+	return 0
+}
+
+// deriveCompare_floa returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_floa(this, that float32) int {
+	if this != that {
+		if this < that {
+			return -1
+		} else {
+			return 1
+		}
+	}
+	return 0
+}
+
+// deriveCompare_int1 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_int1(this, that int16) int {
+	if this != that {
+		if this < that {
+			return -1
+		} else {
+			return 1
+		}
+	}
+	return 0
+}
+
+// deriveCompare_int3 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_int3(this, that int32) int {
+	if this != that {
+		if this < that {
+			return -1
+		} else {
+			return 1
+		}
+	}
+	return 0
+}
+
+// deriveCompare_int64 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_int64(this, that int64) int {
+	if this != that {
+		if this < that {
+			return -1
+		} else {
+			return 1
+		}
+	}
+	return 0
+}
+
+// deriveCompare_int85 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_int85(this, that int8) int {
+	if this != that {
+		if this < that {
+			return -1
+		} else {
+			return 1
+		}
+	}
+	return 0
+}
+
+// deriveCompare_uint5 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_uint5(this, that uint) int {
+	if this != that {
+		if this < that {
+			return -1
+		} else {
+			return 1
+		}
+	}
+	return 0
+}
+
+// deriveCompare_uint1 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_uint1(this, that uint16) int {
+	if this != that {
+		if this < that {
+			return -1
+		} else {
+			return 1
+		}
+	}
+	return 0
+}
+
+// deriveCompare_uint3 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_uint3(this, that uint32) int {
+	if this != that {
+		if this < that {
+			return -1
+		} else {
+			return 1
+		}
+	}
+	return 0
+}
+
+// deriveCompare_uint6 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_uint6(this, that uint64) int {
+	if this != that {
+		if this < that {
+			return -1
+		} else {
+			return 1
+		}
+	}
+	return 0
+}
+
+// deriveCompare_142 returns:
+//   * 0 if this and that are equal,
+//   * -1 is this is smaller and
+//   * +1 is this is bigger.
+func deriveCompare_142(this, that uintptr) int {
+	if this != that {
+		if this < that {
+			return -1
+		} else {
+			return 1
+		}
+	}
+	return 0
+}
+
+// deriveString_5 appends a compact and human readable representation of this to buf.
+func deriveString_5(this []string, buf []byte) []byte {
+	buf = append(buf, '[')
+	for i, v := range this {
+		if i == 10 {
+			buf = append(buf, ", ... "...)
+			buf = strconv.AppendInt(buf, int64(len(this)-i), 10)
+			buf = append(buf, " more"...)
+			break
+		}
+		if i > 0 {
+			buf = append(buf, ", "...)
+		}
+		buf = strconv.AppendQuote(buf, v)
+	}
+	buf = append(buf, ']')
+	return buf
+}
+
+// deriveString_6 appends a compact and human readable representation of this to buf.
+func deriveString_6(this map[string]uint8, buf []byte) []byte {
+	buf = append(buf, "map["...)
+	for i, k := range deriveSortedStrings(deriveKeys_22(this)) {
+		if i == 10 {
+			buf = append(buf, ", ... "...)
+			buf = strconv.AppendInt(buf, int64(len(this)-i), 10)
+			buf = append(buf, " more"...)
+			break
+		}
+		if i > 0 {
+			buf = append(buf, ", "...)
+		}
+		buf = strconv.AppendQuote(buf, k)
+		buf = append(buf, ": "...)
+		buf = strconv.AppendUint(buf, uint64(this[k]), 10)
+	}
+	buf = append(buf, ']')
+	return buf
+}
+
+// deriveString_7 appends a compact and human readable representation of this to buf.
+func deriveString_7(this [2]int, buf []byte) []byte {
+	buf = append(buf, '[')
+	for i, v := range this {
+		if i == 10 {
+			buf = append(buf, ", ... "...)
+			buf = strconv.AppendInt(buf, int64(len(this)-i), 10)
+			buf = append(buf, " more"...)
+			break
+		}
+		if i > 0 {
+			buf = append(buf, ", "...)
+		}
+		buf = strconv.AppendInt(buf, int64(v), 10)
+	}
+	buf = append(buf, ']')
+	return buf
+}
+
+// deriveEqual_104 returns whether this and that are equal.
+func deriveEqual_104(this, that *vendortest.AVendoredObject) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Name == that.Name
+}
+
+// deriveWalk_5 calls visit with every value of type Endpoint, which is nested inside this, until visit returns an error.
+func deriveWalk_5(this **WalkRegion, visit func(e *Endpoint) error) error {
+	if this == nil {
+		return nil
+	}
+	if err := deriveWalk_6(*this, visit); err != nil {
+		return err
+	}
+	return nil
+}
+
+// deriveKeys_21 returns the keys of the input map as a slice.
+func deriveKeys_21(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// deriveKeys_22 returns the keys of the input map as a slice.
+func deriveKeys_22(m map[string]uint8) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// deriveDiff_1 appends the differences between this and that to diffs, where path is the path to this and that.
+func deriveDiff_1(this, that []int, path string, diffs []string) []string {
+	if this == nil || that == nil {
+		if (this == nil) != (that == nil) {
+			diffs = append(diffs, fmt.Sprintf("%s: %#v != %#v", path, this, that))
+		}
+		return diffs
+	}
+	if len(this) != len(that) {
+		diffs = append(diffs, fmt.Sprintf("%s: len %d != %d", path, len(this), len(that)))
+	}
+	for i := 0; i < len(this) && i < len(that); i++ {
+		if this[i] != that[i] {
+			diffs = append(diffs, fmt.Sprintf("%s[%d]: %#v != %#v", path, i, this[i], that[i]))
+		}
+	}
+	return diffs
+}
+
+// deriveDiff_2 appends the differences between this and that to diffs, where path is the path to this and that.
+func deriveDiff_2(this, that map[string]int, path string, diffs []string) []string {
+	if this == nil || that == nil {
+		if (this == nil) != (that == nil) {
+			diffs = append(diffs, fmt.Sprintf("%s: %#v != %#v", path, this, that))
+		}
+		return diffs
+	}
+	n := len(diffs)
+	for k, thisv := range this {
+		thatv, ok := that[k]
+		if !ok {
+			diffs = append(diffs, fmt.Sprintf("%s[%#v]: %#v != <missing>", path, k, thisv))
+			continue
+		}
+		if thisv != thatv {
+			diffs = append(diffs, fmt.Sprintf("%s[%#v]: %#v != %#v", path, k, thisv, thatv))
+		}
+	}
+	for k, thatv := range that {
+		if _, ok := this[k]; !ok {
+			diffs = append(diffs, fmt.Sprintf("%s[%#v]: <missing> != %#v", path, k, thatv))
+		}
+	}
+	sort.Strings(diffs[n:])
+	return diffs
+}
+
+// deriveDiff_S appends the differences between this and that to diffs, where path is the path to this and that.
+func deriveDiff_S(this, that Shape, path string, diffs []string) []string {
+	if this == nil || that == nil {
+		if this != nil || that != nil {
+			diffs = append(diffs, fmt.Sprintf("%s: %#v != %#v", path, this, that))
+		}
+		return diffs
+	}
+	if reflect.TypeOf(this) != reflect.TypeOf(that) {
+		diffs = append(diffs, fmt.Sprintf("%s: %#v != %#v", path, this, that))
+		return diffs
+	}
+	switch this := this.(type) {
+	case *Circle:
+		that := that.(*Circle)
+		diffs = deriveDiff_5(this, that, path, diffs)
+		return diffs
+	case Square:
+		that := that.(Square)
+		diffs = deriveDiff_6(&this, &that, path, diffs)
+		return diffs
+	case *Square:
+		that := that.(*Square)
+		diffs = deriveDiff_6(this, that, path, diffs)
+		return diffs
+	}
+	if !reflect.DeepEqual(this, that) {
+		diffs = append(diffs, fmt.Sprintf("%s: %#v != %#v", path, this, that))
+	}
+	return diffs
+}
+
+// deriveDiff_3 appends the differences between this and that to diffs, where path is the path to this and that.
+func deriveDiff_3(this, that [2]float64, path string, diffs []string) []string {
+	for i := 0; i < len(this); i++ {
+		if this[i] != that[i] {
+			diffs = append(diffs, fmt.Sprintf("%s[%d]: %#v != %#v", path, i, this[i], that[i]))
+		}
+	}
+	return diffs
+}
+
+// deriveDiff_4 appends the differences between this and that to diffs, where path is the path to this and that.
+func deriveDiff_4(this, that *extra.PrivateFieldAndNoEqualMethod, path string, diffs []string) []string {
+	if this == nil || that == nil {
+		if (this == nil) != (that == nil) {
+			diffs = append(diffs, fmt.Sprintf("%s: %#v != %#v", path, this, that))
+		}
+		return diffs
+	}
+	thisv := reflect.Indirect(reflect.ValueOf(this))
+	thatv := reflect.Indirect(reflect.ValueOf(that))
+	if *(*int64)(unsafe.Pointer(thisv.FieldByName("number").UnsafeAddr())) != *(*int64)(unsafe.Pointer(thatv.FieldByName("number").UnsafeAddr())) {
+		diffs = append(diffs, fmt.Sprintf("%s.number: %#v != %#v", path, *(*int64)(unsafe.Pointer(thisv.FieldByName("number").UnsafeAddr())), *(*int64)(unsafe.Pointer(thatv.FieldByName("number").UnsafeAddr()))))
+	}
+	diffs = deriveDiff_7(*(*[]int64)(unsafe.Pointer(thisv.FieldByName("numbers").UnsafeAddr())), *(*[]int64)(unsafe.Pointer(thatv.FieldByName("numbers").UnsafeAddr())), path+".numbers", diffs)
+	diffs = deriveDiff_8(*(**int64)(unsafe.Pointer(thisv.FieldByName("ptr").UnsafeAddr())), *(**int64)(unsafe.Pointer(thatv.FieldByName("ptr").UnsafeAddr())), path+".ptr", diffs)
+	diffs = deriveDiff_9(*(*[]*int64)(unsafe.Pointer(thisv.FieldByName("numberpts").UnsafeAddr())), *(*[]*int64)(unsafe.Pointer(thatv.FieldByName("numberpts").UnsafeAddr())), path+".numberpts", diffs)
+	diffs = deriveDiff_10(*(**extra.StructWithoutEqualMethod)(unsafe.Pointer(thisv.FieldByName("strct").UnsafeAddr())), *(**extra.StructWithoutEqualMethod)(unsafe.Pointer(thatv.FieldByName("strct").UnsafeAddr())), path+".strct", diffs)
+	return diffs
+}
+
+// deriveDeepCopyGraph_4 recursively copies the contents of src into dst.
+func deriveDeepCopyGraph_4(dst, src *Square, visited map[interface{}]interface{}) {
+	dst.Side = src.Side
+	if src.Color == nil {
+		dst.Color = nil
+	} else {
+		if dst.Color != nil {
+			if len(src.Color) > len(dst.Color) {
+				if cap(dst.Color) >= len(src.Color) {
+					dst.Color = (dst.Color)[:len(src.Color)]
+				} else {
+					dst.Color = make([]string, len(src.Color))
+				}
+			} else if len(src.Color) < len(dst.Color) {
+				dst.Color = (dst.Color)[:len(src.Color)]
+			}
+		} else {
+			dst.Color = make([]string, len(src.Color))
+		}
+		copy(dst.Color, src.Color)
+	}
+}
+
+// deriveEqualGraph_5 returns whether this and that are equal.
+func deriveEqualGraph_5(this, that *Circle, visited map[[2]interface{}]bool) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if visited[[2]interface{}{this, that}] {
+		return true
+	}
+	visited[[2]interface{}{this, that}] = true
+	return this.Radius == that.Radius
+}
+
+// deriveEqualGraph_6 returns whether this and that are equal.
+func deriveEqualGraph_6(this, that *Square, visited map[[2]interface{}]bool) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if visited[[2]interface{}{this, that}] {
+		return true
+	}
+	visited[[2]interface{}{this, that}] = true
+	return this.Side == that.Side &&
+		deriveEqualGraph_7(this.Color, that.Color, visited)
+}
+
+// deriveHashSeed_5 writes the object to the FNV-1a 64 state h and returns the new state.
+func deriveHashSeed_5(object *Circle, h uint64) uint64 {
+	if object == nil {
+		return (h ^ 0) * 1099511628211
+	}
+	h = (h ^ 1) * 1099511628211
+	h = deriveHashSeed_u(math.Float64bits(float64(object.Radius)), h)
+	return h
+}
+
+// deriveHashSeed_Sq writes the object to the FNV-1a 64 state h and returns the new state.
+func deriveHashSeed_Sq(object Square, h uint64) uint64 {
+	h = deriveHashSeed_u(math.Float64bits(float64(object.Side)), h)
+	h = deriveHashSeed_3(object.Color, h)
+	return h
+}
+
+// deriveHashSeed_6 writes the object to the FNV-1a 64 state h and returns the new state.
+func deriveHashSeed_6(object *Square, h uint64) uint64 {
+	if object == nil {
+		return (h ^ 0) * 1099511628211
+	}
+	h = (h ^ 1) * 1099511628211
+	h = deriveHashSeed_u(math.Float64bits(float64(object.Side)), h)
+	h = deriveHashSeed_3(object.Color, h)
+	return h
+}
+
+// deriveWalk_6 calls visit with every value of type Endpoint, which is nested inside this, until visit returns an error.
+func deriveWalk_6(this *WalkRegion, visit func(e *Endpoint) error) error {
+	if this == nil {
+		return nil
+	}
+	if err := deriveWalk_7(&this.Endpoints, visit); err != nil {
+		return err
+	}
+	if err := deriveWalk_8(&this.Children, visit); err != nil {
+		return err
+	}
+	return nil
+}
+
+// deriveDiff_5 appends the differences between this and that to diffs, where path is the path to this and that.
+func deriveDiff_5(this, that *Circle, path string, diffs []string) []string {
+	if this == nil || that == nil {
+		if (this == nil) != (that == nil) {
+			diffs = append(diffs, fmt.Sprintf("%s: %#v != %#v", path, this, that))
+		}
+		return diffs
+	}
+	if this.Radius != that.Radius {
+		diffs = append(diffs, fmt.Sprintf("%s.Radius: %#v != %#v", path, this.Radius, that.Radius))
+	}
+	return diffs
+}
+
+// deriveDiff_6 appends the differences between this and that to diffs, where path is the path to this and that.
+func deriveDiff_6(this, that *Square, path string, diffs []string) []string {
+	if this == nil || that == nil {
+		if (this == nil) != (that == nil) {
+			diffs = append(diffs, fmt.Sprintf("%s: %#v != %#v", path, this, that))
+		}
+		return diffs
+	}
+	if this.Side != that.Side {
+		diffs = append(diffs, fmt.Sprintf("%s.Side: %#v != %#v", path, this.Side, that.Side))
+	}
+	diffs = deriveDiff_11(this.Color, that.Color, path+".Color", diffs)
+	return diffs
+}
+
+// deriveDiff_7 appends the differences between this and that to diffs, where path is the path to this and that.
+func deriveDiff_7(this, that []int64, path string, diffs []string) []string {
+	if this == nil || that == nil {
+		if (this == nil) != (that == nil) {
+			diffs = append(diffs, fmt.Sprintf("%s: %#v != %#v", path, this, that))
+		}
+		return diffs
+	}
+	if len(this) != len(that) {
+		diffs = append(diffs, fmt.Sprintf("%s: len %d != %d", path, len(this), len(that)))
+	}
+	for i := 0; i < len(this) && i < len(that); i++ {
+		if this[i] != that[i] {
+			diffs = append(diffs, fmt.Sprintf("%s[%d]: %#v != %#v", path, i, this[i], that[i]))
+		}
+	}
+	return diffs
+}
+
+// deriveDiff_8 appends the differences between this and that to diffs, where path is the path to this and that.
+func deriveDiff_8(this, that *int64, path string, diffs []string) []string {
+	if this == nil || that == nil {
+		if (this == nil) != (that == nil) {
+			diffs = append(diffs, fmt.Sprintf("%s: %#v != %#v", path, this, that))
+		}
+		return diffs
+	}
+	if *this != *that {
+		diffs = append(diffs, fmt.Sprintf("%s: %#v != %#v", path, *this, *that))
+	}
+	return diffs
+}
+
+// deriveDiff_9 appends the differences between this and that to diffs, where path is the path to this and that.
+func deriveDiff_9(this, that []*int64, path string, diffs []string) []string {
+	if this == nil || that == nil {
+		if (this == nil) != (that == nil) {
+			diffs = append(diffs, fmt.Sprintf("%s: %#v != %#v", path, this, that))
+		}
+		return diffs
+	}
+	if len(this) != len(that) {
+		diffs = append(diffs, fmt.Sprintf("%s: len %d != %d", path, len(this), len(that)))
+	}
+	for i := 0; i < len(this) && i < len(that); i++ {
+		diffs = deriveDiff_8(this[i], that[i], fmt.Sprintf("%s[%d]", path, i), diffs)
+	}
+	return diffs
+}
+
+// deriveDiff_10 appends the differences between this and that to diffs, where path is the path to this and that.
+func deriveDiff_10(this, that *extra.StructWithoutEqualMethod, path string, diffs []string) []string {
+	if this == nil || that == nil {
+		if (this == nil) != (that == nil) {
+			diffs = append(diffs, fmt.Sprintf("%s: %#v != %#v", path, this, that))
+		}
+		return diffs
+	}
+	if this.Number != that.Number {
+		diffs = append(diffs, fmt.Sprintf("%s.Number: %#v != %#v", path, this.Number, that.Number))
+	}
+	return diffs
+}
+
+// deriveEqualGraph_7 returns whether this and that are equal.
+func deriveEqualGraph_7(this, that []string, visited map[[2]interface{}]bool) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if len(this) != len(that) {
+		return false
+	}
+	for i := 0; i < len(this); i++ {
+		if !(this[i] == that[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveWalk_7 calls visit with every value of type Endpoint, which is nested inside this, until visit returns an error.
+func deriveWalk_7(this *[]*Endpoint, visit func(e *Endpoint) error) error {
+	if this == nil {
+		return nil
+	}
+	for i := range *this {
+		if err := deriveWalk_1(&(*this)[i], visit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deriveWalk_8 calls visit with every value of type Endpoint, which is nested inside this, until visit returns an error.
+func deriveWalk_8(this *[]WalkRegion, visit func(e *Endpoint) error) error {
+	if this == nil {
+		return nil
+	}
+	for i := range *this {
+		if err := deriveWalk_6(&(*this)[i], visit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deriveDiff_11 appends the differences between this and that to diffs, where path is the path to this and that.
+func deriveDiff_11(this, that []string, path string, diffs []string) []string {
+	if this == nil || that == nil {
+		if (this == nil) != (that == nil) {
+			diffs = append(diffs, fmt.Sprintf("%s: %#v != %#v", path, this, that))
+		}
+		return diffs
+	}
+	if len(this) != len(that) {
+		diffs = append(diffs, fmt.Sprintf("%s: len %d != %d", path, len(this), len(that)))
+	}
+	for i := 0; i < len(this) && i < len(that); i++ {
+		if this[i] != that[i] {
+			diffs = append(diffs, fmt.Sprintf("%s[%d]: %#v != %#v", path, i, this[i], that[i]))
+		}
+	}
+	return diffs
+}
@@ -0,0 +1,103 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/awalterschulze/goderive/test/extra"
+)
+
+func newDiffable() *Diffable {
+	return &Diffable{
+		Name:   "a",
+		Ages:   []int{1, 2, 3},
+		Tags:   map[string]int{"a": 1, "b": 2},
+		Parent: &Diffable{Name: "parent", Ages: []int{4}},
+		Shape:  &Circle{Radius: 1},
+		When:   time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC),
+		Pair:   [2]float64{1, 2},
+	}
+}
+
+func TestDiffEqual(t *testing.T) {
+	this, that := newDiffable(), newDiffable()
+	that.Skipped = 1
+	that.When = that.When.In(time.FixedZone("other", 3600))
+	if diffs := deriveDiff(this, that); len(diffs) != 0 {
+		t.Fatalf("expected no differences, but got %v", diffs)
+	}
+}
+
+func TestDiffPaths(t *testing.T) {
+	this, that := newDiffable(), newDiffable()
+	that.Name = "b"
+	that.Ages = []int{1, 5}
+	that.Tags = map[string]int{"a": 2, "c": 3}
+	that.Parent.Ages[0] = 6
+	that.Shape = &Circle{Radius: 2}
+	that.Pair[1] = 3
+	want := []string{
+		`.Name: "a" != "b"`,
+		`.Ages: len 3 != 2`,
+		`.Ages[1]: 2 != 5`,
+		`.Tags["a"]: 1 != 2`,
+		`.Tags["b"]: 2 != <missing>`,
+		`.Tags["c"]: <missing> != 3`,
+		`.Parent.Ages[0]: 4 != 6`,
+		`.Shape.Radius: 1 != 2`,
+		`.Pair[1]: 2 != 3`,
+	}
+	if got := deriveDiff(this, that); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestDiffNil(t *testing.T) {
+	this, that := newDiffable(), newDiffable()
+	that.Parent = nil
+	that.Shape = nil
+	that.Ages = nil
+	want := []string{
+		`.Ages: []int{1, 2, 3} != []int(nil)`,
+		`.Parent: &test.Diffable{Name:"parent", Ages:[]int{4}, Tags:map[string]int(nil), Parent:(*test.Diffable)(nil), Shape:test.Shape(nil), When:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), Pair:[2]float64{0, 0}, Private:(*extra.PrivateFieldAndNoEqualMethod)(nil), Skipped:0} != (*test.Diffable)(nil)`,
+		`.Shape: &test.Circle{Radius:1} != <nil>`,
+	}
+	if got := deriveDiff(this, that); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if got := deriveDiffInt(1, 2); !reflect.DeepEqual(got, []string{"1 != 2"}) {
+		t.Fatalf("got %v", got)
+	}
+}
+
+func TestDiffPrivateFields(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		this := random((*extra.PrivateFieldAndNoEqualMethod)(nil)).(*extra.PrivateFieldAndNoEqualMethod)
+		that := random((*extra.PrivateFieldAndNoEqualMethod)(nil)).(*extra.PrivateFieldAndNoEqualMethod)
+		diffs := deriveDiff(&Diffable{Private: this}, &Diffable{Private: that})
+		if eq := deriveEqualPrivateFields(this, that); eq != (len(diffs) == 0) {
+			t.Fatalf("equal is %v, but got differences %v", eq, diffs)
+		}
+		for _, d := range diffs {
+			if !strings.HasPrefix(d, ".Private") {
+				t.Fatalf("expected the difference to be in the private fields, but got %s", d)
+			}
+		}
+	}
+}
@@ -14,8 +14,13 @@
 
 package test
 
-import "testing"
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
 
 func TestDoSuccess(t *testing.T) {
 	f := func() (string, error) {
@@ -45,3 +50,73 @@ func TestDoFailure(t *testing.T) {
 		t.Fatal("expected error")
 	}
 }
+
+func TestDoContextSuccess(t *testing.T) {
+	f := func(ctx context.Context) (string, error) {
+		return "a", nil
+	}
+	g := func(ctx context.Context) (int, error) {
+		return 1, nil
+	}
+	s, i, err := deriveDoContext(context.Background(), f, g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != "a" || i != 1 {
+		t.Fatalf("unexpected results %s %d", s, i)
+	}
+}
+
+func TestDoContextCancel(t *testing.T) {
+	failed := fmt.Errorf("failed")
+	f := func(ctx context.Context) (string, error) {
+		return "", failed
+	}
+	g := func(ctx context.Context) (int, error) {
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(10 * time.Second):
+			return 1, nil
+		}
+	}
+	_, _, err := deriveDoContext(context.Background(), f, g)
+	if err != failed {
+		t.Fatalf("expected the first error, but got %v", err)
+	}
+}
+
+func TestDoContextBounded(t *testing.T) {
+	var running, max int32
+	f := func(ctx context.Context) (int, error) {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&max)
+			if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return int(n), nil
+	}
+	_, _, _, err := deriveDoBounded(context.Background(), 2, f, f, f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if max > 2 {
+		t.Fatalf("expected at most 2 functions to run at the same time, but %d did", max)
+	}
+}
+
+func TestDoContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	f := func(ctx context.Context) (int, error) {
+		return 0, ctx.Err()
+	}
+	_, _, _, err := deriveDoBounded(ctx, 1, f, f, f)
+	if err != context.Canceled {
+		t.Fatalf("expected the error of the context, but got %v", err)
+	}
+}
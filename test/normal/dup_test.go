@@ -14,7 +14,10 @@
 
 package test
 
-import "testing"
+import (
+	"sync"
+	"testing"
+)
 
 func TestDup(t *testing.T) {
 	c := make(chan int)
@@ -48,3 +51,31 @@ func TestDup(t *testing.T) {
 		t.Fatalf("got %d != want %d", got, want)
 	}
 }
+
+func TestTee(t *testing.T) {
+	c := make(chan int)
+	go func() {
+		for i := 1; i <= 3; i++ {
+			c <- i
+		}
+		close(c)
+	}()
+	cs := deriveTee(3, c)
+	sums := make([]int, len(cs))
+	var wg sync.WaitGroup
+	for i := range cs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for v := range cs[i] {
+				sums[i] += v
+			}
+		}()
+	}
+	wg.Wait()
+	for i, sum := range sums {
+		if sum != 6 {
+			t.Fatalf("channel %d: got %d, want 6", i, sum)
+		}
+	}
+}
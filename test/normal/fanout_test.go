@@ -0,0 +1,50 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package test
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestFanOut(t *testing.T) {
+	c := make(chan int)
+	go func() {
+		for i := 0; i < 9; i++ {
+			c <- i
+		}
+		close(c)
+	}()
+	cs := deriveFanOut(3, c)
+	if len(cs) != 3 {
+		t.Fatalf("got %d channels, want 3", len(cs))
+	}
+	got := make([][]int, len(cs))
+	var wg sync.WaitGroup
+	for i := range cs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for v := range cs[i] {
+				got[i] = append(got[i], v)
+			}
+		}()
+	}
+	wg.Wait()
+	want := [][]int{{0, 3, 6}, {1, 4, 7}, {2, 5, 8}}
+	if !deriveEqualFanOut(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
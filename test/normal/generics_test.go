@@ -0,0 +1,144 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type GenericPair[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+type GenericList[T any] struct {
+	Value T
+	Next  *GenericList[T]
+}
+
+type GenericTree[T comparable] struct {
+	Value    T
+	Children []*GenericTree[T]
+}
+
+func (this *GenericTree[T]) Equal(that *GenericTree[T]) bool {
+	return deriveEqualPtrToGenericTree(this, that)
+}
+
+func genericEqualSlices[T comparable](this, that []T) bool {
+	return deriveEqualGenericSlice(this, that)
+}
+
+func genericEqualStrings[S comparable](this, that []S) bool {
+	return deriveEqualGenericSlice(this, that)
+}
+
+func genericCompare[T ~int | ~string](this, that T) int {
+	return deriveCompareGeneric(this, that)
+}
+
+func genericClone[T ~int | ~string](this *GenericList[T]) *GenericList[T] {
+	return deriveCloneGenericList(this)
+}
+
+func TestGenericEqual(t *testing.T) {
+	this := &GenericPair[string, []int]{Key: "a", Value: []int{1, 2}}
+	that := &GenericPair[string, []int]{Key: "a", Value: []int{1, 2}}
+	if !deriveEqualPtrToGenericPair(this, that) {
+		t.Fatalf("expected equal")
+	}
+	that.Value[1] = 3
+	if deriveEqualPtrToGenericPair(this, that) {
+		t.Fatalf("expected not equal")
+	}
+	list := &GenericList[int]{Value: 1, Next: &GenericList[int]{Value: 2}}
+	if !deriveEqualPtrToGenericList(list, list) {
+		t.Fatalf("expected equal")
+	}
+	if deriveEqualPtrToGenericList(list, list.Next) {
+		t.Fatalf("expected not equal")
+	}
+}
+
+func TestGenericEqualTypeParam(t *testing.T) {
+	if !genericEqualSlices([]string{"a", "b"}, []string{"a", "b"}) {
+		t.Fatalf("expected equal")
+	}
+	if genericEqualSlices([]int{1, 2}, []int{2, 1}) {
+		t.Fatalf("expected not equal")
+	}
+	if genericEqualStrings([]string{"a"}, []string{"b"}) {
+		t.Fatalf("expected not equal")
+	}
+	this := &GenericTree[string]{Value: "a", Children: []*GenericTree[string]{{Value: "b"}}}
+	that := &GenericTree[string]{Value: "a", Children: []*GenericTree[string]{{Value: "b"}}}
+	if !this.Equal(that) {
+		t.Fatalf("expected equal")
+	}
+	that.Children[0].Value = "c"
+	if this.Equal(that) {
+		t.Fatalf("expected not equal")
+	}
+}
+
+func TestGenericCompare(t *testing.T) {
+	this := &GenericList[int]{Value: 1, Next: &GenericList[int]{Value: 2}}
+	that := &GenericList[int]{Value: 1, Next: &GenericList[int]{Value: 3}}
+	if c := deriveComparePtrToGenericList(this, that); c != -1 {
+		t.Fatalf("expected -1, but got %d", c)
+	}
+	if c := genericCompare("b", "a"); c != 1 {
+		t.Fatalf("expected 1, but got %d", c)
+	}
+	if c := genericCompare(1, 1); c != 0 {
+		t.Fatalf("expected 0, but got %d", c)
+	}
+}
+
+func TestGenericHash(t *testing.T) {
+	this := &GenericPair[string, []int]{Key: "a", Value: []int{1, 2}}
+	that := &GenericPair[string, []int]{Key: "a", Value: []int{1, 2}}
+	if deriveHashPtrToGenericPair(this) != deriveHashPtrToGenericPair(that) {
+		t.Fatalf("expected equal hashes")
+	}
+}
+
+func TestGenericDeepCopy(t *testing.T) {
+	src := &GenericList[string]{Value: "a", Next: &GenericList[string]{Value: "b"}}
+	dst := &GenericList[string]{}
+	deriveDeepCopyPtrToGenericList(dst, src)
+	if !reflect.DeepEqual(src, dst) {
+		t.Fatalf("expected equal")
+	}
+	if src.Next == dst.Next {
+		t.Fatalf("expected a deep copy")
+	}
+	clone := genericClone(src)
+	if !reflect.DeepEqual(src, clone) {
+		t.Fatalf("expected equal")
+	}
+	if src.Next == clone.Next {
+		t.Fatalf("expected a deep copy")
+	}
+}
+
+func TestGenericGoString(t *testing.T) {
+	got := deriveGoStringGenericPair(&GenericPair[string, int]{Key: "a", Value: 1})
+	if !strings.Contains(got, "GenericPair[string, int]") {
+		t.Fatalf("expected the instantiated type name in %s", got)
+	}
+}
@@ -0,0 +1,89 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package test
+
+import (
+	"testing"
+)
+
+func newDoublyLinked(values ...int) *DoublyLinked {
+	var head, prev *DoublyLinked
+	for _, v := range values {
+		node := &DoublyLinked{Value: v, Prev: prev}
+		if prev == nil {
+			head = node
+		} else {
+			prev.Next = node
+		}
+		prev = node
+	}
+	return head
+}
+
+func TestDoublyLinkedGraph(t *testing.T) {
+	this := newDoublyLinked(1, 2, 3)
+	that := &DoublyLinked{}
+	this.DeepCopy(that)
+	if !this.Equal(that) {
+		t.Fatalf("expected a copy")
+	}
+	if that.Next.Prev != that || that.Next.Next.Prev != that.Next {
+		t.Fatalf("expected the back pointers to point into the copy")
+	}
+	if that.Next == this.Next {
+		t.Fatalf("expected the nodes to be copied")
+	}
+	that.Next.Next.Value = 4
+	if this.Equal(that) {
+		t.Fatalf("expected different lists to be different")
+	}
+	if !deriveEqualGraphCurried(this)(newDoublyLinked(1, 2, 3)) {
+		t.Fatalf("expected the curried function to compare lists")
+	}
+}
+
+func newGraph() *GraphNode {
+	a := &GraphNode{Name: "a"}
+	b := &GraphNode{Name: "b", Shape: &Circle{Radius: 1}}
+	a.Edges = []*GraphNode{b, b, a}
+	b.Edges = []*GraphNode{a}
+	a.Labels = map[string]*GraphNode{"self": a, "other": b}
+	return a
+}
+
+func TestGraphNode(t *testing.T) {
+	this := newGraph()
+	that := &GraphNode{}
+	deriveDeepCopyGraph(that, this)
+	if !deriveEqualGraph(this, that) {
+		t.Fatalf("expected a copy")
+	}
+	if that.Edges[0] != that.Edges[1] {
+		t.Fatalf("expected shared pointers to be shared in the copy")
+	}
+	if that.Edges[2] != that || that.Labels["self"] != that || that.Labels["other"] != that.Edges[0] || that.Edges[0].Edges[0] != that {
+		t.Fatalf("expected cyclic pointers to be cyclic in the copy")
+	}
+	if that.Edges[0] == this.Edges[0] || that.Edges[0].Shape == this.Edges[0].Shape {
+		t.Fatalf("expected the nodes to be copied")
+	}
+	that.Edges[0].Shape.(*Circle).Radius = 2
+	if deriveEqualGraph(this, that) {
+		t.Fatalf("expected different graphs to be different")
+	}
+	if !deriveEqualGraph(newGraph(), newGraph()) {
+		t.Fatalf("expected equal graphs")
+	}
+}
@@ -0,0 +1,113 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package test
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+	"testing"
+	"time"
+)
+
+// encoding builds the documented encoding of a value, which is hashed by deriveHashSeed.
+type encoding []byte
+
+func (e encoding) byte(b byte) encoding {
+	return append(e, b)
+}
+
+func (e encoding) uint64(v uint64) encoding {
+	return binary.LittleEndian.AppendUint64(e, v)
+}
+
+func (e encoding) string(s string) encoding {
+	return append(e.uint64(uint64(len(s))), s...)
+}
+
+func (e encoding) fnv() uint64 {
+	h := fnv.New64a()
+	h.Write(e)
+	return h.Sum64()
+}
+
+func TestHashSeedString(t *testing.T) {
+	want := encoding{}.string("abc").fnv()
+	if got := deriveHashSeed(0, "abc"); got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if deriveHashSeed(1, "abc") == want {
+		t.Fatalf("expected the seed to change the hash")
+	}
+}
+
+func TestHashSeedStruct(t *testing.T) {
+	key := &StableKey{
+		Name:    "a",
+		Version: -1,
+		Ratio:   0.5,
+		Enabled: true,
+		Tags:    []string{"x"},
+		Attrs:   map[string]int{"b": 2, "a": 1},
+		Shape:   &Circle{Radius: 1},
+		Cache:   []byte{1, 2, 3},
+	}
+	want := encoding{}.
+		byte(1).
+		string("a").
+		uint64(math.MaxUint64).
+		uint64(math.Float64bits(0.5)).
+		byte(1).
+		byte(1).uint64(1).string("x").
+		byte(1).uint64(2).string("a").uint64(1).string("b").uint64(2).
+		byte(0).
+		byte(1).string("*test.Circle").byte(1).uint64(math.Float64bits(1)).
+		fnv()
+	if got := deriveHashSeedStableKey(0, key); got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	other := *key
+	other.Cache = nil
+	if deriveHashSeedStableKey(42, key) != deriveHashSeedStableKey(42, &other) {
+		t.Fatalf("expected skipped fields not to change the hash")
+	}
+	other.Tags = []string{}
+	if deriveHashSeedStableKey(42, key) == deriveHashSeedStableKey(42, &other) {
+		t.Fatalf("expected different values to have different hashes")
+	}
+}
+
+func TestHashSeedSkippedField(t *testing.T) {
+	key := &SkippedKey{Name: "a", Callback: func() {}}
+	want := encoding{}.byte(1).string("a").fnv()
+	if got := deriveHashSeedSkippedKey(0, key); got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
+
+func TestHashSeedTime(t *testing.T) {
+	now := time.Date(2017, 1, 2, 3, 4, 5, 6, time.UTC)
+	data, err := now.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := encoding{}.string(string(data)).fnv()
+	if got := deriveHashSeedTime(0, now); got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if deriveHashSeedTime(0, now) == deriveHashSeedTime(0, now.Add(time.Second)) {
+		t.Fatalf("expected different times to have different hashes")
+	}
+}
@@ -0,0 +1,94 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package test
+
+import (
+	"errors"
+	"testing"
+)
+
+func newWithInterfaces() *WithInterfaces {
+	return &WithInterfaces{
+		Shape:  &Circle{Radius: 1},
+		Shapes: []Shape{Square{Side: 2, Color: []string{"red"}}, nil, &Circle{Radius: 3}},
+		Err:    errors.New("err"),
+		Any:    map[string]int{"a": 1},
+	}
+}
+
+func TestInterfacesEqual(t *testing.T) {
+	this, that := newWithInterfaces(), newWithInterfaces()
+	if !this.Equal(that) {
+		t.Fatalf("expected equal interfaces")
+	}
+	if this.Hash() != that.Hash() {
+		t.Fatalf("expected equal interfaces to have the same hash")
+	}
+	if c := this.Compare(that); c != 0 {
+		t.Fatalf("expected equal interfaces to compare equal, but got %d", c)
+	}
+	that.Shapes[0] = Square{Side: 2, Color: []string{"blue"}}
+	if this.Equal(that) || this.Compare(that) == 0 {
+		t.Fatalf("expected different values of the same type to be different")
+	}
+	that.Shapes[0] = &Square{Side: 2, Color: []string{"red"}}
+	if this.Equal(that) || this.Compare(that) == 0 {
+		t.Fatalf("expected values of different types to be different")
+	}
+	that = newWithInterfaces()
+	that.Err = errors.New("other")
+	if this.Equal(that) {
+		t.Fatalf("expected different errors to be different")
+	}
+}
+
+func TestInterfacesDeepCopy(t *testing.T) {
+	this := newWithInterfaces()
+	that := &WithInterfaces{}
+	this.DeepCopy(that)
+	if !this.Equal(that) {
+		t.Fatalf("expected a copy")
+	}
+	if this.Shape == that.Shape {
+		t.Fatalf("expected the pointer in the interface to be copied")
+	}
+	that.Shapes[0].(Square).Color[0] = "blue"
+	if this.Shapes[0].(Square).Color[0] != "red" {
+		t.Fatalf("expected the slice in the struct in the interface to be copied")
+	}
+}
+
+func TestInterfacesUnsupportedImplementation(t *testing.T) {
+	this, that := newWithInterfaces(), newWithInterfaces()
+	this.Shape, that.Shape = &Formula{Size: 2}, &Formula{Size: 2}
+	if !this.Equal(that) {
+		t.Fatalf("expected equal formulas")
+	}
+	if this.Hash() != that.Hash() {
+		t.Fatalf("expected equal formulas to have the same hash")
+	}
+	if c := this.Compare(that); c != 0 {
+		t.Fatalf("expected equal formulas to compare equal, but got %d", c)
+	}
+	that.Shape = &Formula{Size: 3}
+	if this.Equal(that) || this.Compare(that) == 0 {
+		t.Fatalf("expected different formulas to be different")
+	}
+	copied := &WithInterfaces{}
+	this.DeepCopy(copied)
+	if !this.Equal(copied) {
+		t.Fatalf("expected a copy")
+	}
+}
@@ -0,0 +1,68 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package test
+
+import (
+	"testing"
+)
+
+func TestIsZero(t *testing.T) {
+	if !deriveIsZero(Resettable{}) {
+		t.Fatalf("expected the zero value to be zero")
+	}
+	if !deriveIsZero(Resettable{Kept: 1}) {
+		t.Fatalf("expected the skipped field to be ignored")
+	}
+	one := 1
+	nonzero := []Resettable{
+		{Name: "a"},
+		{Count: 1},
+		{Done: true},
+		{Values: []*int{}},
+		{Values: []*int{&one}},
+		{Index: map[string]int{}},
+		{Address: Address{City: "city"}},
+		{Billing: &Address{}},
+		{Shape: &Circle{}},
+		{Pairs: [2][]int{nil, {}}},
+		{Callback: func() {}},
+		{Events: make(chan int)},
+	}
+	for _, r := range nonzero {
+		if deriveIsZero(r) {
+			t.Fatalf("expected %#v not to be zero", r)
+		}
+	}
+	if !deriveIsZeroArray([3]int{}) || deriveIsZeroArray([3]int{0, 0, 1}) {
+		t.Fatalf("expected an array to be zero, only when all its elements are zero")
+	}
+}
+
+func TestIsEmpty(t *testing.T) {
+	empty := Resettable{
+		Values: []*int{},
+		Index:  map[string]int{},
+		Pairs:  [2][]int{nil, {}},
+	}
+	if !deriveIsEmpty(empty) {
+		t.Fatalf("expected empty slices and maps to be empty")
+	}
+	if deriveIsZero(empty) {
+		t.Fatalf("expected empty slices and maps not to be zero")
+	}
+	if deriveIsEmpty(Resettable{Index: map[string]int{"a": 0}}) {
+		t.Fatalf("expected a map with an entry not to be empty")
+	}
+}
@@ -15,6 +15,7 @@
 package test
 
 import (
+	"context"
 	"errors"
 	"reflect"
 	"testing"
@@ -254,3 +255,50 @@ func TestJoinVariantOfSendRecvChannel(t *testing.T) {
 		t.Fatalf("got %d != want %d", got, want)
 	}
 }
+
+func TestJoinContextSlice(t *testing.T) {
+	cs := []<-chan int{toChanInts(1, 2), toChanInts(3)}
+	got := 0
+	for v := range deriveJoinContextSlice(context.Background(), cs) {
+		got += v
+	}
+	if got != 6 {
+		t.Fatalf("got %d, want 6", got)
+	}
+}
+
+func TestJoinContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	c0, c1 := make(chan int), make(chan int)
+	out := deriveJoinContextVariadic(ctx, c0, c1)
+	c1 <- 1
+	if got := <-out; got != 1 {
+		t.Fatalf("got %d, want 1", got)
+	}
+	cancel()
+	for range out {
+	}
+}
+
+func TestJoinContextChannels(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan (<-chan int))
+	out := deriveJoinContextChannels(ctx, in)
+	in <- toChanInts(1)
+	if got := <-out; got != 1 {
+		t.Fatalf("got %d, want 1", got)
+	}
+	in <- make(chan int)
+	cancel()
+	for range out {
+	}
+}
+
+func toChanInts(is ...int) <-chan int {
+	c := make(chan int, len(is))
+	for _, i := range is {
+		c <- i
+	}
+	close(c)
+	return c
+}
@@ -14,8 +14,13 @@
 
 package test
 
-import "testing"
-import "fmt"
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
 
 type Adder struct {
 	Int int
@@ -346,3 +351,196 @@ func TestMemSetError(t *testing.T) {
 		t.Fatalf("not called thrice, but %d", called)
 	}
 }
+
+func TestMemSync(t *testing.T) {
+	var called int32
+	release := make(chan struct{})
+	inc := func(n int) int {
+		atomic.AddInt32(&called, 1)
+		<-release
+		return n + 1
+	}
+	minc := deriveMemSyncInc(inc)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := minc(1); got != 2 {
+				t.Errorf("inc(1) got %d want 2", got)
+			}
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if got := minc(1); got != 2 {
+		t.Fatalf("inc(1) got %d want 2", got)
+	}
+	if called != 1 {
+		t.Fatalf("not called once, but %d", called)
+	}
+}
+
+func TestMemSyncHashed(t *testing.T) {
+	called := 0
+	sum := func(ns []int, offset int) (int, error) {
+		called++
+		total := offset
+		for _, n := range ns {
+			total += n
+		}
+		return total, nil
+	}
+	msum := deriveMemSyncSum(sum)
+	for i := 0; i < 2; i++ {
+		if got, err := msum([]int{1, 2}, 3); err != nil || got != 6 {
+			t.Fatalf("sum([1, 2], 3) got %d, %v want 6", got, err)
+		}
+	}
+	if got, _ := msum([]int{1, 2}, 4); got != 7 {
+		t.Fatalf("sum([1, 2], 4) got %d want 7", got)
+	}
+	if called != 2 {
+		t.Fatalf("not called twice, but %d", called)
+	}
+}
+
+func TestMemLRU(t *testing.T) {
+	called := 0
+	inc := func(n int) int {
+		called++
+		return n + 1
+	}
+	minc := deriveMemLRUInc(2, inc)
+	minc(1)
+	minc(2)
+	minc(1)
+	minc(3)
+	if called != 3 {
+		t.Fatalf("not called thrice, but %d", called)
+	}
+	minc(1)
+	if called != 3 {
+		t.Fatalf("expected the most recently used result to be remembered")
+	}
+	minc(2)
+	if called != 4 {
+		t.Fatalf("expected the least recently used result to be evicted")
+	}
+}
+
+func TestMemLRUHashed(t *testing.T) {
+	called := 0
+	first := func(ns []int) int {
+		called++
+		return ns[0]
+	}
+	mfirst := deriveMemLRUFirst(1, first)
+	mfirst([]int{1})
+	mfirst([]int{1})
+	mfirst([]int{2})
+	mfirst([]int{1})
+	if called != 3 {
+		t.Fatalf("not called thrice, but %d", called)
+	}
+}
+
+func TestMemTTL(t *testing.T) {
+	called := 0
+	inc := func(n int) int {
+		called++
+		return n + 1
+	}
+	minc := deriveMemTTLInc(20*time.Millisecond, inc)
+	minc(1)
+	minc(1)
+	if called != 1 {
+		t.Fatalf("not called once, but %d", called)
+	}
+	time.Sleep(30 * time.Millisecond)
+	minc(1)
+	if called != 2 {
+		t.Fatalf("expected the result to expire")
+	}
+}
+
+func TestSingleflight(t *testing.T) {
+	var called int32
+	release := make(chan struct{})
+	get := func(key string) (string, error) {
+		atomic.AddInt32(&called, 1)
+		<-release
+		return key, nil
+	}
+	mget := deriveSingleflightGet(get)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got, err := mget("a"); err != nil || got != "a" {
+				t.Errorf("get(a) got %s, %v want a", got, err)
+			}
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if called != 1 {
+		t.Fatalf("expected the concurrent calls to be coalesced, but called %d times", called)
+	}
+	mget("a")
+	if called != 2 {
+		t.Fatalf("expected the result not to be remembered")
+	}
+}
+
+func TestMemPanic(t *testing.T) {
+	memoizers := map[string]func(func(uint) uint) func(uint) uint{
+		"sync": func(f func(uint) uint) func(uint) uint {
+			return deriveMemSyncPanic(f)
+		},
+		"lru": func(f func(uint) uint) func(uint) uint {
+			return deriveMemLRUPanic(2, f)
+		},
+		"ttl": func(f func(uint) uint) func(uint) uint {
+			return deriveMemTTLPanic(time.Minute, f)
+		},
+		"singleflight": func(f func(uint) uint) func(uint) uint {
+			return deriveSingleflightPanic(f)
+		},
+	}
+	for name, memoize := range memoizers {
+		t.Run(name, func(t *testing.T) {
+			called := 0
+			mf := memoize(func(n uint) uint {
+				called++
+				if called == 1 {
+					panic("first call")
+				}
+				return n + 1
+			})
+			func() {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Fatalf("expected the panic to be passed on")
+					}
+				}()
+				mf(1)
+			}()
+			done := make(chan uint)
+			go func() {
+				done <- mf(1)
+			}()
+			select {
+			case got := <-done:
+				if got != 2 {
+					t.Fatalf("got %d, want 2", got)
+				}
+			case <-time.After(time.Second):
+				t.Fatalf("the call after the panic is blocked")
+			}
+		})
+	}
+}
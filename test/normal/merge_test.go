@@ -0,0 +1,116 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/awalterschulze/goderive/test/extra"
+)
+
+func TestMergeZero(t *testing.T) {
+	ratio := 0.5
+	dst := &Mergeable{
+		Name:    "a",
+		Count:   1,
+		Enabled: true,
+		Ratio:   &ratio,
+		Tags:    []string{"x"},
+		History: []string{"created"},
+		Labels:  map[string]string{"a": "1"},
+		Address: Address{Street: "street", City: "city"},
+		Scores:  [3]int{1, 2, 3},
+		Version: 1,
+	}
+	want := &Mergeable{}
+	deriveDeepCopyMergeable(want, dst)
+	// Version is also skipped by deepcopy.
+	want.Version = dst.Version
+	deriveMerge(dst, &Mergeable{})
+	if !reflect.DeepEqual(dst, want) {
+		t.Fatalf("expected merging a zero value to change nothing, but got %#v", dst)
+	}
+}
+
+func TestMergePartial(t *testing.T) {
+	ratio, other := 0.5, 0.0
+	owner := &Mergeable{Name: "owner"}
+	dst := &Mergeable{
+		Name:    "a",
+		Count:   1,
+		Ratio:   &ratio,
+		Tags:    []string{"x"},
+		History: []string{"created"},
+		Labels:  map[string]string{"a": "1", "b": "2"},
+		Address: Address{Street: "street", City: "city"},
+		Scores:  [3]int{1, 2, 3},
+	}
+	src := &Mergeable{
+		Count:    2,
+		Enabled:  true,
+		Ratio:    &other,
+		Tags:     []string{"y", "z"},
+		History:  []string{"updated"},
+		Labels:   map[string]string{"b": "3", "c": "4"},
+		Address:  Address{City: "town"},
+		Billing:  &Address{Street: "billing"},
+		Shape:    &Circle{Radius: 1},
+		Owner:    owner,
+		Scores:   [3]int{0, 5, 0},
+		Version:  2,
+		Embedded: extra.StructWithoutEqualMethod{Number: 3},
+	}
+	deriveMerge(dst, src)
+	want := &Mergeable{
+		Name:     "a",
+		Count:    2,
+		Enabled:  true,
+		Ratio:    &other,
+		Tags:     []string{"y", "z"},
+		History:  []string{"created", "updated"},
+		Labels:   map[string]string{"a": "1", "b": "3", "c": "4"},
+		Address:  Address{Street: "street", City: "town"},
+		Billing:  &Address{Street: "billing"},
+		Shape:    &Circle{Radius: 1},
+		Owner:    owner,
+		Scores:   [3]int{1, 5, 3},
+		Embedded: extra.StructWithoutEqualMethod{Number: 3},
+	}
+	if !reflect.DeepEqual(dst, want) {
+		t.Fatalf("got %#v\nwant %#v", dst, want)
+	}
+	if dst.Ratio == src.Ratio || dst.Billing == src.Billing || dst.Shape == src.Shape || &dst.Tags[0] == &src.Tags[0] {
+		t.Fatalf("expected the references to be copied")
+	}
+	if dst.Owner != src.Owner {
+		t.Fatalf("expected the shallow field to be copied by reference")
+	}
+}
+
+func TestMergeTime(t *testing.T) {
+	created := time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC)
+	updated := time.Date(2021, 6, 7, 8, 9, 10, 11, time.FixedZone("zone", 3600))
+	dst := &MergeUpdate{Name: "a", Updated: created}
+	deriveMergeUpdate(dst, &MergeUpdate{Name: "b"})
+	if dst.Name != "b" || !dst.Updated.Equal(created) {
+		t.Fatalf("expected a zero time not to overwrite, but got %v", dst)
+	}
+	deriveMergeUpdate(dst, &MergeUpdate{Updated: updated})
+	if !dst.Updated.Equal(updated) || dst.Updated.Location() != updated.Location() {
+		t.Fatalf("got %v, want %v", dst.Updated, updated)
+	}
+}
@@ -14,7 +14,15 @@
 
 package test
 
-import "testing"
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
 
 func TestPipeline(t *testing.T) {
 	cc := derivePipeline(toChan, wordsize)
@@ -31,3 +39,174 @@ func TestPipeline(t *testing.T) {
 		t.Fatalf("got %d, want %d", got, want)
 	}
 }
+
+func countWords(ctx context.Context, line string) (int, error) {
+	return len(strings.Split(line, " ")), nil
+}
+
+func double(ctx context.Context, i int) (int, error) {
+	return i * 2, nil
+}
+
+func TestPipelineUnordered(t *testing.T) {
+	run := derivePipelineUnordered(3, countWords, double)
+	out, wait := run(context.Background(), toChan(lines))
+	got := 0
+	for c := range out {
+		got += c
+	}
+	if err := wait(); err != nil {
+		t.Fatal(err)
+	}
+	if want := 2 * 4 * len(lines); got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
+
+func TestPipelineOrdered(t *testing.T) {
+	in := make(chan int)
+	go func() {
+		for i := 0; i < 100; i++ {
+			in <- i
+		}
+		close(in)
+	}()
+	sleepy := func(ctx context.Context, i int) (int, error) {
+		time.Sleep(time.Duration(100-i) * time.Microsecond)
+		return i, nil
+	}
+	run := derivePipelineOrdered(8, sleepy, double)
+	out, wait := run(context.Background(), in)
+	want := 0
+	for got := range out {
+		if got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		want += 2
+	}
+	if err := wait(); err != nil {
+		t.Fatal(err)
+	}
+	if want != 200 {
+		t.Fatalf("got %d results, want 100", want/2)
+	}
+}
+
+func TestPipelineOrderedError(t *testing.T) {
+	in := make(chan int)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		defer close(in)
+		for i := 0; i < 100; i++ {
+			select {
+			case in <- i:
+			case <-done:
+				return
+			}
+		}
+	}()
+	errTooBig := errors.New("too big")
+	check := func(ctx context.Context, i int) (string, error) {
+		if i > 10 {
+			return "", errTooBig
+		}
+		return strconv.Itoa(i), nil
+	}
+	run := derivePipelineOrderedCheck(4, double, check)
+	out, wait := run(context.Background(), in)
+	n := 0
+	for range out {
+		n++
+	}
+	if err := wait(); err != errTooBig {
+		t.Fatalf("got error %v, want %v", err, errTooBig)
+	}
+	if n > 6 {
+		t.Fatalf("got %d results, want at most 6", n)
+	}
+}
+
+func TestPipelineUnorderedCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan string)
+	run := derivePipelineUnordered(2, countWords, double)
+	out, wait := run(ctx, in)
+	in <- "hello world"
+	if got := <-out; got != 4 {
+		t.Fatalf("got %d, want 4", got)
+	}
+	cancel()
+	for range out {
+	}
+	if err := wait(); err != context.Canceled {
+		t.Fatalf("got error %v, want %v", err, context.Canceled)
+	}
+}
+
+// tracked returns a stage, which records the maximum number of times that it runs concurrently.
+func tracked(max *int32) func(context.Context, int) (int, error) {
+	var running int32
+	return func(ctx context.Context, i int) (int, error) {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(max)
+			if n <= m || atomic.CompareAndSwapInt32(max, m, n) {
+				break
+			}
+		}
+		time.Sleep(100 * time.Microsecond)
+		atomic.AddInt32(&running, -1)
+		return i, nil
+	}
+}
+
+// runTracked sends the numbers up to n through the pipeline and returns the number of results.
+func runTracked(t *testing.T, run func(context.Context, <-chan int) (<-chan int, func() error), n int) int {
+	in := make(chan int)
+	go func() {
+		for i := 0; i < n; i++ {
+			in <- i
+		}
+		close(in)
+	}()
+	out, wait := run(context.Background(), in)
+	got := 0
+	for range out {
+		got++
+	}
+	if err := wait(); err != nil {
+		t.Fatal(err)
+	}
+	return got
+}
+
+func TestPipelineWorkersPerStage(t *testing.T) {
+	var first, second int32
+	run := derivePipelineUnorderedPerStage(1, tracked(&first), 4, tracked(&second))
+	if got := runTracked(t, run, 40); got != 40 {
+		t.Fatalf("got %d results, want 40", got)
+	}
+	if first != 1 || second > 4 {
+		t.Fatalf("expected at most 1 and 4 workers, but got %d and %d", first, second)
+	}
+	first, second = 0, 0
+	ordered := derivePipelineOrderedPerStage(4, tracked(&first), 1, tracked(&second))
+	if got := runTracked(t, ordered, 40); got != 40 {
+		t.Fatalf("got %d results, want 40", got)
+	}
+	if first > 4 || second != 1 {
+		t.Fatalf("expected at most 4 and 1 workers, but got %d and %d", first, second)
+	}
+}
+
+func TestPipelineWorkersAllStages(t *testing.T) {
+	var first, second int32
+	run := derivePipelineUnorderedAllStages(2, tracked(&first), tracked(&second))
+	if got := runTracked(t, run, 40); got != 40 {
+		t.Fatalf("got %d results, want 40", got)
+	}
+	if first > 2 || second > 2 {
+		t.Fatalf("expected at most 2 workers for every stage, but got %d and %d", first, second)
+	}
+}
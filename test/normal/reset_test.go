@@ -0,0 +1,61 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/awalterschulze/goderive/test/extra"
+)
+
+func TestReset(t *testing.T) {
+	one := 1
+	r := &Resettable{
+		Name:     "a",
+		Count:    1,
+		Done:     true,
+		Values:   make([]*int, 2, 10),
+		Index:    map[string]int{"a": 1},
+		Address:  Address{Street: "street"},
+		Billing:  &Address{},
+		Shape:    &Circle{},
+		Pairs:    [2][]int{{1, 2}, nil},
+		Callback: func() {},
+		Events:   make(chan int),
+		Kept:     1,
+	}
+	r.Values[0] = &one
+	r.Private = *(&extra.PrivateFieldAndNoEqualMethod{}).Generate(rand.New(rand.NewSource(1)), 5).Interface().(*extra.PrivateFieldAndNoEqualMethod)
+	values, pairs := r.Values, r.Pairs[0]
+	deriveReset(r)
+	if r.Kept != 1 {
+		t.Fatalf("expected the skipped field not to be reset")
+	}
+	r.Kept = 0
+	if !deriveIsEmpty(*r) {
+		t.Fatalf("expected a reset value to be empty, but got %#v", r)
+	}
+	if cap(r.Values) != 10 || r.Values == nil || r.Index == nil || cap(r.Pairs[0]) != 2 {
+		t.Fatalf("expected the capacity of slices and maps to be kept")
+	}
+	if values[0] != nil || pairs[0] != 0 {
+		t.Fatalf("expected the elements of the slices to be zeroed")
+	}
+	r.Values = append(r.Values, &one)
+	if &r.Values[0] != &values[0] {
+		t.Fatalf("expected the slice to be reused")
+	}
+}
@@ -0,0 +1,74 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestStringZero(t *testing.T) {
+	if got, want := deriveString(Loggable{}), `Loggable{Password: <redacted>}`; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := deriveStringLoggable(nil), `nil`; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+func TestString(t *testing.T) {
+	l := &Loggable{
+		Name:     "child",
+		Password: "secret",
+		Count:    -3,
+		Ratio:    0.5,
+		Enabled:  true,
+		Tags:     []string{"a", "b"},
+		Scores:   map[string]uint8{"b": 2, "a": 1},
+		Parent:   &Loggable{Name: "parent"},
+		Shape:    &Circle{Radius: 1},
+		Err:      errors.New("oops"),
+		Timeout:  time.Second,
+		Pair:     [2]int{0, 1},
+		Callback: func() {},
+		internal: 4,
+		Skipped:  5,
+		Hidden:   "hidden",
+	}
+	want := `&Loggable{Name: "child", Password: <redacted>, Count: -3, Ratio: 0.5, Enabled: true, Tags: ["a", "b"], ` +
+		`Scores: map["a": 1, "b": 2], Parent: &Loggable{Name: "parent", Password: <redacted>}, Shape: &{1}, Err: oops, ` +
+		`Timeout: 1s, Pair: [0, 1], Callback: <func>, internal: 4}`
+	if got := deriveStringLoggable(l); got != want {
+		t.Fatalf("got  %s\nwant %s", got, want)
+	}
+	if got := fmt.Sprintf("%v", l); got != want {
+		t.Fatalf("expected the String method to be used by fmt, but got %s", got)
+	}
+}
+
+func TestStringTruncate(t *testing.T) {
+	list := make([]int, 15)
+	for i := range list {
+		list[i] = i
+	}
+	if got, want := deriveStringInts(list), `[0, 1, 2, 3, 4, 5, 6, 7, 8, 9, ... 5 more]`; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := deriveStringInts(list[:3]), `[0, 1, 2]`; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
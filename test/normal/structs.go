@@ -876,3 +876,231 @@ func (this *PrivateEmbedded) GoString() string {
 func (this *PrivateEmbedded) Hash() uint64 {
 	return deriveHashPrivateEmbedded(this)
 }
+
+type TaggedFields struct {
+	Name             string
+	Version          TaggedVersion
+	Cache            map[string]int `derive:"-"`
+	Updated          int64          `derive:"equal=-,hash=-,compare=-"`
+	Parent           *TaggedFields  `derive:"shallow"`
+	XXX_unrecognized []byte         `derive:"-"`
+}
+
+type TaggedVersion struct {
+	Major int
+	Build int `derive:"-"`
+}
+
+func (this *TaggedFields) Equal(that *TaggedFields) bool {
+	return deriveEqualPtrToTaggedFields(this, that)
+}
+
+func (this *TaggedFields) Compare(that *TaggedFields) int {
+	return deriveComparePtrToTaggedFields(this, that)
+}
+
+func (this *TaggedFields) DeepCopy(that *TaggedFields) {
+	deriveDeepCopyPtrToTaggedFields(that, this)
+}
+
+func (this *TaggedFields) GoString() string {
+	return deriveGoStringTaggedFields(this)
+}
+
+func (this *TaggedFields) Hash() uint64 {
+	return deriveHashTaggedFields(this)
+}
+
+type Shape interface {
+	Area() float64
+}
+
+type Circle struct {
+	Radius float64
+}
+
+func (this *Circle) Area() float64 {
+	return 3 * this.Radius * this.Radius
+}
+
+type Square struct {
+	Side  float64
+	Color []string
+}
+
+func (this Square) Area() float64 {
+	return this.Side * this.Side
+}
+
+// Formula implements Shape, but since it has a function field, it is compared, hashed and copied at runtime.
+type Formula struct {
+	Size    float64
+	Compute func(float64) float64
+}
+
+func (this *Formula) Area() float64 {
+	return this.Compute(this.Size)
+}
+
+type WithInterfaces struct {
+	Shape  Shape
+	Shapes []Shape
+	Err    error
+	Any    interface{}
+}
+
+func (this *WithInterfaces) Equal(that *WithInterfaces) bool {
+	return deriveEqualPtrToWithInterfaces(this, that)
+}
+
+func (this *WithInterfaces) Compare(that *WithInterfaces) int {
+	return deriveComparePtrToWithInterfaces(this, that)
+}
+
+func (this *WithInterfaces) DeepCopy(that *WithInterfaces) {
+	deriveDeepCopyPtrToWithInterfaces(that, this)
+}
+
+func (this *WithInterfaces) Hash() uint64 {
+	return deriveHashPtrToWithInterfaces(this)
+}
+
+type DoublyLinked struct {
+	Value int
+	Prev  *DoublyLinked
+	Next  *DoublyLinked
+}
+
+func (this *DoublyLinked) Equal(that *DoublyLinked) bool {
+	return deriveEqualGraphPtrToDoublyLinked(this, that)
+}
+
+func (this *DoublyLinked) DeepCopy(that *DoublyLinked) {
+	deriveDeepCopyGraphPtrToDoublyLinked(that, this)
+}
+
+type GraphNode struct {
+	Name   string
+	Edges  []*GraphNode
+	Labels map[string]*GraphNode
+	Shape  Shape
+}
+
+type StableKey struct {
+	Name    string
+	Version int32
+	Ratio   float64
+	Enabled bool
+	Tags    []string
+	Attrs   map[string]int
+	Parent  *StableKey
+	Shape   Shape
+	Cache   []byte `derive:"-"`
+}
+
+type SkippedKey struct {
+	Name     string
+	Callback func() `derive:"hash=-"`
+}
+
+type Diffable struct {
+	Name    string
+	Ages    []int
+	Tags    map[string]int
+	Parent  *Diffable
+	Shape   Shape
+	When    time.Time
+	Pair    [2]float64
+	Private *extra.PrivateFieldAndNoEqualMethod
+	Skipped int `derive:"-"`
+}
+
+type Mergeable struct {
+	Name     string
+	Count    int
+	Enabled  bool
+	Ratio    *float64
+	Tags     []string
+	History  []string `derive:"merge=append"`
+	Labels   map[string]string
+	Address  Address
+	Billing  *Address
+	Shape    Shape
+	Owner    *Mergeable `derive:"shallow"`
+	Scores   [3]int
+	Version  int `derive:"-"`
+	Embedded extra.StructWithoutEqualMethod
+}
+
+type MergeUpdate struct {
+	Name    string
+	Updated time.Time
+}
+
+type Address struct {
+	Street string
+	City   string
+}
+
+type Resettable struct {
+	Name     string
+	Count    int
+	Done     bool
+	Values   []*int
+	Index    map[string]int
+	Address  Address
+	Billing  *Address
+	Shape    Shape
+	Pairs    [2][]int
+	Private  extra.PrivateFieldAndNoEqualMethod
+	Callback func()
+	Events   chan int
+	Kept     int `derive:"-"`
+}
+
+type WalkConfig struct {
+	Name     string
+	Primary  Endpoint
+	Backup   *Endpoint
+	Replicas []Endpoint
+	Regions  map[string]*WalkRegion
+	Pair     [2]*Endpoint
+	Ignored  *Endpoint `derive:"walk=-"`
+	Private  extra.PrivateFieldAndNoEqualMethod
+}
+
+type WalkRegion struct {
+	Endpoints []*Endpoint
+	Children  []WalkRegion
+}
+
+type Endpoint struct {
+	Host     string
+	Port     int
+	Fallback *Endpoint
+}
+
+type Loggable struct {
+	Name     string
+	Password string `derive:"sensitive"`
+	Count    int
+	Ratio    float32
+	Enabled  bool
+	Tags     []string
+	Scores   map[string]uint8
+	Parent   *Loggable
+	Shape    Shape
+	Err      error
+	Timeout  time.Duration
+	Created  time.Time
+	Pair     [2]int
+	Callback func()
+	internal int
+	Skipped  int    `derive:"string=-"`
+	Hidden   string `derive:"-,sensitive"`
+}
+
+// String returns a compact and human readable representation of the log entry.
+func (l *Loggable) String() string {
+	return deriveStringLoggable(l)
+}
@@ -0,0 +1,74 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package test
+
+import (
+	"strings"
+	"testing"
+)
+
+func newTaggedFields() (*TaggedFields, *TaggedFields) {
+	parent := &TaggedFields{Name: "parent"}
+	this := &TaggedFields{
+		Name:             "a",
+		Version:          TaggedVersion{Major: 1, Build: 2},
+		Cache:            map[string]int{"a": 1},
+		Updated:          3,
+		Parent:           parent,
+		XXX_unrecognized: []byte{1},
+	}
+	that := &TaggedFields{
+		Name:    "a",
+		Version: TaggedVersion{Major: 1, Build: 5},
+		Updated: 6,
+		Parent:  &TaggedFields{Name: "parent"},
+	}
+	return this, that
+}
+
+func TestTagsSkipFields(t *testing.T) {
+	this, that := newTaggedFields()
+	if !this.Equal(that) {
+		t.Fatalf("expected the skipped fields to be ignored by equal")
+	}
+	if this.Hash() != that.Hash() {
+		t.Fatalf("expected the skipped fields to be ignored by hash")
+	}
+	if c := this.Compare(that); c != 0 {
+		t.Fatalf("expected the skipped fields to be ignored by compare, but got %d", c)
+	}
+	that.Version.Major = 2
+	if this.Equal(that) || this.Compare(that) == 0 {
+		t.Fatalf("expected the fields, which are not skipped, to be compared")
+	}
+	if s := this.GoString(); strings.Contains(s, "Cache") || strings.Contains(s, "XXX_unrecognized") || !strings.Contains(s, "Updated") {
+		t.Fatalf("expected only the fields, which are not skipped by gostring, but got %s", s)
+	}
+}
+
+func TestTagsDeepCopy(t *testing.T) {
+	this, _ := newTaggedFields()
+	that := &TaggedFields{Cache: map[string]int{"b": 2}}
+	this.DeepCopy(that)
+	if that.Parent != this.Parent {
+		t.Fatalf("expected the shallow field to be copied by reference")
+	}
+	if _, ok := that.Cache["b"]; !ok || len(that.Cache) != 1 || that.XXX_unrecognized != nil {
+		t.Fatalf("expected the skipped fields not to be copied, but got %#v", that)
+	}
+	if that.Updated != this.Updated || that.Version.Major != this.Version.Major || that.Version.Build != 0 {
+		t.Fatalf("expected the fields, which are not skipped by deepcopy, to be copied, but got %#v", that)
+	}
+}
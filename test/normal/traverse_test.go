@@ -15,9 +15,13 @@
 package test
 
 import (
+	"context"
+	"errors"
 	"reflect"
 	"strconv"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func toInts(ss []string) ([]int, error) {
@@ -36,3 +40,115 @@ func TestTraverse(t *testing.T) {
 		t.Fatal("expected error")
 	}
 }
+
+func TestTraverseMap(t *testing.T) {
+	is, err := deriveTraverseMap(strconv.Atoi, map[string]string{"a": "1", "b": "2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(is, map[string]int{"a": 1, "b": 2}) {
+		t.Fatalf("not equal")
+	}
+	if _, err := deriveTraverseMap(strconv.Atoi, map[string]string{"a": "1", "b": "b"}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestTraverseError(t *testing.T) {
+	read := func() (string, error) {
+		return "1", nil
+	}
+	i, err := deriveTraverseError(strconv.Atoi, read)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i != 1 {
+		t.Fatalf("expected 1, but got %d", i)
+	}
+	failed := errors.New("failed")
+	fail := func() (string, error) {
+		return "", failed
+	}
+	if _, err := deriveTraverseError(strconv.Atoi, fail); err != failed {
+		t.Fatalf("expected the error of the input function, but got %v", err)
+	}
+}
+
+func TestTraverseParallel(t *testing.T) {
+	var running, max int32
+	atoi := func(s string) (int, error) {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&max)
+			if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return strconv.Atoi(s)
+	}
+	ss := make([]string, 20)
+	want := make([]int, 20)
+	for i := range ss {
+		ss[i] = strconv.Itoa(i)
+		want[i] = i
+	}
+	is, err := deriveTraverseParallel(3, atoi, ss)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(is, want) {
+		t.Fatalf("expected the results in the same order, but got %v", is)
+	}
+	if max > 3 {
+		t.Fatalf("expected at most 3 elements to be processed at the same time, but %d were", max)
+	}
+	if _, err := deriveTraverseParallel(3, atoi, []string{"1", "a", "3"}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestTraverseParallelContext(t *testing.T) {
+	var calls int32
+	failed := errors.New("failed")
+	f := func(ctx context.Context, i int) (int, error) {
+		atomic.AddInt32(&calls, 1)
+		if i == 0 {
+			return 0, failed
+		}
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}
+	_, err := deriveTraverseParallelContext(context.Background(), 2, f, make([]int, 100))
+	if err != failed {
+		t.Fatalf("expected the first error, but got %v", err)
+	}
+	if calls > 2 {
+		t.Fatalf("expected no more elements to be processed after the first error, but %d were", calls)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := deriveTraverseParallelContext(ctx, 2, f, []int{1, 2}); err != context.Canceled {
+		t.Fatalf("expected the error of the context, but got %v", err)
+	}
+}
+
+func TestTraverseParallelNarrow(t *testing.T) {
+	double := func(i int) (int, error) {
+		return 2 * i, nil
+	}
+	list := make([]int, 256)
+	want := make([]int, 256)
+	for i := range list {
+		list[i] = i
+		want[i] = 2 * i
+	}
+	is, err := deriveTraverseParallelInt8(int8(0), double, list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(is, want) {
+		t.Fatalf("expected every element to be processed, but got %v", is)
+	}
+}
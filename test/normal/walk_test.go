@@ -0,0 +1,103 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func newWalkConfig() *WalkConfig {
+	return &WalkConfig{
+		Name:    "config",
+		Primary: Endpoint{Host: "a", Fallback: &Endpoint{Host: "b"}},
+		Backup:  &Endpoint{Host: "c"},
+		Replicas: []Endpoint{
+			{Host: "d"},
+			{Host: "e"},
+		},
+		Regions: map[string]*WalkRegion{
+			"west": {Endpoints: []*Endpoint{{Host: "h"}}},
+			"east": {
+				Endpoints: []*Endpoint{{Host: "f"}, nil},
+				Children:  []WalkRegion{{Endpoints: []*Endpoint{{Host: "g"}}}},
+			},
+		},
+		Pair:    [2]*Endpoint{nil, {Host: "i"}},
+		Ignored: &Endpoint{Host: "ignored"},
+	}
+}
+
+func TestWalk(t *testing.T) {
+	config := newWalkConfig()
+	var hosts []string
+	err := deriveWalk(config, func(e *Endpoint) error {
+		hosts = append(hosts, e.Host)
+		e.Port = 80
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i"}
+	if !reflect.DeepEqual(hosts, want) {
+		t.Fatalf("got %v, want %v", hosts, want)
+	}
+	if config.Primary.Fallback.Port != 80 || config.Replicas[1].Port != 80 || config.Regions["east"].Children[0].Endpoints[0].Port != 80 {
+		t.Fatalf("expected the visited values to be updated")
+	}
+	if config.Ignored.Port != 0 {
+		t.Fatalf("expected the skipped field not to be walked")
+	}
+}
+
+func TestWalkStop(t *testing.T) {
+	stop := errors.New("stop")
+	var hosts []string
+	err := deriveWalk(newWalkConfig(), func(e *Endpoint) error {
+		hosts = append(hosts, e.Host)
+		if e.Host == "d" {
+			return stop
+		}
+		return nil
+	})
+	if err != stop {
+		t.Fatalf("expected the error of visit, but got %v", err)
+	}
+	want := []string{"a", "b", "c", "d"}
+	if !reflect.DeepEqual(hosts, want) {
+		t.Fatalf("got %v, want %v", hosts, want)
+	}
+}
+
+func TestWalkMapValues(t *testing.T) {
+	ports := map[string]Endpoint{"b": {Host: "b"}, "a": {Host: "a"}}
+	var hosts []string
+	err := deriveWalkEndpoints(&ports, func(e *Endpoint) error {
+		hosts = append(hosts, e.Host)
+		e.Port = 8080
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(hosts, []string{"a", "b"}) {
+		t.Fatalf("expected the map to be walked in the order of its keys, but got %v", hosts)
+	}
+	if ports["a"].Port != 8080 {
+		t.Fatalf("expected the map values to be stored again")
+	}
+}
@@ -0,0 +1,6 @@
+# The derived files in normal are generated sequentially, which parallel generation should reproduce exactly.
+if ! goderive -j 4 -check ../normal/... ../autoname/... ../dedup/... ; then
+    echo "expected parallel generation to be identical to sequential generation"
+    exit 1
+fi
+exit 0
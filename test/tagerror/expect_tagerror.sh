@@ -0,0 +1,15 @@
+cp tagerror.gold tagerror.go
+if out=$(goderive -json . 2>&1) ; then
+    echo "expected an invalid derive struct tag error"
+    rm ./derived.gen.go
+    rm ./tagerror.go
+    exit 1
+fi
+rm ./tagerror.go
+case "$out" in
+    *'tagerror.go","line":5,"column":16,"code":"invalid-tag","severity":"error","message":"unknown plugin \"eqaul\" in derive struct tag"'*)
+        exit 0
+        ;;
+esac
+echo "expected a json diagnostic for the misspelled plugin name in the derive struct tag, but got: $out"
+exit 1
@@ -0,0 +1,12 @@
+// Code generated by goderive DO NOT EDIT.
+
+package testfiles
+
+// deriveKeys returns the keys of the input map as a slice.
+func deriveKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,30 @@
+// Code generated by goderive DO NOT EDIT.
+
+package testfiles_test
+
+import (
+	testfiles "github.com/awalterschulze/goderive/test/testfiles"
+)
+
+// deriveEqual returns whether this and that are equal.
+func deriveEqual(this, that *testfiles.Person) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Name == that.Name &&
+			this.Age == that.Age
+}
+
+// deriveMax returns the maximum value from the input list and the default value, if the list is empty.
+func deriveMax(list []int, def int) int {
+	if len(list) == 0 {
+		return def
+	}
+	m := list[0]
+	list = list[1:]
+	for i, v := range list {
+		if v > m {
+			m = list[i]
+		}
+	}
+	return m
+}
@@ -0,0 +1,14 @@
+# Functions, which are only called from tests, should not be compiled into the package itself.
+if grep -q deriveKeys derived.gen.go ; then
+    echo "expected deriveKeys to only be generated into derived.gen_test.go"
+    exit 1
+fi
+if ! grep -q "func deriveKeys" derived.gen_test.go ; then
+    echo "expected deriveKeys to be generated into derived.gen_test.go"
+    exit 1
+fi
+if ! grep -q "^package testfiles_test" derived.gen_x_test.go ; then
+    echo "expected the external test package to be generated into derived.gen_x_test.go"
+    exit 1
+fi
+exit 0
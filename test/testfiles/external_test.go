@@ -0,0 +1,34 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package testfiles_test
+
+import (
+	"testing"
+
+	"github.com/awalterschulze/goderive/test/testfiles"
+)
+
+func TestExternal(t *testing.T) {
+	this := &testfiles.Person{Name: "a", Age: 1}
+	if !deriveEqual(this, &testfiles.Person{Name: "a", Age: 1}) {
+		t.Fatalf("expected equal")
+	}
+	if !this.Equal(&testfiles.Person{Name: "a", Age: 1}) {
+		t.Fatalf("expected equal")
+	}
+	if deriveMax([]int{1, 3, 2}, 0) != 3 {
+		t.Fatalf("expected max 3")
+	}
+}
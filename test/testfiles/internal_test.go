@@ -0,0 +1,32 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package testfiles
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestInternal(t *testing.T) {
+	this := &Person{Name: "a", Age: 1}
+	if !deriveEqual(this, &Person{Name: "a", Age: 1}) {
+		t.Fatalf("expected equal")
+	}
+	keys := deriveKeys(map[string]int{"b": 2, "a": 1})
+	sort.Strings(keys)
+	if len(keys) != 2 || keys[0] != "a" || keys[1] != "b" {
+		t.Fatalf("expected keys a and b, but got %v", keys)
+	}
+}
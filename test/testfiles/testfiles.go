@@ -0,0 +1,26 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package testfiles tests that derived functions, which are only called from test files,
+// are generated into test files.
+package testfiles
+
+type Person struct {
+	Name string
+	Age  int
+}
+
+func (this *Person) Equal(that *Person) bool {
+	return deriveEqual(this, that)
+}